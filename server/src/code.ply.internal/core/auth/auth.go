@@ -0,0 +1,158 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type principalKeyType string
+
+const (
+	_principalKey principalKeyType = "principal"
+
+	// ApiKeyHeader carries API keys on service to service calls
+	ApiKeyHeader = "X-API-Key"
+)
+
+// Principal kinds
+const (
+	PrincipalUser    = "user"
+	PrincipalService = "service"
+)
+
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+type (
+	// Principal is who a request was authenticated as. Users are identified
+	// by their token's subject and services by the name of their API key.
+	// Session is set for users who logged in to the service itself, whose
+	// subject is their user id. Mfa is set when users logged in with a
+	// second factor, to the service itself or, as its token's amr claim
+	// shows, to the configured issuer.
+	Principal struct {
+		Kind     string
+		Subject  string
+		Issuer   string
+		Email    string
+		Name     string
+		IssuedAt time.Time
+		Session  bool
+		Mfa      bool
+		Claims   map[string]interface{}
+	}
+
+	// Authenticator checks the bearer token or API key of requests.
+	Authenticator struct {
+		tokens       *tokenVerifier
+		sessions     *Sessions
+		checkSession func(context.Context, *Principal) error
+		apiKeys      []ApiKeyParams
+	}
+
+	// Params configure authentication. CheckSession, when set, is called
+	// for every valid session token, so tokens of accounts since disabled or
+	// reset can be refused.
+	Params struct {
+		Jwt          JwtParams
+		Sessions     SessionParams
+		CheckSession func(context.Context, *Principal) error
+		ApiKeys      []ApiKeyParams
+	}
+
+	// JwtParams configure how bearer tokens are verified. Tokens must be
+	// issued by Issuer for Audience, when set, and signed with a key from
+	// the JWKS at JwksFile or JwksUrl; with neither, the JWKS is discovered
+	// from the issuer's OpenID configuration.
+	JwtParams struct {
+		Issuer      string
+		Audience    string
+		JwksUrl     string
+		JwksFile    string
+		JwksRefresh time.Duration
+		Leeway      time.Duration
+	}
+
+	// ApiKeyParams names a service and the hex SHA-256 hash of its key.
+	ApiKeyParams struct {
+		Name string
+		Hash string
+	}
+)
+
+func New(p Params) (*Authenticator, error) {
+	tokens, err := newTokenVerifier(p.Jwt)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := NewSessions(p.Sessions)
+	if err != nil {
+		return nil, err
+	}
+	return &Authenticator{
+		tokens:       tokens,
+		sessions:     sessions,
+		checkSession: p.CheckSession,
+		apiKeys:      p.ApiKeys,
+	}, nil
+}
+
+// Authenticate returns who r was made by. It returns nil when r carries no
+// credentials and ErrInvalidCredentials, possibly wrapped, when they are not
+// valid.
+func (a *Authenticator) Authenticate(ctx context.Context, r *http.Request) (*Principal, error) {
+	if key := r.Header.Get(ApiKeyHeader); key != "" {
+		return a.authenticateApiKey(key)
+	}
+
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return nil, nil
+	}
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return nil, ErrInvalidCredentials
+	}
+	token = strings.TrimSpace(token)
+
+	if a.sessions != nil && tokenAlgorithm(token) == sessionAlgorithm {
+		principal, err := a.sessions.verify(token)
+		if err != nil {
+			return nil, err
+		}
+		if a.checkSession != nil {
+			if err := a.checkSession(ctx, principal); err != nil {
+				return nil, err
+			}
+		}
+		return principal, nil
+	}
+	if a.tokens == nil {
+		return nil, ErrInvalidCredentials
+	}
+	return a.tokens.verify(ctx, token)
+}
+
+// tokenAlgorithm returns the algorithm a JWT's header names, or "" when it
+// cannot be read.
+func tokenAlgorithm(token string) string {
+	encoded, _, _ := strings.Cut(token, ".")
+	header := &jwtHeader{}
+	if err := decodeSegment(encoded, header); err != nil {
+		return ""
+	}
+	return header.Alg
+}
+
+// WithPrincipal returns a copy of ctx carrying p.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, _principalKey, p)
+}
+
+// PrincipalFromContext returns the principal ctx carries, if any.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(_principalKey).(*Principal)
+	return p, ok && p != nil
+}
@@ -0,0 +1,409 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultJwksRefresh = time.Hour
+	defaultLeeway      = time.Minute
+
+	// jwksRetryInterval limits how often a token signed with an unknown key
+	// can make the JWKS be fetched again
+	jwksRetryInterval = time.Minute
+
+	jwksFetchTimeout = 10 * time.Second
+	maxJwksBytes     = 1 << 20
+)
+
+// mfaMethods are the authentication methods (RFC 8176) that show a token's
+// user logged in with more than a password
+var mfaMethods = []string{"mfa", "otp", "hwk", "sc"}
+
+// signingAlgorithms are the JWS algorithms tokens may be signed with, by
+// the hash they use
+var signingAlgorithms = map[string]crypto.Hash{
+	"RS256": crypto.SHA256,
+	"RS384": crypto.SHA384,
+	"RS512": crypto.SHA512,
+	"ES256": crypto.SHA256,
+	"ES384": crypto.SHA384,
+}
+
+type (
+	// tokenVerifier checks JWTs against the keys of a JWKS, which is
+	// cached and fetched again every refresh interval.
+	tokenVerifier struct {
+		params JwtParams
+		client *http.Client
+
+		mu        sync.Mutex
+		keys      map[string]crypto.PublicKey
+		fetchedAt time.Time
+	}
+
+	jwtHeader struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid,omitempty"`
+	}
+
+	jwtClaims struct {
+		Issuer    string      `json:"iss"`
+		Subject   string      `json:"sub"`
+		Audience  interface{} `json:"aud"`
+		ExpiresAt *int64      `json:"exp"`
+		NotBefore *int64      `json:"nbf"`
+		Email     string      `json:"email"`
+		Name      string      `json:"name"`
+		Methods   interface{} `json:"amr"`
+	}
+
+	jwks struct {
+		Keys []jwk `json:"keys"`
+	}
+
+	jwk struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid,omitempty"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	}
+)
+
+// newTokenVerifier returns nil when no issuer or JWKS is configured, in which
+// case bearer tokens are refused.
+func newTokenVerifier(p JwtParams) (*tokenVerifier, error) {
+	if p.Issuer == "" && p.JwksUrl == "" && p.JwksFile == "" {
+		return nil, nil
+	}
+	if p.JwksRefresh <= 0 {
+		p.JwksRefresh = defaultJwksRefresh
+	}
+	if p.Leeway <= 0 {
+		p.Leeway = defaultLeeway
+	}
+
+	v := &tokenVerifier{
+		params: p,
+		client: &http.Client{Timeout: jwksFetchTimeout},
+	}
+	// A JWKS file is read up front so a bad one stops the service starting
+	if p.JwksFile != "" {
+		if _, err := v.signingKeys(context.Background(), ""); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// verify checks token's signature and claims and returns the user it was
+// issued to.
+func (v *tokenVerifier) verify(ctx context.Context, token string) (*Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidCredentials)
+	}
+
+	header := &jwtHeader{}
+	if err := decodeSegment(parts[0], header); err != nil {
+		return nil, fmt.Errorf("%w: malformed token header", ErrInvalidCredentials)
+	}
+	hash, ok := signingAlgorithms[header.Alg]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported signing algorithm %q", ErrInvalidCredentials, header.Alg)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed token signature", ErrInvalidCredentials)
+	}
+
+	keys, err := v.signingKeys(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	digest := hash.New()
+	digest.Write([]byte(parts[0] + "." + parts[1]))
+	verified := false
+	for kid, key := range keys {
+		if header.Kid != "" && kid != header.Kid {
+			continue
+		}
+		if verifySignature(header.Alg, key, hash, digest.Sum(nil), signature) {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, fmt.Errorf("%w: token signature is invalid", ErrInvalidCredentials)
+	}
+
+	claims := &jwtClaims{}
+	if err := decodeSegment(parts[1], claims); err != nil {
+		return nil, fmt.Errorf("%w: malformed token claims", ErrInvalidCredentials)
+	}
+	if err := v.checkClaims(claims, time.Now()); err != nil {
+		return nil, err
+	}
+
+	rawClaims := map[string]interface{}{}
+	decodeSegment(parts[1], &rawClaims)
+	return &Principal{
+		Kind:    PrincipalUser,
+		Subject: claims.Subject,
+		Issuer:  claims.Issuer,
+		Email:   claims.Email,
+		Name:    claims.Name,
+		Mfa:     usedMfa(claims.Methods),
+		Claims:  rawClaims,
+	}, nil
+}
+
+func (v *tokenVerifier) checkClaims(claims *jwtClaims, now time.Time) error {
+	if claims.Subject == "" {
+		return fmt.Errorf("%w: token has no subject", ErrInvalidCredentials)
+	}
+	if v.params.Issuer != "" && claims.Issuer != v.params.Issuer {
+		return fmt.Errorf("%w: token is from another issuer", ErrInvalidCredentials)
+	}
+	if v.params.Audience != "" && !hasClaimValue(claims.Audience, v.params.Audience) {
+		return fmt.Errorf("%w: token is for another audience", ErrInvalidCredentials)
+	}
+	if claims.ExpiresAt == nil || now.Add(-v.params.Leeway).Unix() >= *claims.ExpiresAt {
+		return fmt.Errorf("%w: token has expired", ErrInvalidCredentials)
+	}
+	if claims.NotBefore != nil && now.Add(v.params.Leeway).Unix() < *claims.NotBefore {
+		return fmt.Errorf("%w: token is not valid yet", ErrInvalidCredentials)
+	}
+	return nil
+}
+
+// signingKeys returns the JWKS keys by key id, fetching them again when they
+// are due a refresh or kid is not among them.
+func (v *tokenVerifier) signingKeys(ctx context.Context, kid string) (map[string]crypto.PublicKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	age := time.Since(v.fetchedAt)
+	_, known := v.keys[kid]
+	stale := v.keys == nil || age > v.params.JwksRefresh
+	if !stale && (known || kid == "" || age < jwksRetryInterval) {
+		return v.keys, nil
+	}
+
+	keys, err := v.loadJwks(ctx)
+	if err != nil {
+		// Keep verifying with the keys already known while the issuer is
+		// unreachable
+		if v.keys != nil {
+			return v.keys, nil
+		}
+		return nil, err
+	}
+	v.keys = keys
+	v.fetchedAt = time.Now()
+	return v.keys, nil
+}
+
+func (v *tokenVerifier) loadJwks(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	var content []byte
+	var err error
+	switch {
+	case v.params.JwksFile != "":
+		content, err = os.ReadFile(v.params.JwksFile)
+	case v.params.JwksUrl != "":
+		content, err = v.fetch(ctx, v.params.JwksUrl)
+	default:
+		var jwksUrl string
+		jwksUrl, err = v.discoverJwksUrl(ctx)
+		if err == nil {
+			content, err = v.fetch(ctx, jwksUrl)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error loading jwks: %w", err)
+	}
+
+	set := &jwks{}
+	if err := json.Unmarshal(content, set); err != nil {
+		return nil, fmt.Errorf("error loading jwks: %w", err)
+	}
+	keys := map[string]crypto.PublicKey{}
+	for _, key := range set.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		publicKey, err := key.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("error loading jwks key %q: %w", key.Kid, err)
+		}
+		keys[key.Kid] = publicKey
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("error loading jwks: no signing keys")
+	}
+	return keys, nil
+}
+
+// discoverJwksUrl reads the JWKS location from the issuer's OpenID
+// configuration.
+func (v *tokenVerifier) discoverJwksUrl(ctx context.Context) (string, error) {
+	content, err := v.fetch(ctx, strings.TrimSuffix(v.params.Issuer, "/")+"/.well-known/openid-configuration")
+	if err != nil {
+		return "", err
+	}
+	configuration := struct {
+		JwksUri string `json:"jwks_uri"`
+	}{}
+	if err := json.Unmarshal(content, &configuration); err != nil {
+		return "", err
+	}
+	if configuration.JwksUri == "" {
+		return "", errors.New("issuer does not publish a jwks_uri")
+	}
+	return configuration.JwksUri, nil
+}
+
+func (v *tokenVerifier) fetch(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned status %d", url, resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxJwksBytes))
+}
+
+func (k *jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("rsa exponent is too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+func verifySignature(alg string, key crypto.PublicKey, hash crypto.Hash, digest []byte, signature []byte) bool {
+	switch publicKey := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(alg, "RS") {
+			return false
+		}
+		return rsa.VerifyPKCS1v15(publicKey, hash, digest, signature) == nil
+
+	case *ecdsa.PublicKey:
+		if !strings.HasPrefix(alg, "ES") {
+			return false
+		}
+		// JWS signatures are r and s back to back, each the size of the
+		// curve
+		size := (publicKey.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return false
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		return ecdsa.Verify(publicKey, digest, r, s)
+	}
+	return false
+}
+
+// hasClaimValue reports whether a claim that is a string or a list of them,
+// as aud and amr may be, holds want.
+func hasClaimValue(claim interface{}, want string) bool {
+	switch values := claim.(type) {
+	case string:
+		return values == want
+	case []interface{}:
+		for _, value := range values {
+			if value == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// usedMfa reports whether a token's authentication methods (RFC 8176) show
+// the user logged in with a second factor.
+func usedMfa(methods interface{}) bool {
+	for _, method := range mfaMethods {
+		if hasClaimValue(methods, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	content, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(content, v)
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	content, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(content), nil
+}
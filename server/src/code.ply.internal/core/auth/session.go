@@ -0,0 +1,198 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"code.ply.internal/core/encryption"
+)
+
+const (
+	// sessionAlgorithm is the JWS algorithm session tokens are signed with.
+	// Tokens from the configured issuer never use it, so a public key can
+	// not be passed off as an HMAC secret.
+	sessionAlgorithm = "HS256"
+
+	defaultSessionIssuer = "ply"
+	defaultSessionExpiry = 12 * time.Hour
+
+	// challengePurpose marks tokens that only prove a password was checked,
+	// to be exchanged for a session along with a multi-factor code
+	challengePurpose = "mfa"
+	challengeExpiry  = 5 * time.Minute
+)
+
+type (
+	// Sessions issues and verifies the bearer tokens users get by logging
+	// in to the service itself.
+	Sessions struct {
+		issuer string
+		key    []byte
+		expiry time.Duration
+	}
+
+	// SessionParams configure session tokens. They are signed with the
+	// base64 encoded 32 byte key read from SigningKeyFile or, when that is
+	// empty, from the environment variable SigningKeyEnv.
+	SessionParams struct {
+		Issuer         string
+		SigningKeyFile string
+		SigningKeyEnv  string
+		Expiry         time.Duration
+	}
+
+	sessionClaims struct {
+		Issuer    string `json:"iss"`
+		Subject   string `json:"sub"`
+		Email     string `json:"email,omitempty"`
+		Name      string `json:"name,omitempty"`
+		Mfa       bool   `json:"mfa,omitempty"`
+		Purpose   string `json:"pur,omitempty"`
+		IssuedAt  int64  `json:"iat"`
+		ExpiresAt int64  `json:"exp"`
+	}
+)
+
+// NewSessions returns nil when no signing key is configured, in which case
+// no one can log in.
+func NewSessions(p SessionParams) (*Sessions, error) {
+	if p.SigningKeyFile == "" && p.SigningKeyEnv == "" {
+		return nil, nil
+	}
+	key, err := encryption.LoadKey(encryption.KeyParams{File: p.SigningKeyFile, Env: p.SigningKeyEnv})
+	if err != nil {
+		return nil, fmt.Errorf("error loading session signing key: %w", err)
+	}
+
+	issuer := p.Issuer
+	if issuer == "" {
+		issuer = defaultSessionIssuer
+	}
+	expiry := p.Expiry
+	if expiry <= 0 {
+		expiry = defaultSessionExpiry
+	}
+	return &Sessions{
+		issuer: issuer,
+		key:    key,
+		expiry: expiry,
+	}, nil
+}
+
+// Issue returns a token identifying a user by subject, and when it expires.
+// mfa records that the user logged in with a second factor.
+func (s *Sessions) Issue(subject string, email string, name string, mfa bool) (string, time.Time, error) {
+	now := time.Now()
+	return s.issue(sessionClaims{
+		Issuer:    s.issuer,
+		Subject:   subject,
+		Email:     email,
+		Name:      name,
+		Mfa:       mfa,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(s.expiry).Unix(),
+	})
+}
+
+// IssueChallenge returns a short-lived token for a user whose password was
+// checked, which is not accepted as a bearer token but is exchanged for a
+// session once their second factor is too.
+func (s *Sessions) IssueChallenge(subject string) (string, time.Time, error) {
+	now := time.Now()
+	return s.issue(sessionClaims{
+		Issuer:    s.issuer,
+		Subject:   subject,
+		Purpose:   challengePurpose,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(challengeExpiry).Unix(),
+	})
+}
+
+// VerifyChallenge checks a token from IssueChallenge and returns the subject
+// it was issued to.
+func (s *Sessions) VerifyChallenge(token string) (string, error) {
+	claims, err := s.verifyClaims(token)
+	if err != nil {
+		return "", err
+	}
+	if claims.Purpose != challengePurpose {
+		return "", fmt.Errorf("%w: not a multi-factor challenge", ErrInvalidCredentials)
+	}
+	return claims.Subject, nil
+}
+
+func (s *Sessions) issue(claims sessionClaims) (string, time.Time, error) {
+	header, err := json.Marshal(jwtHeader{Alg: sessionAlgorithm})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(s.sign(signed)), time.Unix(claims.ExpiresAt, 0), nil
+}
+
+// verify checks a session token's signature and expiry and returns the user
+// it was issued to.
+func (s *Sessions) verify(token string) (*Principal, error) {
+	claims, err := s.verifyClaims(token)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Purpose != "" {
+		return nil, fmt.Errorf("%w: not a session token", ErrInvalidCredentials)
+	}
+
+	rawClaims := map[string]interface{}{}
+	decodeSegment(strings.Split(token, ".")[1], &rawClaims)
+	return &Principal{
+		Kind:     PrincipalUser,
+		Subject:  claims.Subject,
+		Issuer:   claims.Issuer,
+		Email:    claims.Email,
+		Name:     claims.Name,
+		IssuedAt: time.Unix(claims.IssuedAt, 0),
+		Session:  true,
+		Mfa:      claims.Mfa,
+		Claims:   rawClaims,
+	}, nil
+}
+
+// verifyClaims checks the signature, issuer and expiry of any token the
+// service issued and returns its claims.
+func (s *Sessions) verifyClaims(token string) (*sessionClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidCredentials)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(signature, s.sign(parts[0]+"."+parts[1])) {
+		return nil, fmt.Errorf("%w: token signature is invalid", ErrInvalidCredentials)
+	}
+
+	claims := &sessionClaims{}
+	if err := decodeSegment(parts[1], claims); err != nil {
+		return nil, fmt.Errorf("%w: malformed token claims", ErrInvalidCredentials)
+	}
+	if claims.Issuer != s.issuer || claims.Subject == "" {
+		return nil, fmt.Errorf("%w: token is from another issuer", ErrInvalidCredentials)
+	}
+	if time.Now().Unix() >= claims.ExpiresAt {
+		return nil, fmt.Errorf("%w: token has expired", ErrInvalidCredentials)
+	}
+	return claims, nil
+}
+
+func (s *Sessions) sign(signed string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(signed))
+	return mac.Sum(nil)
+}
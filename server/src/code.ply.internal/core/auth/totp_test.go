@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"testing"
+	"time"
+)
+
+// rfc6238Secret is the SHA1 secret of the RFC 6238 test vectors.
+var rfc6238Secret = []byte("12345678901234567890")
+
+func TestTotpCode(t *testing.T) {
+	// RFC 6238 appendix B, keeping the last six of its eight digits
+	tests := []struct {
+		time int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, test := range tests {
+		if got := totpCode(rfc6238Secret, test.time/totpPeriod); got != test.want {
+			t.Errorf("code at %d = %s, want %s", test.time, got, test.want)
+		}
+	}
+}
+
+func TestVerifyTotp(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	current := now.Unix() / totpPeriod
+
+	tests := []struct {
+		name     string
+		code     string
+		after    int64
+		wantStep int64
+		wantOk   bool
+	}{
+		{"current", "081804", 0, current, true},
+		{"spaced", " 081 804 ", 0, current, true},
+		{"previous period", totpCode(rfc6238Secret, current-1), 0, current - 1, true},
+		{"next period", totpCode(rfc6238Secret, current+1), 0, current + 1, true},
+		{"beyond skew", totpCode(rfc6238Secret, current-2), 0, 0, false},
+		{"wrong", "000000", 0, 0, false},
+		{"short", "08180", 0, 0, false},
+		{"replayed", "081804", current, 0, false},
+		{"after an earlier use", "081804", current - 1, current, true},
+		{"older than the last use", totpCode(rfc6238Secret, current-1), current - 1, 0, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			step, ok := VerifyTotp(rfc6238Secret, test.code, now, test.after)
+			if ok != test.wantOk || step != test.wantStep {
+				t.Errorf("VerifyTotp(%q, after %d) = %d, %v, want %d, %v", test.code, test.after, step, ok, test.wantStep, test.wantOk)
+			}
+		})
+	}
+}
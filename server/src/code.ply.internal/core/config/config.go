@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
@@ -26,8 +27,22 @@ var (
 
 // Config structure for Kafka settings
 type Config struct {
-	Service ServiceConfig `yaml:"service"`
-	Mongo   MongoConfig   `yaml:"mongo"`
+	Service      ServiceConfig      `yaml:"service"`
+	Mongo        MongoConfig        `yaml:"mongo"`
+	Revalidation RevalidationConfig `yaml:"revalidation"`
+	Documents    DocumentConfig     `yaml:"documents"`
+	Storage      StorageConfig      `yaml:"storage"`
+	Jobs         JobsConfig         `yaml:"jobs"`
+	Encryption   EncryptionConfig   `yaml:"encryption"`
+	Extraction   ExtractionConfig   `yaml:"extraction"`
+	Scanning     ScanningConfig     `yaml:"scanning"`
+	Quotas       QuotaConfig        `yaml:"quotas"`
+	Previews     PreviewConfig      `yaml:"previews"`
+	Search       SearchConfig       `yaml:"search"`
+	Sharing      SharingConfig      `yaml:"sharing"`
+	Auth         AuthConfig         `yaml:"auth"`
+	Accounts     AccountsConfig     `yaml:"accounts"`
+	Mail         MailConfig         `yaml:"mail"`
 }
 
 type ServiceConfig struct {
@@ -35,15 +50,263 @@ type ServiceConfig struct {
 }
 
 type MongoConfig struct {
-	Url                  string `yaml:"url"`
-	Database             string `yaml:"database"`
-	ActivityCollection   string `yaml:"activityCollection"`
-	EnrollmentCollection string `yaml:"enrollmentCollection"`
-	LocationCollection   string `yaml:"locationCollection"`
-	PracticeCollection   string `yaml:"practiceCollection"`
-	ProviderCollection   string `yaml:"providerCollection"`
-	TaskCollection       string `yaml:"taskCollection"`
-	DocumentCollection   string `yaml:"documentCollection"`
+	Url                       string `yaml:"url"`
+	Database                  string `yaml:"database"`
+	ActivityCollection        string `yaml:"activityCollection"`
+	AffiliationCollection     string `yaml:"affiliationCollection"`
+	EnrollmentCollection      string `yaml:"enrollmentCollection"`
+	LocationCollection        string `yaml:"locationCollection"`
+	OrganizationCollection    string `yaml:"organizationCollection"`
+	PracticeCollection        string `yaml:"practiceCollection"`
+	ProviderCollection        string `yaml:"providerCollection"`
+	TaskCollection            string `yaml:"taskCollection"`
+	DocumentCollection        string `yaml:"documentCollection"`
+	DocumentVersionCollection string `yaml:"documentVersionCollection"`
+	ExtractionJobCollection   string `yaml:"extractionJobCollection"`
+	ResumableUploadCollection string `yaml:"resumableUploadCollection"`
+	StorageUsageCollection    string `yaml:"storageUsageCollection"`
+	DocumentTextCollection    string `yaml:"documentTextCollection"`
+	SharedLinkCollection      string `yaml:"sharedLinkCollection"`
+	RoleGrantCollection       string `yaml:"roleGrantCollection"`
+	UserCollection            string `yaml:"userCollection"`
+	UserTokenCollection       string `yaml:"userTokenCollection"`
+	MfaPolicyCollection       string `yaml:"mfaPolicyCollection"`
+}
+
+// RevalidationConfig holds how often payers require an enrollment to be
+// revalidated. Payers without a rule fall back to DefaultCycleMonths, and a
+// cycle of zero disables the computation.
+type RevalidationConfig struct {
+	DefaultCycleMonths int                      `yaml:"defaultCycleMonths"`
+	Payers             []PayerRevalidationCycle `yaml:"payers"`
+}
+
+type PayerRevalidationCycle struct {
+	Payer       string `yaml:"payer"`
+	CycleMonths int    `yaml:"cycleMonths"`
+}
+
+type DocumentConfig struct {
+	// MaxUploadBytes caps the size of a single upload request body
+	MaxUploadBytes int64 `yaml:"maxUploadBytes"`
+	// AllowedContentTypes lists the media types accepted on upload, as
+	// detected from the file content; empty allows any type
+	AllowedContentTypes []string `yaml:"allowedContentTypes"`
+	// Dedupe refuses, with the existing document's id, an upload of content
+	// the practice already has instead of storing a second copy
+	Dedupe bool `yaml:"dedupe"`
+	// MaxResumableUploadBytes caps the declared size of a resumable upload;
+	// zero allows any size
+	MaxResumableUploadBytes int64 `yaml:"maxResumableUploadBytes"`
+	// ResumableUploadExpiry is how long a resumable upload is kept after it
+	// last received data
+	ResumableUploadExpiry time.Duration `yaml:"resumableUploadExpiry"`
+	// OrphanGracePeriod is how old a stored file must be before storage
+	// reconciliation reports it as having no record, so uploads still in
+	// progress are left alone; zero uses an hour
+	OrphanGracePeriod time.Duration `yaml:"orphanGracePeriod"`
+}
+
+// StorageConfig selects where document files are kept. Backend is either
+// "local" or "s3".
+type StorageConfig struct {
+	Backend string             `yaml:"backend"`
+	Local   LocalStorageConfig `yaml:"local"`
+	S3      S3StorageConfig    `yaml:"s3"`
+}
+
+type LocalStorageConfig struct {
+	Directory string `yaml:"directory"`
+}
+
+type S3StorageConfig struct {
+	Endpoint     string `yaml:"endpoint"`
+	Region       string `yaml:"region"`
+	Bucket       string `yaml:"bucket"`
+	AccessKey    string `yaml:"accessKey"`
+	SecretKey    string `yaml:"secretKey"`
+	UsePathStyle bool   `yaml:"usePathStyle"`
+}
+
+// EncryptionConfig lists the master keys that wrap document data keys.
+// Uploads are encrypted with ActiveKeyId, or stored in plaintext when it is
+// empty. Keys used before a rotation must stay listed until the rotate-keys
+// command has rewrapped every document.
+type EncryptionConfig struct {
+	ActiveKeyId string            `yaml:"activeKeyId"`
+	Keys        []MasterKeyConfig `yaml:"keys"`
+}
+
+// MasterKeyConfig reads a base64 encoded 32 byte key from File or, when
+// File is empty, from the environment variable Env.
+type MasterKeyConfig struct {
+	Id   string `yaml:"id"`
+	File string `yaml:"file"`
+	Env  string `yaml:"env"`
+}
+
+// ExtractionConfig selects the field extractor uploads are queued for.
+// Backend is "http", "stub" for a local stand-in, or empty to disable
+// extraction. Failed jobs are retried until MaxAttempts.
+type ExtractionConfig struct {
+	Backend     string               `yaml:"backend"`
+	Http        HttpExtractionConfig `yaml:"http"`
+	MaxAttempts int                  `yaml:"maxAttempts"`
+}
+
+type HttpExtractionConfig struct {
+	Url     string        `yaml:"url"`
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// QuotaConfig limits how many bytes of document files, across all versions,
+// each practice may store. DefaultBytes applies to practices not listed in
+// Practices; zero is unlimited.
+type QuotaConfig struct {
+	DefaultBytes int64            `yaml:"defaultBytes"`
+	Practices    map[string]int64 `yaml:"practices"`
+}
+
+// PreviewConfig sets how document previews are generated. MaxDimension caps
+// their width and height; Pdftoppm is the path of poppler's pdftoppm, which
+// renders the first page of PDFs.
+type PreviewConfig struct {
+	MaxDimension int           `yaml:"maxDimension"`
+	Pdftoppm     string        `yaml:"pdftoppm"`
+	Timeout      time.Duration `yaml:"timeout"`
+}
+
+// SearchConfig sets how the text of documents is extracted for search.
+// Pdftotext is the path of poppler's pdftotext, and MaxTextBytes caps how
+// much of each document's text is indexed. The words of encrypted documents
+// are indexed as keyed hashes, with a base64 encoded 32 byte key read from
+// TermKeyFile or, when that is empty, from the environment variable
+// TermKeyEnv; without either encrypted documents are not indexed.
+type SearchConfig struct {
+	Pdftotext    string        `yaml:"pdftotext"`
+	Timeout      time.Duration `yaml:"timeout"`
+	MaxTextBytes int           `yaml:"maxTextBytes"`
+	TermKeyFile  string        `yaml:"termKeyFile"`
+	TermKeyEnv   string        `yaml:"termKeyEnv"`
+}
+
+// SharingConfig sets up signed document links. Links are signed with a
+// base64 encoded 32 byte key read from SigningKeyFile or, when that is empty,
+// from the environment variable SigningKeyEnv; without either no links can be
+// created. BaseUrl is where the API is reached from outside. Links last
+// DefaultExpiry unless asked otherwise, and never longer than MaxExpiry.
+type SharingConfig struct {
+	SigningKeyFile string        `yaml:"signingKeyFile"`
+	SigningKeyEnv  string        `yaml:"signingKeyEnv"`
+	BaseUrl        string        `yaml:"baseUrl"`
+	DefaultExpiry  time.Duration `yaml:"defaultExpiry"`
+	MaxExpiry      time.Duration `yaml:"maxExpiry"`
+}
+
+// AuthConfig sets how API requests are authenticated. While Enabled, every
+// operation the spec secures needs a bearer token or an API key, and is
+// allowed by the caller's role grants. Admins lists subjects that are admins
+// without a grant, so the first grants can be made, each prefixed with its
+// kind as grants are keyed: "token:<subject>" for a bearer token,
+// "service:<name>" for an API key, or "account:<user id>" for a login.
+type AuthConfig struct {
+	Enabled bool           `yaml:"enabled"`
+	Jwt     JwtConfig      `yaml:"jwt"`
+	ApiKeys []ApiKeyConfig `yaml:"apiKeys"`
+	Admins  []string       `yaml:"admins"`
+}
+
+// JwtConfig sets how bearer tokens are verified. Tokens must come from Issuer
+// and, when it is set, be meant for Audience. Their signing keys are read
+// from the JWKS at JwksFile, for use offline, or JwksUrl, or else discovered
+// from the issuer's OpenID configuration, and fetched again every
+// JwksRefresh. Leeway allows for clock skew in expiry checks.
+type JwtConfig struct {
+	Issuer      string        `yaml:"issuer"`
+	Audience    string        `yaml:"audience"`
+	JwksUrl     string        `yaml:"jwksUrl"`
+	JwksFile    string        `yaml:"jwksFile"`
+	JwksRefresh time.Duration `yaml:"jwksRefresh"`
+	Leeway      time.Duration `yaml:"leeway"`
+}
+
+// ApiKeyConfig names a calling service and the hex SHA-256 hash of its API
+// key, as printed by the generate-api-key command.
+type ApiKeyConfig struct {
+	Name string `yaml:"name"`
+	Hash string `yaml:"hash"`
+}
+
+// AccountsConfig sets up the service's own user accounts. Users log in for
+// a bearer token signed with a base64 encoded 32 byte key read from
+// SigningKeyFile or, when that is empty, from the environment variable
+// SigningKeyEnv; without either no one can log in. Tokens name Issuer and
+// last TokenExpiry. Invitation and password reset emails link to
+// InvitationUrl and PasswordResetUrl with the one-time token as the token
+// query parameter. After MaxFailedLogins wrong passwords or multi-factor
+// codes in a row an account is locked for LockoutDuration. MfaIssuer names
+// the service in authenticator apps.
+type AccountsConfig struct {
+	SigningKeyFile      string        `yaml:"signingKeyFile"`
+	SigningKeyEnv       string        `yaml:"signingKeyEnv"`
+	Issuer              string        `yaml:"issuer"`
+	TokenExpiry         time.Duration `yaml:"tokenExpiry"`
+	InvitationExpiry    time.Duration `yaml:"invitationExpiry"`
+	PasswordResetExpiry time.Duration `yaml:"passwordResetExpiry"`
+	InvitationUrl       string        `yaml:"invitationUrl"`
+	PasswordResetUrl    string        `yaml:"passwordResetUrl"`
+	MinPasswordLength   int           `yaml:"minPasswordLength"`
+	MaxFailedLogins     int           `yaml:"maxFailedLogins"`
+	LockoutDuration     time.Duration `yaml:"lockoutDuration"`
+	MfaIssuer           string        `yaml:"mfaIssuer"`
+}
+
+// MailConfig selects how email is sent. Backend is "smtp", "log" to write
+// messages to the log in development, or empty to send none, in which case
+// users cannot be invited or reset their passwords.
+type MailConfig struct {
+	Backend string     `yaml:"backend"`
+	From    string     `yaml:"from"`
+	Smtp    SmtpConfig `yaml:"smtp"`
+}
+
+// SmtpConfig is the server mail is submitted to. Username, when set, is
+// authenticated with PLAIN, which is only sent over TLS.
+type SmtpConfig struct {
+	Address  string        `yaml:"address"`
+	Username string        `yaml:"username"`
+	Password string        `yaml:"password"`
+	Timeout  time.Duration `yaml:"timeout"`
+}
+
+// ScanningConfig selects the malware scanner uploads are checked with.
+// Backend is "clamd", "stub" for a local stand-in that flags the EICAR test
+// file, or empty to disable scanning. While scanning is enabled only files
+// that scanned clean can be downloaded.
+type ScanningConfig struct {
+	Backend string      `yaml:"backend"`
+	Clamd   ClamdConfig `yaml:"clamd"`
+}
+
+// ClamdConfig points at a clamd daemon. clamd's StreamMaxLength must be at
+// least documents.maxUploadBytes and maxResumableUploadBytes: larger files
+// are recorded as too large to scan, are not scanned again and cannot be
+// downloaded.
+type ClamdConfig struct {
+	Address string        `yaml:"address"`
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// JobsConfig sets how often each background job runs. A zero interval
+// disables the job.
+type JobsConfig struct {
+	VerifyDocumentsInterval time.Duration `yaml:"verifyDocumentsInterval"`
+	ExtractionInterval      time.Duration `yaml:"extractionInterval"`
+	ResumableUploadInterval time.Duration `yaml:"resumableUploadInterval"`
+	ScanInterval            time.Duration `yaml:"scanInterval"`
+	ReconcileInterval       time.Duration `yaml:"reconcileInterval"`
+	PreviewInterval         time.Duration `yaml:"previewInterval"`
+	IndexInterval           time.Duration `yaml:"indexInterval"`
 }
 
 // Function to load config from a YAML file
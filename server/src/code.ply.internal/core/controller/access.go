@@ -0,0 +1,351 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"code.ply.internal/core/auth"
+	"code.ply.internal/core/models"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+// roleLevels rank the roles; each grants the permissions of those below it
+var roleLevels = map[string]int{
+	models.RolePracticeViewer: 1,
+	models.RolePracticeEditor: 2,
+	models.RoleCoordinator:    3,
+	models.RoleAdmin:          4,
+}
+
+// permissionLevels are the lowest role level holding each permission
+var permissionLevels = map[string]int{
+	models.PermissionRead:   1,
+	models.PermissionWrite:  2,
+	models.PermissionManage: 3,
+	models.PermissionAdmin:  4,
+}
+
+// access is what a principal's grants allow, as role levels by practice and
+// organization.
+type access struct {
+	admin         bool
+	practices     map[string]int
+	organizations map[string]int
+}
+
+// Authorize returns an *AccessDeniedError unless the principal on ctx holds
+// permission over every one of resources. Operations on no resource need
+// admin. Without a principal, as when authentication is disabled or for
+// background jobs, everything is allowed.
+func (c *controller) Authorize(ctx context.Context, permission string, resources []*models.Resource) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	granted, err := c.principalAccess(ctx, principal)
+	if err != nil {
+		return err
+	}
+	if granted.admin {
+		return nil
+	}
+
+	denied := &AccessDeniedError{Permission: permission}
+	level, ok := permissionLevels[permission]
+	if !ok || level >= permissionLevels[models.PermissionAdmin] || len(resources) == 0 {
+		return denied
+	}
+
+	practiceOrganizations := map[string]string{}
+	for _, resource := range resources {
+		allowed, err := c.resourceAllowed(ctx, granted, resource, level, practiceOrganizations)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			denied.Resource = resource
+			return denied
+		}
+	}
+	return nil
+}
+
+// resourceAllowed reports whether granted reaches level over resource.
+// Records shared by several practices, such as providers, need it over any
+// one of them to be read or changed, and over all of them to be managed.
+// Resources that do not exist are not allowed, so their existence is not
+// revealed.
+func (c *controller) resourceAllowed(ctx context.Context, granted *access, resource *models.Resource, level int, practiceOrganizations map[string]string) (bool, error) {
+	if resource.Kind == models.ResourceOrganization {
+		return granted.organizations[resource.Id] >= level, nil
+	}
+
+	practiceIds, err := c.resourcePractices(ctx, resource)
+	if err == mongodriver.ErrNoDocuments {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if len(practiceIds) == 0 {
+		return false, nil
+	}
+
+	requireAll := level >= permissionLevels[models.PermissionManage]
+	for _, practiceId := range practiceIds {
+		practiceLevel, err := c.practiceLevel(ctx, granted, practiceId, practiceOrganizations)
+		if err != nil {
+			return false, err
+		}
+		if practiceLevel >= level && !requireAll {
+			return true, nil
+		}
+		if practiceLevel < level && requireAll {
+			return false, nil
+		}
+	}
+	return requireAll, nil
+}
+
+// practiceLevel returns the role level granted over a practice, directly or
+// through its organization.
+func (c *controller) practiceLevel(ctx context.Context, granted *access, practiceId string, practiceOrganizations map[string]string) (int, error) {
+	level := granted.practices[practiceId]
+	if len(granted.organizations) == 0 {
+		return level, nil
+	}
+
+	organizationId, ok := practiceOrganizations[practiceId]
+	if !ok {
+		practice, err := c.ReadPractice(ctx, practiceId)
+		if err != nil && err != mongodriver.ErrNoDocuments {
+			return 0, err
+		}
+		if practice != nil {
+			organizationId = practice.OrganizationId
+		}
+		practiceOrganizations[practiceId] = organizationId
+	}
+	if organizationId != "" && granted.organizations[organizationId] > level {
+		level = granted.organizations[organizationId]
+	}
+	return level, nil
+}
+
+// resourcePractices returns the practices a resource belongs to.
+func (c *controller) resourcePractices(ctx context.Context, resource *models.Resource) ([]string, error) {
+	var practiceId string
+	switch resource.Kind {
+	case models.ResourcePractice:
+		if _, err := c.ReadPractice(ctx, resource.Id); err != nil {
+			return nil, err
+		}
+		practiceId = resource.Id
+	case models.ResourceDocument:
+		doc := &models.Document{}
+		err := c.documentCollection.FindOne(ctx, bson.M{"documentid": resource.Id}, doc)
+		if err != nil {
+			return nil, err
+		}
+		practiceId = doc.PracticeId
+	case models.ResourceEnrollment:
+		enrollment, err := c.ReadEnrollment(ctx, resource.Id)
+		if err != nil {
+			return nil, err
+		}
+		practiceId = enrollment.PracticeId
+	case models.ResourceLocation:
+		location, err := c.ReadLocation(ctx, resource.Id)
+		if err != nil {
+			return nil, err
+		}
+		practiceId = location.PracticeId
+	case models.ResourceAffiliation:
+		affiliation, err := c.ReadAffiliation(ctx, resource.Id)
+		if err != nil {
+			return nil, err
+		}
+		practiceId = affiliation.PracticeId
+	case models.ResourceTask:
+		task := &models.Task{}
+		err := c.taskCollection.FindOne(ctx, bson.M{"taskid": resource.Id}, task)
+		if err != nil {
+			return nil, err
+		}
+		practiceId = task.PracticeId
+	case models.ResourceUpload:
+		upload, err := c.ReadResumableUpload(ctx, resource.Id)
+		if err != nil {
+			return nil, err
+		}
+		practiceId = upload.PracticeId
+	case models.ResourceProvider:
+		return c.providerPractices(ctx, resource.Id)
+	}
+
+	if practiceId == "" {
+		return nil, nil
+	}
+	return []string{practiceId}, nil
+}
+
+// providerPractices returns the practices a provider is actively affiliated
+// with, along with the practice recorded on providers from before
+// affiliations.
+func (c *controller) providerPractices(ctx context.Context, providerId string) ([]string, error) {
+	provider, err := c.ReadProvider(ctx, providerId)
+	if err != nil {
+		return nil, err
+	}
+
+	affiliations := []*models.Affiliation{}
+	err = c.affiliationCollection.Find(ctx, activeAffiliationFilter(bson.M{"providerid": providerId}), &affiliations)
+	if err != nil {
+		return nil, err
+	}
+
+	practiceIds := []string{}
+	if provider.PracticeId != "" {
+		practiceIds = append(practiceIds, provider.PracticeId)
+	}
+	for _, affiliation := range affiliations {
+		practiceIds = append(practiceIds, affiliation.PracticeId)
+	}
+	return practiceIds, nil
+}
+
+// principalAccess collects what a principal's grants, and the configured
+// admins, allow.
+func (c *controller) principalAccess(ctx context.Context, principal *auth.Principal) (*access, error) {
+	granted := &access{
+		admin:         c.admins[adminKey(principalSubjectKind(principal), principal.Subject)],
+		practices:     map[string]int{},
+		organizations: map[string]int{},
+	}
+	if granted.admin {
+		return granted, nil
+	}
+
+	grants, err := c.ListRoleGrants(ctx, principalSubjectKind(principal), principal.Subject)
+	if err != nil {
+		return nil, err
+	}
+	for _, grant := range grants {
+		level := roleLevels[grant.Role]
+		switch {
+		case grant.Role == models.RoleAdmin:
+			granted.admin = true
+		case grant.PracticeId != "" && level > granted.practices[grant.PracticeId]:
+			granted.practices[grant.PracticeId] = level
+		case grant.OrganizationId != "" && level > granted.organizations[grant.OrganizationId]:
+			granted.organizations[grant.OrganizationId] = level
+		}
+	}
+	return granted, nil
+}
+
+// callerAccess returns what the principal on ctx may access, or nil when
+// there is no principal and everything may be.
+func (c *controller) callerAccess(ctx context.Context) (*access, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	granted, err := c.principalAccess(ctx, principal)
+	if err != nil || granted.admin {
+		return nil, err
+	}
+	return granted, nil
+}
+
+// principalSubjectKind returns the kind of grant subject a principal is.
+func principalSubjectKind(principal *auth.Principal) string {
+	switch {
+	case principal.Kind == auth.PrincipalService:
+		return models.SubjectService
+	case principal.Session:
+		return models.SubjectAccount
+	}
+	return models.SubjectToken
+}
+
+// adminKey is how a configured admin is looked up: a token subject and an
+// API key of the same name are different callers.
+func adminKey(subjectKind string, subject string) string {
+	return subjectKind + ":" + subject
+}
+
+// ListRoleGrants returns the grants of one subject of subjectKind, or every
+// grant when both are empty. Either may be left empty to not filter on it.
+func (c *controller) ListRoleGrants(ctx context.Context, subjectKind string, subject string) ([]*models.RoleGrant, error) {
+	filter := bson.M{}
+	if subjectKind != "" {
+		filter["subjectkind"] = subjectKind
+	}
+	if subject != "" {
+		filter["subject"] = subject
+	}
+	grants := []*models.RoleGrant{}
+	if err := c.roleGrantCollection.Find(ctx, filter, &grants); err != nil {
+		return nil, err
+	}
+	return grants, nil
+}
+
+// CreateRoleGrant gives a subject a role. Admin grants take no scope; every
+// other role is scoped to exactly one practice or organization.
+func (c *controller) CreateRoleGrant(ctx context.Context, grant *models.RoleGrant) (*models.RoleGrant, error) {
+	if grant.Subject == "" {
+		return nil, &ValidationError{Message: "subject is required"}
+	}
+	switch grant.SubjectKind {
+	case models.SubjectToken, models.SubjectService, models.SubjectAccount:
+	default:
+		return nil, &ValidationError{Message: `subjectKind must be "token", "service" or "account"`}
+	}
+	if err := c.validateRoleGrant(ctx, grant); err != nil {
+		return nil, err
+	}
+
+	grant.GrantId = uuid.New().String()
+	grant.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+	if err := c.roleGrantCollection.Upsert(ctx, bson.M{"grantid": grant.GrantId}, grant); err != nil {
+		return nil, err
+	}
+	return grant, nil
+}
+
+// validateRoleGrant checks a grant's role and scope, and that the practice
+// or organization it is scoped to exists.
+func (c *controller) validateRoleGrant(ctx context.Context, grant *models.RoleGrant) error {
+	if _, ok := roleLevels[grant.Role]; !ok {
+		return &ValidationError{Message: "unknown role " + grant.Role}
+	}
+
+	scoped := grant.PracticeId != "" || grant.OrganizationId != ""
+	switch {
+	case grant.Role == models.RoleAdmin && scoped:
+		return &ValidationError{Message: "admin grants cannot be scoped"}
+	case grant.Role != models.RoleAdmin && grant.PracticeId != "" && grant.OrganizationId != "":
+		return &ValidationError{Message: "grant either a practice or an organization, not both"}
+	case grant.Role != models.RoleAdmin && !scoped:
+		return &ValidationError{Message: "practiceId or organizationId is required"}
+	}
+	if grant.PracticeId != "" {
+		if _, err := c.ReadPractice(ctx, grant.PracticeId); err != nil {
+			return err
+		}
+	}
+	if grant.OrganizationId != "" {
+		if _, err := c.ReadOrganization(ctx, grant.OrganizationId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *controller) DeleteRoleGrant(ctx context.Context, grantId string) error {
+	return c.roleGrantCollection.DeleteOne(ctx, bson.M{"grantid": grantId})
+}
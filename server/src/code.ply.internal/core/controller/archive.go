@@ -0,0 +1,138 @@
+package controller
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.ply.internal/core/models"
+)
+
+const (
+	archiveManifestName = "manifest.csv"
+	unclassifiedFolder  = "unclassified"
+)
+
+// Manifest statuses of archived documents
+const (
+	archiveIncluded    = "included"
+	archiveUnavailable = "unavailable"
+	archiveBlocked     = "blocked"
+)
+
+var archiveManifestHeader = []string{
+	"path", "documentId", "fileName", "documentType", "providerId", "locationId",
+	"enrollmentId", "expirationDate", "size", "sha256", "scanStatus", "status",
+}
+
+// WriteDocumentArchive streams a ZIP of docs' current files to w, each in a
+// folder named for its document type, followed by a manifest.csv listing
+// every document. The archive is sent as it is built, so a file that cannot
+// be opened is marked unavailable in the manifest instead of failing it. Files
+// that may not be downloaded, such as infected ones, are left out and marked
+// blocked.
+func (c *controller) WriteDocumentArchive(ctx context.Context, docs []*models.Document, w io.Writer) error {
+	archive := zip.NewWriter(w)
+	manifest := [][]string{archiveManifestHeader}
+	used := map[string]bool{}
+
+	for _, doc := range docs {
+		name, status := "", archiveBlocked
+		if c.DocumentDownloadable(doc) == nil {
+			name = archiveEntryName(doc, used)
+			included, err := c.writeArchiveEntry(ctx, archive, name, doc)
+			if err != nil {
+				return err
+			}
+
+			status = archiveIncluded
+			if !included {
+				name, status = "", archiveUnavailable
+			}
+		}
+		manifest = append(manifest, []string{
+			name, doc.DocumentId, doc.FileName, doc.DocumentType, doc.ProviderId, doc.LocationId,
+			doc.EnrollmentId, doc.ExpirationDate, strconv.FormatInt(doc.Size, 10), doc.Sha256, doc.ScanStatus, status,
+		})
+	}
+
+	entry, err := archive.CreateHeader(&zip.FileHeader{
+		Name:     archiveManifestName,
+		Method:   zip.Deflate,
+		Modified: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	manifestWriter := csv.NewWriter(entry)
+	for _, row := range manifest {
+		for i := range row {
+			row[i] = csvSafe(row[i])
+		}
+		if err := manifestWriter.Write(row); err != nil {
+			return err
+		}
+	}
+	manifestWriter.Flush()
+	if err := manifestWriter.Error(); err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
+
+// writeArchiveEntry copies doc's file into the archive. It reports false
+// without an error when the file cannot be opened.
+func (c *controller) writeArchiveEntry(ctx context.Context, archive *zip.Writer, name string, doc *models.Document) (bool, error) {
+	body, err := c.OpenDocument(ctx, doc, 0, -1)
+	if err != nil {
+		return false, nil
+	}
+	defer body.Close()
+
+	entry, err := archive.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: time.Now(),
+	})
+	if err != nil {
+		return false, err
+	}
+	if _, err := io.Copy(entry, body); err != nil {
+		return false, fmt.Errorf("error archiving document %s: %w", doc.DocumentId, err)
+	}
+	return true, nil
+}
+
+// archiveEntryName returns a unique path for doc in the archive, numbering
+// files that share a name.
+func archiveEntryName(doc *models.Document, used map[string]bool) string {
+	folder := doc.DocumentType
+	if folder == "" {
+		folder = unclassifiedFolder
+	}
+	fileName := sanitizeFileName(doc.FileName)
+	ext := path.Ext(fileName)
+	base := strings.TrimSuffix(fileName, ext)
+
+	name := path.Join(folder, fileName)
+	for n := 2; used[name] || name == archiveManifestName; n++ {
+		name = path.Join(folder, fmt.Sprintf("%s_%d%s", base, n, ext))
+	}
+	used[name] = true
+	return name
+}
+
+// csvSafe stops spreadsheet programs reading a cell as a formula.
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"bufio"
+	"bytes"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+// maxStoredFileNameLength bounds the sanitized name used in storage keys.
+const maxStoredFileNameLength = 100
+
+// officeContentTypes maps extensions to the Office types whose files sniff as
+// plain ZIP or OLE containers.
+var officeContentTypes = map[string]string{
+	".doc":  "application/msword",
+	".xls":  "application/vnd.ms-excel",
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// sanitizeFileName reduces a client-supplied file name to a safe final path
+// element for use in storage keys. The original name is kept for display.
+func sanitizeFileName(fileName string) string {
+	fileName = fileName[strings.LastIndexAny(fileName, `/\`)+1:]
+
+	var b strings.Builder
+	for _, r := range fileName {
+		switch {
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9', r == '.', r == '-', r == '_':
+			b.WriteRune(r)
+		case r == ' ':
+			b.WriteRune('_')
+		}
+	}
+
+	// no leading dots, so neither hidden files nor ".." survive
+	cleaned := strings.TrimLeft(b.String(), "._")
+	if len(cleaned) > maxStoredFileNameLength {
+		ext := filepath.Ext(cleaned)
+		if len(ext) > 10 {
+			ext = ""
+		}
+		cleaned = cleaned[:maxStoredFileNameLength-len(ext)] + ext
+	}
+	if cleaned == "" {
+		return "file"
+	}
+	return cleaned
+}
+
+// sniffContentType detects the media type of file from its first bytes
+// without consuming them.
+func sniffContentType(file *bufio.Reader, fileName string) string {
+	head, _ := file.Peek(512)
+	contentType := http.DetectContentType(head)
+
+	switch {
+	case bytes.HasPrefix(head, []byte("II*\x00")), bytes.HasPrefix(head, []byte("MM\x00*")):
+		// scanned documents are often TIFF, which the standard sniffer skips
+		return "image/tiff"
+	case contentType == "application/zip", bytes.HasPrefix(head, []byte("\xD0\xCF\x11\xE0\xA1\xB1\x1A\xE1")):
+		// Office files are containers; only then is the extension trusted
+		if officeType, ok := officeContentTypes[strings.ToLower(filepath.Ext(fileName))]; ok {
+			return officeType
+		}
+	}
+	return contentType
+}
+
+// contentTypeAllowed reports whether contentType is in allowed, ignoring
+// parameters such as charset. An empty allowlist allows everything.
+func contentTypeAllowed(contentType string, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, allowedType := range allowed {
+		if strings.EqualFold(mediaType, allowedType) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,20 +1,35 @@
 package controller
 
 import (
+	"bufio"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
-	"os"
-	"path/filepath"
+	"path"
+	"strings"
+	"time"
 
+	"code.ply.internal/core/auth"
 	"code.ply.internal/core/config"
+	"code.ply.internal/core/encryption"
+	"code.ply.internal/core/gateway/extractor"
+	"code.ply.internal/core/gateway/mailer"
 	"code.ply.internal/core/gateway/mongo"
+	"code.ply.internal/core/gateway/scanner"
+	"code.ply.internal/core/gateway/storage"
 	"code.ply.internal/core/models"
+	"code.ply.internal/core/preview"
+	"code.ply.internal/core/search"
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
 )
 
-const uploadDir = "uploads"
+// legacyUploadDir prefixed the storage path of documents written straight to
+// the container filesystem, before storage keys were relative to the backend.
+const legacyUploadDir = "uploads/"
 
 type (
 	Controller interface {
@@ -24,6 +39,9 @@ type (
 		ReadEnrollment(context.Context, string) (*models.Enrollment, error)
 		UpdateEnrollment(context.Context, *models.Enrollment) error
 		ListEnrollments(context.Context, string) ([]*models.Enrollment, error)
+		ListRevalidationsDue(context.Context, string, int) ([]*models.Enrollment, error)
+		ListDuplicateEnrollments(context.Context, string) ([]*models.DuplicateEnrollmentGroup, error)
+		ListDependentEnrollments(context.Context, string) ([]*models.Enrollment, error)
 
 		// Activity
 		CreateActivity(context.Context, *models.Activity) (string, error)
@@ -42,6 +60,16 @@ type (
 		ReadPractice(context.Context, string) (*models.Practice, error)
 		UpdatePractice(context.Context, *models.Practice) error
 
+		// Organization
+		CreateOrganization(context.Context, *models.Organization) (string, error)
+		ListOrganizations(context.Context) ([]*models.Organization, error)
+		ReadOrganization(context.Context, string) (*models.Organization, error)
+		UpdateOrganization(context.Context, *models.Organization) error
+		ListOrganizationPractices(context.Context, string) ([]*models.Practice, error)
+		ListOrganizationProviders(context.Context, string) ([]*models.Provider, error)
+		ListOrganizationEnrollments(context.Context, string) ([]*models.Enrollment, error)
+		ListOrganizationOpenTasks(context.Context, string) ([]*models.Task, error)
+
 		// Task
 		CreateTask(context.Context, *models.Task) (string, error)
 		ListTasks(context.Context, string) ([]*models.Task, error)
@@ -54,21 +82,126 @@ type (
 		UpdateProvider(context.Context, *models.Provider) error
 		ListProviders(context.Context, string) ([]*models.Provider, error)
 
+		// Affiliation
+		CreateAffiliation(context.Context, *models.Affiliation) (string, error)
+		DeleteAffiliation(context.Context, string) error
+		ReadAffiliation(context.Context, string) (*models.Affiliation, error)
+		UpdateAffiliation(context.Context, *models.Affiliation) error
+		ListAffiliations(context.Context, string) ([]*models.Affiliation, error)
+
 		// Document
-		UploadDocument(context.Context, string, string, io.Reader) (string, error)
+		UploadDocument(context.Context, *models.Document, io.Reader) (string, error)
 		GetDocument(context.Context, string) (*models.Document, error)
-		ListDocuments(context.Context, string) ([]*models.Document, error)
+		StatDocument(context.Context, *models.Document) (int64, error)
+		OpenDocument(context.Context, *models.Document, int64, int64) (io.ReadCloser, error)
+		ListDocuments(context.Context, string, *models.DocumentFilter) ([]*models.Document, error)
+		WriteDocumentArchive(context.Context, []*models.Document, io.Writer) error
+		UpdateDocumentMetadata(context.Context, string, *models.Document) error
+		UploadDocumentVersion(context.Context, string, string, int64, io.Reader) (int, error)
+		ListDocumentVersions(context.Context, string) ([]*models.DocumentVersion, error)
+		GetDocumentVersion(context.Context, string, int) (*models.Document, error)
+		SetCurrentDocumentVersion(context.Context, string, int) error
+		QueueDocumentExtraction(context.Context, string) error
+		ApplyExtractedFields(context.Context, string, []*models.ExtractedField) error
+		ProcessExtractionJobs(context.Context) (int, error)
+
+		DocumentDownloadable(*models.Document) error
+		ScanDocuments(context.Context) (int, error)
 		DeleteDocument(context.Context, string) error
+		VerifyDocuments(context.Context, string) (*models.VerificationReport, error)
+		ReconcileStorage(context.Context, *models.ReconciliationOptions) (*models.ReconciliationReport, error)
+		RotateDocumentKeys(context.Context) (int, error)
+		GetStorageUsage(context.Context, string) (*models.StorageUsage, error)
+		GeneratePreviews(context.Context) (int, error)
+		OpenDocumentPreview(context.Context, *models.Document) (io.ReadCloser, int64, error)
+		SearchDocuments(context.Context, string, string, int) ([]*models.DocumentSearchResult, error)
+		EnsureIndexes(context.Context) error
+		IndexDocuments(context.Context) (int, error)
+
+		// Shared link
+		CreateSharedLink(context.Context, string, *models.SharedLinkRequest) (*models.SharedLink, error)
+		ListSharedLinks(context.Context, string) ([]*models.SharedLink, error)
+		RevokeSharedLink(context.Context, string, string) error
+		OpenSharedLink(context.Context, string, int64, string) (*models.Document, *models.SharedLink, error)
+		ClaimSharedLink(context.Context, *models.SharedLink) error
+
+		// Access
+		Authorize(context.Context, string, []*models.Resource) error
+		ListRoleGrants(context.Context, string, string) ([]*models.RoleGrant, error)
+		CreateRoleGrant(context.Context, *models.RoleGrant) (*models.RoleGrant, error)
+		DeleteRoleGrant(context.Context, string) error
+
+		// User
+		InviteUser(context.Context, *models.Invitation) (*models.User, error)
+		AcceptInvitation(context.Context, string, string, string) (*models.Session, error)
+		Login(context.Context, string, string) (*models.Session, error)
+		RequestPasswordReset(context.Context, string) error
+		ResetPassword(context.Context, string, string) error
+		ChangePassword(context.Context, string, string) (*models.Session, error)
+		ReadCurrentUser(context.Context) (*models.Me, error)
+		ListUsers(context.Context) ([]*models.User, error)
+		DisableUser(context.Context, string) error
+		CheckSession(context.Context, *auth.Principal) error
+
+		// Mfa
+		StartMfaEnrollment(context.Context) (*models.MfaEnrollment, error)
+		ConfirmMfaEnrollment(context.Context, string) (*models.MfaRecoveryCodes, error)
+		DisableMfa(context.Context, string) error
+		RegenerateRecoveryCodes(context.Context, string) (*models.MfaRecoveryCodes, error)
+		CompleteMfaLogin(context.Context, string, string) (*models.Session, error)
+		CheckMfa(context.Context) error
+		ReadMfaPolicy(context.Context) (*models.MfaPolicy, error)
+		UpdateMfaPolicy(context.Context, *models.MfaPolicy) (*models.MfaPolicy, error)
+		ResetUserMfa(context.Context, string) error
+
+		// Resumable upload
+		CreateResumableUpload(context.Context, *models.ResumableUpload) (*models.ResumableUpload, error)
+		ReadResumableUpload(context.Context, string) (*models.ResumableUpload, error)
+		AppendResumableUpload(context.Context, string, int64, io.Reader) (*models.ResumableUpload, error)
+		FinalizeResumableUpload(context.Context, string) (string, error)
+		DeleteResumableUpload(context.Context, string) error
+		CleanupResumableUploads(context.Context) (int, error)
 	}
 
 	controller struct {
-		activityCollection   mongo.Gateway
-		enrollmentCollection mongo.Gateway
-		locationCollection   mongo.Gateway
-		practiceCollection   mongo.Gateway
-		providerCollection   mongo.Gateway
-		taskCollection       mongo.Gateway
-		documentCollection   mongo.Gateway
+		activityCollection        mongo.Gateway
+		affiliationCollection     mongo.Gateway
+		enrollmentCollection      mongo.Gateway
+		locationCollection        mongo.Gateway
+		organizationCollection    mongo.Gateway
+		practiceCollection        mongo.Gateway
+		providerCollection        mongo.Gateway
+		taskCollection            mongo.Gateway
+		documentCollection        mongo.Gateway
+		documentVersionCollection mongo.Gateway
+		extractionJobCollection   mongo.Gateway
+		resumableUploadCollection mongo.Gateway
+		storageUsageCollection    mongo.Gateway
+		documentTextCollection    mongo.Gateway
+		sharedLinkCollection      mongo.Gateway
+		roleGrantCollection       mongo.Gateway
+		userCollection            mongo.Gateway
+		userTokenCollection       mongo.Gateway
+		mfaPolicyCollection       mongo.Gateway
+		documentStorage           storage.Gateway
+		documentKeys              *encryption.Keyring
+		extractor                 extractor.Gateway
+		scanner                   scanner.Gateway
+		previews                  *preview.Generator
+		textExtractor             *search.Extractor
+		linkSigningKey            []byte
+		searchTermKey             []byte
+		sessions                  *auth.Sessions
+		mailer                    mailer.Gateway
+		totpKey                   []byte
+
+		revalidation config.RevalidationConfig
+		documents    config.DocumentConfig
+		extraction   config.ExtractionConfig
+		quotas       config.QuotaConfig
+		sharing      config.SharingConfig
+		accounts     config.AccountsConfig
+		admins       map[string]bool
 	}
 
 	Params struct {
@@ -84,6 +217,12 @@ func New(ctx context.Context, p Params) (Controller, error) {
 		Database:   cfg.Mongo.Database,
 	})
 
+	affiliationCollection, _ := mongo.New(ctx, mongo.Params{
+		Url:        cfg.Mongo.Url,
+		Collection: cfg.Mongo.AffiliationCollection,
+		Database:   cfg.Mongo.Database,
+	})
+
 	enrollmentCollection, _ := mongo.New(ctx, mongo.Params{
 		Url:        cfg.Mongo.Url,
 		Collection: cfg.Mongo.EnrollmentCollection,
@@ -96,6 +235,12 @@ func New(ctx context.Context, p Params) (Controller, error) {
 		Database:   cfg.Mongo.Database,
 	})
 
+	organizationCollection, _ := mongo.New(ctx, mongo.Params{
+		Url:        cfg.Mongo.Url,
+		Collection: cfg.Mongo.OrganizationCollection,
+		Database:   cfg.Mongo.Database,
+	})
+
 	practiceCollection, _ := mongo.New(ctx, mongo.Params{
 		Url:        cfg.Mongo.Url,
 		Collection: cfg.Mongo.PracticeCollection,
@@ -120,20 +265,222 @@ func New(ctx context.Context, p Params) (Controller, error) {
 		Database:   cfg.Mongo.Database,
 	})
 
+	documentVersionCollection, _ := mongo.New(ctx, mongo.Params{
+		Url:        cfg.Mongo.Url,
+		Collection: cfg.Mongo.DocumentVersionCollection,
+		Database:   cfg.Mongo.Database,
+	})
+
+	extractionJobCollection, _ := mongo.New(ctx, mongo.Params{
+		Url:        cfg.Mongo.Url,
+		Collection: cfg.Mongo.ExtractionJobCollection,
+		Database:   cfg.Mongo.Database,
+	})
+
+	resumableUploadCollection, _ := mongo.New(ctx, mongo.Params{
+		Url:        cfg.Mongo.Url,
+		Collection: cfg.Mongo.ResumableUploadCollection,
+		Database:   cfg.Mongo.Database,
+	})
+
+	storageUsageCollection, _ := mongo.New(ctx, mongo.Params{
+		Url:        cfg.Mongo.Url,
+		Collection: cfg.Mongo.StorageUsageCollection,
+		Database:   cfg.Mongo.Database,
+	})
+
+	documentTextCollection, _ := mongo.New(ctx, mongo.Params{
+		Url:        cfg.Mongo.Url,
+		Collection: cfg.Mongo.DocumentTextCollection,
+		Database:   cfg.Mongo.Database,
+	})
+
+	sharedLinkCollection, _ := mongo.New(ctx, mongo.Params{
+		Url:        cfg.Mongo.Url,
+		Collection: cfg.Mongo.SharedLinkCollection,
+		Database:   cfg.Mongo.Database,
+	})
+
+	roleGrantCollection, _ := mongo.New(ctx, mongo.Params{
+		Url:        cfg.Mongo.Url,
+		Collection: cfg.Mongo.RoleGrantCollection,
+		Database:   cfg.Mongo.Database,
+	})
+
+	userCollection, _ := mongo.New(ctx, mongo.Params{
+		Url:        cfg.Mongo.Url,
+		Collection: cfg.Mongo.UserCollection,
+		Database:   cfg.Mongo.Database,
+	})
+
+	userTokenCollection, _ := mongo.New(ctx, mongo.Params{
+		Url:        cfg.Mongo.Url,
+		Collection: cfg.Mongo.UserTokenCollection,
+		Database:   cfg.Mongo.Database,
+	})
+
+	mfaPolicyCollection, _ := mongo.New(ctx, mongo.Params{
+		Url:        cfg.Mongo.Url,
+		Collection: cfg.Mongo.MfaPolicyCollection,
+		Database:   cfg.Mongo.Database,
+	})
+
+	documentStorage, err := storage.New(ctx, storage.Params{
+		Backend: cfg.Storage.Backend,
+		Local: storage.LocalParams{
+			Directory: cfg.Storage.Local.Directory,
+		},
+		S3: storage.S3Params{
+			Endpoint:     cfg.Storage.S3.Endpoint,
+			Region:       cfg.Storage.S3.Region,
+			Bucket:       cfg.Storage.S3.Bucket,
+			AccessKey:    cfg.Storage.S3.AccessKey,
+			SecretKey:    cfg.Storage.S3.SecretKey,
+			UsePathStyle: cfg.Storage.S3.UsePathStyle,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	documentKeys, err := encryption.New(encryptionParams(cfg.Encryption))
+	if err != nil {
+		return nil, err
+	}
+
+	documentExtractor, err := extractor.New(ctx, extractor.Params{
+		Backend: cfg.Extraction.Backend,
+		Http: extractor.HttpParams{
+			Url:     cfg.Extraction.Http.Url,
+			Timeout: cfg.Extraction.Http.Timeout,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	documentScanner, err := scanner.New(ctx, scanner.Params{
+		Backend: cfg.Scanning.Backend,
+		Clamd: scanner.ClamdParams{
+			Address: cfg.Scanning.Clamd.Address,
+			Timeout: cfg.Scanning.Clamd.Timeout,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	linkSigningKey, err := loadLinkSigningKey(cfg.Sharing)
+	if err != nil {
+		return nil, err
+	}
+
+	searchTermKey, err := loadSearchTermKey(cfg.Search)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := auth.NewSessions(SessionParams(cfg.Accounts))
+	if err != nil {
+		return nil, err
+	}
+
+	totpKey, err := loadTotpKey(cfg.Accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	accountMailer, err := mailer.New(ctx, mailer.Params{
+		Backend: cfg.Mail.Backend,
+		From:    cfg.Mail.From,
+		Smtp: mailer.SmtpParams{
+			Address:  cfg.Mail.Smtp.Address,
+			Username: cfg.Mail.Smtp.Username,
+			Password: cfg.Mail.Smtp.Password,
+			Timeout:  cfg.Mail.Smtp.Timeout,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	admins := map[string]bool{}
+	for _, admin := range cfg.Auth.Admins {
+		kind, subject, _ := strings.Cut(admin, ":")
+		switch kind {
+		case models.SubjectToken, models.SubjectService, models.SubjectAccount:
+		default:
+			return nil, fmt.Errorf("admin %q is not prefixed with token:, service: or account:", admin)
+		}
+		admins[adminKey(kind, subject)] = true
+	}
+
 	return &controller{
-		activityCollection:   activityCollection,
-		enrollmentCollection: enrollmentCollection,
-		locationCollection:   locationCollection,
-		practiceCollection:   practiceCollection,
-		providerCollection:   providerCollection,
-		taskCollection:       taskCollection,
-		documentCollection:   documentCollection,
+		activityCollection:        activityCollection,
+		affiliationCollection:     affiliationCollection,
+		enrollmentCollection:      enrollmentCollection,
+		locationCollection:        locationCollection,
+		organizationCollection:    organizationCollection,
+		practiceCollection:        practiceCollection,
+		providerCollection:        providerCollection,
+		taskCollection:            taskCollection,
+		documentCollection:        documentCollection,
+		documentVersionCollection: documentVersionCollection,
+		extractionJobCollection:   extractionJobCollection,
+		resumableUploadCollection: resumableUploadCollection,
+		storageUsageCollection:    storageUsageCollection,
+		documentTextCollection:    documentTextCollection,
+		sharedLinkCollection:      sharedLinkCollection,
+		roleGrantCollection:       roleGrantCollection,
+		userCollection:            userCollection,
+		userTokenCollection:       userTokenCollection,
+		mfaPolicyCollection:       mfaPolicyCollection,
+		documentStorage:           documentStorage,
+		documentKeys:              documentKeys,
+		extractor:                 documentExtractor,
+		scanner:                   documentScanner,
+		previews: preview.New(preview.Params{
+			MaxDimension: cfg.Previews.MaxDimension,
+			Pdftoppm:     cfg.Previews.Pdftoppm,
+			Timeout:      cfg.Previews.Timeout,
+		}),
+		textExtractor: search.New(search.Params{
+			Pdftotext:    cfg.Search.Pdftotext,
+			Timeout:      cfg.Search.Timeout,
+			MaxTextBytes: cfg.Search.MaxTextBytes,
+		}),
+		linkSigningKey: linkSigningKey,
+		searchTermKey:  searchTermKey,
+		sessions:       sessions,
+		mailer:         accountMailer,
+		totpKey:        totpKey,
+
+		revalidation: cfg.Revalidation,
+		documents:    cfg.Documents,
+		extraction:   cfg.Extraction,
+		quotas:       cfg.Quotas,
+		sharing:      cfg.Sharing,
+		accounts:     cfg.Accounts,
+		admins:       admins,
 	}, nil
 }
 
 func (c *controller) CreateEnrollment(ctx context.Context, enrollment *models.Enrollment) (string, error) {
+	if err := validateEnrollment(enrollment); err != nil {
+		return "", err
+	}
 	enrollment.EnrollmentId = uuid.New().String()
-	err := c.enrollmentCollection.Upsert(ctx, bson.M{"enrollmentid": enrollment.EnrollmentId}, enrollment)
+	if err := c.checkDuplicateEnrollment(ctx, enrollment); err != nil {
+		return "", err
+	}
+	if err := c.checkGroupEnrollment(ctx, enrollment); err != nil {
+		return "", err
+	}
+
+	if err := c.scheduleRevalidation(enrollment); err != nil {
+		return "", err
+	}
+	err := c.saveEnrollment(ctx, enrollment)
 	if err != nil {
 		return "", err
 	}
@@ -152,6 +499,9 @@ func (c *controller) CreateEnrollment(ctx context.Context, enrollment *models.En
 }
 
 func (c *controller) DeleteEnrollment(ctx context.Context, enrollmentId string) error {
+	if err := c.checkDependentEnrollments(ctx, enrollmentId, nil); err != nil {
+		return err
+	}
 	return c.enrollmentCollection.DeleteOne(ctx, bson.M{"enrollmentid": enrollmentId})
 }
 
@@ -165,7 +515,22 @@ func (c *controller) ReadEnrollment(ctx context.Context, enrollmentId string) (*
 }
 
 func (c *controller) UpdateEnrollment(ctx context.Context, enrollment *models.Enrollment) error {
-	return c.enrollmentCollection.Upsert(ctx, bson.M{"enrollmentid": enrollment.EnrollmentId}, enrollment)
+	if err := validateEnrollment(enrollment); err != nil {
+		return err
+	}
+	if err := c.checkDuplicateEnrollment(ctx, enrollment); err != nil {
+		return err
+	}
+	if err := c.checkGroupEnrollment(ctx, enrollment); err != nil {
+		return err
+	}
+	if err := c.checkDependentEnrollments(ctx, enrollment.EnrollmentId, enrollment); err != nil {
+		return err
+	}
+	if err := c.scheduleRevalidation(enrollment); err != nil {
+		return err
+	}
+	return c.saveEnrollment(ctx, enrollment)
 }
 
 func (c *controller) ListEnrollments(ctx context.Context, practiceId string) ([]*models.Enrollment, error) {
@@ -231,6 +596,9 @@ func (c *controller) ListLocations(ctx context.Context, practiceId string) ([]*m
 }
 
 func (c *controller) CreatePractice(ctx context.Context, practice *models.Practice) (string, error) {
+	if err := validatePractice(practice); err != nil {
+		return "", err
+	}
 	practice.PracticeId = uuid.New().String()
 	err := c.practiceCollection.Upsert(ctx, bson.M{"practiceid": practice.PracticeId}, practice)
 	if err != nil {
@@ -256,7 +624,18 @@ func (c *controller) ListPractices(ctx context.Context) ([]*models.Practice, err
 	if err != nil {
 		return nil, err
 	}
-	return practices, nil
+
+	granted, err := c.callerAccess(ctx)
+	if err != nil || granted == nil {
+		return practices, err
+	}
+	visible := []*models.Practice{}
+	for _, practice := range practices {
+		if granted.practices[practice.PracticeId] > 0 || granted.organizations[practice.OrganizationId] > 0 {
+			visible = append(visible, practice)
+		}
+	}
+	return visible, nil
 }
 
 func (c *controller) ReadPractice(ctx context.Context, practiceId string) (*models.Practice, error) {
@@ -269,6 +648,9 @@ func (c *controller) ReadPractice(ctx context.Context, practiceId string) (*mode
 }
 
 func (c *controller) UpdatePractice(ctx context.Context, practice *models.Practice) error {
+	if err := validatePractice(practice); err != nil {
+		return err
+	}
 	return c.practiceCollection.Upsert(ctx, bson.M{"practiceid": practice.PracticeId}, practice)
 }
 
@@ -295,15 +677,37 @@ func (c *controller) UpdateTask(ctx context.Context, task *models.Task) error {
 }
 
 func (c *controller) CreateProvider(ctx context.Context, provider *models.Provider) (string, error) {
+	if err := validateProvider(provider); err != nil {
+		return "", err
+	}
+
+	// a provider is a single identity shared by every practice they work at.
+	// A known SSN is refused rather than quietly affiliated with the caller's
+	// practice, which would hand it the provider's records; linking an
+	// existing provider goes through an affiliation instead.
+	if err := c.checkDuplicateSsn(ctx, "", provider.Ssn); err != nil {
+		return "", err
+	}
+
+	practiceId := provider.PracticeId
+	provider.PracticeId = ""
 	provider.ProviderId = uuid.New().String()
 	err := c.providerCollection.Upsert(ctx, bson.M{"providerid": provider.ProviderId}, provider)
 	if err != nil {
 		return "", err
 	}
+
+	if err := c.affiliate(ctx, provider.ProviderId, practiceId); err != nil {
+		return "", err
+	}
 	return provider.ProviderId, nil
 }
 
 func (c *controller) DeleteProvider(ctx context.Context, providerId string) error {
+	err := c.affiliationCollection.DeleteMany(ctx, bson.M{"providerid": providerId})
+	if err != nil {
+		return err
+	}
 	return c.providerCollection.DeleteOne(ctx, bson.M{"providerid": providerId})
 }
 
@@ -317,73 +721,301 @@ func (c *controller) ReadProvider(ctx context.Context, providerId string) (*mode
 }
 
 func (c *controller) UpdateProvider(ctx context.Context, provider *models.Provider) error {
+	if err := validateProvider(provider); err != nil {
+		return err
+	}
+	if err := c.checkDuplicateSsn(ctx, provider.ProviderId, provider.Ssn); err != nil {
+		return err
+	}
+
+	// practice links live on affiliations, not on the provider itself
+	if err := c.affiliate(ctx, provider.ProviderId, provider.PracticeId); err != nil {
+		return err
+	}
+	provider.PracticeId = ""
 	return c.providerCollection.Upsert(ctx, bson.M{"providerid": provider.ProviderId}, provider)
 }
 
 func (c *controller) ListProviders(ctx context.Context, practiceId string) ([]*models.Provider, error) {
+	affiliations := []*models.Affiliation{}
+	err := c.affiliationCollection.Find(ctx, activeAffiliationFilter(bson.M{"practiceid": practiceId}), &affiliations)
+	if err != nil {
+		return nil, err
+	}
+
+	providerIds := make([]string, 0, len(affiliations))
+	for _, affiliation := range affiliations {
+		providerIds = append(providerIds, affiliation.ProviderId)
+	}
+
+	// providers created before affiliations existed still carry a practiceid
 	providers := []*models.Provider{}
-	err := c.providerCollection.Find(ctx, bson.M{"practiceid": practiceId}, &providers)
+	err = c.providerCollection.Find(ctx, bson.M{"$or": bson.A{
+		bson.M{"practiceid": practiceId},
+		bson.M{"providerid": bson.M{"$in": providerIds}},
+	}}, &providers)
 	if err != nil {
 		return nil, err
 	}
 	return providers, nil
 }
 
-func (c *controller) UploadDocument(ctx context.Context, practiceId string, fileName string, file io.Reader) (string, error) {
-	practiceUploadDir := filepath.Join(uploadDir, practiceId)
-	if err := os.MkdirAll(practiceUploadDir, 0755); err != nil {
+func (c *controller) CreateAffiliation(ctx context.Context, affiliation *models.Affiliation) (string, error) {
+	affiliation.AffiliationId = uuid.New().String()
+	err := c.affiliationCollection.Upsert(ctx, bson.M{"affiliationid": affiliation.AffiliationId}, affiliation)
+	if err != nil {
 		return "", err
 	}
+	return affiliation.AffiliationId, nil
+}
+
+func (c *controller) DeleteAffiliation(ctx context.Context, affiliationId string) error {
+	return c.affiliationCollection.DeleteOne(ctx, bson.M{"affiliationid": affiliationId})
+}
+
+func (c *controller) ReadAffiliation(ctx context.Context, affiliationId string) (*models.Affiliation, error) {
+	affiliation := &models.Affiliation{}
+	err := c.affiliationCollection.FindOne(ctx, bson.M{"affiliationid": affiliationId}, affiliation)
+	if err != nil {
+		return nil, err
+	}
+	return affiliation, nil
+}
+
+// UpdateAffiliation changes an affiliation's role and dates. Which provider
+// and practice it links stay as they are: moving it would give the practice
+// access to another practice's provider.
+func (c *controller) UpdateAffiliation(ctx context.Context, affiliation *models.Affiliation) error {
+	stored, err := c.ReadAffiliation(ctx, affiliation.AffiliationId)
+	if err != nil {
+		return err
+	}
+	affiliation.ProviderId = stored.ProviderId
+	affiliation.PracticeId = stored.PracticeId
+	return c.affiliationCollection.Upsert(ctx, bson.M{"affiliationid": affiliation.AffiliationId}, affiliation)
+}
+
+func (c *controller) ListAffiliations(ctx context.Context, providerId string) ([]*models.Affiliation, error) {
+	affiliations := []*models.Affiliation{}
+	err := c.affiliationCollection.Find(ctx, bson.M{"providerid": providerId}, &affiliations)
+	if err != nil {
+		return nil, err
+	}
+	return affiliations, nil
+}
 
-	documentId := uuid.New().String()
-	storageFileName := fmt.Sprintf("%s_%s", documentId, fileName)
-	storagePath := filepath.Join(practiceUploadDir, storageFileName)
+// affiliate links a provider to a practice unless an active affiliation
+// between the two already exists.
+func (c *controller) affiliate(ctx context.Context, providerId string, practiceId string) error {
+	if practiceId == "" {
+		return nil
+	}
 
-	// Create the destination file
-	dst, err := os.Create(storagePath)
+	affiliations := []*models.Affiliation{}
+	err := c.affiliationCollection.Find(ctx, activeAffiliationFilter(bson.M{
+		"providerid": providerId,
+		"practiceid": practiceId,
+	}), &affiliations)
 	if err != nil {
-		return "", fmt.Errorf("error creating file: %w", err)
+		return err
+	}
+	if len(affiliations) > 0 {
+		return nil
+	}
+
+	_, err = c.CreateAffiliation(ctx, &models.Affiliation{
+		ProviderId: providerId,
+		PracticeId: practiceId,
+		StartDate:  time.Now().Format(models.DateLayout),
+	})
+	return err
+}
+
+// activeAffiliationFilter narrows filter to affiliations that have started
+// and not ended. Conditions already on filter are kept.
+func activeAffiliationFilter(filter bson.M) bson.M {
+	today := time.Now().Format(models.DateLayout)
+	return bson.M{"$and": bson.A{
+		filter,
+		bson.M{"$or": bson.A{
+			bson.M{"startdate": ""},
+			bson.M{"startdate": bson.M{"$lte": today}},
+		}},
+		bson.M{"$or": bson.A{
+			bson.M{"enddate": ""},
+			bson.M{"enddate": bson.M{"$gte": today}},
+		}},
+	}}
+}
+
+// UploadDocument stores file as a new document of doc's practice. doc
+// supplies the file name and any classification metadata, and its Size the
+// declared size of file, if known, so an upload that cannot fit in the
+// practice's quota is refused before it is stored.
+func (c *controller) UploadDocument(ctx context.Context, doc *models.Document, file io.Reader) (string, error) {
+	if err := c.validateDocumentMetadata(ctx, doc.PracticeId, doc); err != nil {
+		return "", err
+	}
+	if err := c.reserveQuota(ctx, doc.PracticeId, doc.DocumentType, doc.Size); err != nil {
+		return "", err
+	}
+	return c.storeNewDocument(ctx, doc, file, doc.Size)
+}
+
+// storeNewDocument stores file as a new document once its metadata is
+// validated and reserved bytes of quota are held for it. The reservation is
+// settled to the stored size, or released if the document isn't created.
+func (c *controller) storeNewDocument(ctx context.Context, doc *models.Document, file io.Reader, reserved int64) (string, error) {
+	release := func() {
+		c.chargeUsage(ctx, doc.PracticeId, doc.DocumentType, -reserved, 0)
+	}
+
+	doc.DocumentId = uuid.New().String()
+	doc.CurrentVersion = 1
+	storageKey := path.Join(doc.PracticeId, fmt.Sprintf("%s_%s", doc.DocumentId, sanitizeFileName(doc.FileName)))
+
+	if err := c.storeDocumentFile(ctx, doc, storageKey, file); err != nil {
+		release()
+		return "", err
+	}
+
+	if c.documents.Dedupe {
+		existing, err := c.findDocumentByChecksum(ctx, doc.PracticeId, doc.Sha256)
+		if err != nil {
+			c.documentStorage.Delete(ctx, doc.StoragePath)
+			release()
+			return "", err
+		}
+		if existing != nil {
+			// The caller's metadata is not merged into the existing
+			// document; they get its id to decide what to do with it.
+			c.documentStorage.Delete(ctx, doc.StoragePath)
+			release()
+			return "", &ConflictError{
+				ExistingId: existing.DocumentId,
+				Message:    fmt.Sprintf("document %s already has this content", existing.DocumentId),
+			}
+		}
+	}
+
+	if err := c.settleQuota(ctx, doc.PracticeId, doc.DocumentType, reserved, doc.Size); err != nil {
+		c.documentStorage.Delete(ctx, doc.StoragePath)
+		return "", err
 	}
-	defer dst.Close()
+	reserved = doc.Size
 
-	// Copy the file data
-	if _, err := io.Copy(dst, file); err != nil {
-		os.Remove(storagePath) // Clean up on error
-		return "", fmt.Errorf("error saving file: %w", err)
+	if err := c.queueExtraction(ctx, doc); err != nil {
+		c.documentStorage.Delete(ctx, doc.StoragePath)
+		release()
+		return "", err
 	}
 
 	// Create document record
-	doc := &models.Document{
-		DocumentId:  documentId,
-		PracticeId:  practiceId,
-		FileName:    fileName,
-		StoragePath: storagePath,
+	err := c.documentCollection.Upsert(ctx, bson.M{"documentid": doc.DocumentId}, doc)
+	if err != nil {
+		c.documentStorage.Delete(ctx, doc.StoragePath) // Clean up on error
+		release()
+		return "", err
 	}
 
-	err = c.documentCollection.Upsert(ctx, bson.M{"documentid": doc.DocumentId}, doc)
+	err = c.saveDocumentVersion(ctx, documentVersion(doc, doc.CurrentVersion))
 	if err != nil {
-		os.Remove(storagePath)
+		c.documentCollection.DeleteOne(ctx, bson.M{"documentid": doc.DocumentId})
+		c.documentStorage.Delete(ctx, doc.StoragePath)
+		release()
 		return "", err
 	}
 
+	c.chargeUsage(ctx, doc.PracticeId, doc.DocumentType, 0, 1)
+	c.indexUploadedDocument(ctx, doc)
 	return doc.DocumentId, nil
 }
 
+// storeDocumentFile writes file under storageKey, encrypting it when enabled,
+// and records its storage path, type, size and checksum on doc. The file is
+// scanned as it is written and moved to quarantine if it is infected.
+func (c *controller) storeDocumentFile(ctx context.Context, doc *models.Document, storageKey string, file io.Reader) error {
+	// Trust the content rather than the client's file name for the type
+	content := bufio.NewReaderSize(file, 512)
+	contentType := sniffContentType(content, doc.FileName)
+	if !contentTypeAllowed(contentType, c.documents.AllowedContentTypes) {
+		return &UnsupportedContentTypeError{ContentType: contentType}
+	}
+
+	hash := sha256.New()
+	scan, plaintext := c.scanStream(ctx, io.TeeReader(content, hash))
+	body, err := c.encryptDocument(doc, plaintext)
+	if err != nil {
+		scan.wait(err)
+		return err
+	}
+	size, err := c.documentStorage.Put(ctx, storageKey, body)
+	result, scanErr := scan.wait(err)
+	if err != nil {
+		return err
+	}
+	if doc.WrappedKey != nil {
+		size = encryption.PlaintextSize(size)
+	}
+
+	doc.StoragePath = storageKey
+	doc.ContentType = contentType
+	doc.Size = size
+	doc.Sha256 = hex.EncodeToString(hash.Sum(nil))
+
+	if scan != nil {
+		if err := c.recordScan(ctx, doc, result, scanErr); err != nil {
+			c.documentStorage.Delete(ctx, storageKey)
+			return err
+		}
+	}
+	return nil
+}
+
 func (c *controller) GetDocument(ctx context.Context, documentId string) (*models.Document, error) {
 	doc := &models.Document{}
 	err := c.documentCollection.FindOne(ctx, bson.M{"documentid": documentId}, doc)
 	if err != nil {
 		return nil, err
 	}
+	doc.PreviewAvailable = previewAvailable(doc)
 	return doc, nil
 }
 
-func (c *controller) ListDocuments(ctx context.Context, practiceId string) ([]*models.Document, error) {
+func (c *controller) StatDocument(ctx context.Context, doc *models.Document) (int64, error) {
+	size, err := c.documentStorage.Stat(ctx, documentStorageKey(doc))
+	if err != nil {
+		return 0, err
+	}
+	if doc.WrappedKey != nil {
+		size = encryption.PlaintextSize(size)
+	}
+	return size, nil
+}
+
+// OpenDocument streams length bytes of the stored file starting at offset.
+// A negative length reads to the end of the file.
+func (c *controller) OpenDocument(ctx context.Context, doc *models.Document, offset int64, length int64) (io.ReadCloser, error) {
+	if doc.WrappedKey != nil {
+		return c.openEncryptedDocument(ctx, doc, offset, length)
+	}
+
+	body, err := c.documentStorage.Get(ctx, documentStorageKey(doc), offset, length)
+	if err != nil {
+		return nil, fmt.Errorf("error opening file: %w", err)
+	}
+	return body, nil
+}
+
+func (c *controller) ListDocuments(ctx context.Context, practiceId string, filter *models.DocumentFilter) ([]*models.Document, error) {
 	docs := []*models.Document{}
-	err := c.documentCollection.Find(ctx, bson.M{"practiceid": practiceId}, &docs)
+	err := c.documentCollection.Find(ctx, documentFilter(practiceId, filter), &docs)
 	if err != nil {
 		return nil, err
 	}
+	for _, doc := range docs {
+		doc.PreviewAvailable = previewAvailable(doc)
+	}
 	return docs, nil
 }
 
@@ -395,9 +1027,68 @@ func (c *controller) DeleteDocument(ctx context.Context, documentId string) erro
 		return err
 	}
 
-	// Delete the physical file
-	os.Remove(doc.StoragePath)
+	versions := []*models.DocumentVersion{}
+	err = c.documentVersionCollection.Find(ctx, bson.M{"documentid": documentId}, &versions)
+	if err != nil {
+		return err
+	}
+
+	// Read usage before the records it may be counted from are gone
+	if _, err := c.storageUsage(ctx, doc.PracticeId); err != nil {
+		return err
+	}
+	size, err := c.documentBytes(ctx, doc)
+	if err != nil {
+		return err
+	}
+
+	// Delete the physical files of the document and all its versions. Keep
+	// the records if that fails so the delete can be retried; files that are
+	// already gone are fine.
+	keys := []string{documentStorageKey(doc)}
+	for _, version := range versions {
+		if version.StoragePath != "" && version.StoragePath != doc.StoragePath {
+			keys = append(keys, strings.TrimPrefix(version.StoragePath, legacyUploadDir))
+		}
+	}
+	if doc.PreviewPath != "" {
+		keys = append(keys, doc.PreviewPath)
+	}
+	for _, key := range keys {
+		err := c.documentStorage.Delete(ctx, key)
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return fmt.Errorf("error deleting file: %w", err)
+		}
+	}
+
+	// Delete the document's records from the database, links included
+	err = c.documentVersionCollection.DeleteMany(ctx, bson.M{"documentid": documentId})
+	if err != nil {
+		return err
+	}
+	err = c.extractionJobCollection.DeleteMany(ctx, bson.M{"documentid": documentId})
+	if err != nil {
+		return err
+	}
+	err = c.documentTextCollection.DeleteMany(ctx, bson.M{"documentid": documentId})
+	if err != nil {
+		return err
+	}
+	err = c.sharedLinkCollection.DeleteMany(ctx, bson.M{"documentid": documentId})
+	if err != nil {
+		return err
+	}
+	err = c.documentCollection.DeleteOne(ctx, bson.M{"documentid": documentId})
+	if err != nil {
+		return err
+	}
+
+	c.chargeUsage(ctx, doc.PracticeId, doc.DocumentType, -size, -1)
+	return nil
+}
 
-	// Delete the document record from the database
-	return c.documentCollection.DeleteOne(ctx, bson.M{"documentid": documentId})
+// documentStorageKey returns the key doc is stored under in the storage
+// gateway.
+func documentStorageKey(doc *models.Document) string {
+	return strings.TrimPrefix(doc.StoragePath, legacyUploadDir)
 }
@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"code.ply.internal/core/models"
+	"go.mongodb.org/mongo-driver/bson"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+func (c *controller) ListDuplicateEnrollments(ctx context.Context, practiceId string) ([]*models.DuplicateEnrollmentGroup, error) {
+	enrollments, err := c.ListEnrollments(ctx, practiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	// enrollments for the same provider, payer and state are near-duplicates
+	// even when their location, type or status differ
+	keys := []string{}
+	groups := map[string][]*models.Enrollment{}
+	for _, enrollment := range enrollments {
+		key := strings.Join([]string{
+			enrollment.ProviderId,
+			normalizeEnrollmentField(enrollment.Payer),
+			normalizeEnrollmentField(enrollment.State),
+		}, "|")
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], enrollment)
+	}
+
+	duplicates := []*models.DuplicateEnrollmentGroup{}
+	for _, key := range keys {
+		group := groups[key]
+		if len(group) < 2 {
+			continue
+		}
+
+		reason := "same provider, payer and state"
+		if exactDuplicates(group) {
+			reason = "same provider, payer, state, location and type"
+		}
+		duplicates = append(duplicates, &models.DuplicateEnrollmentGroup{
+			Reason:      reason,
+			Enrollments: group,
+		})
+	}
+	return duplicates, nil
+}
+
+// checkDuplicateEnrollment rejects an enrollment that matches another active
+// one of the same practice and kind for the same provider, payer, state,
+// location and type. Enrollments from before kinds were recorded count as
+// individual ones. It also sets the identity key the enrollment is saved
+// with, whose unique index settles concurrent saves this check misses.
+func (c *controller) checkDuplicateEnrollment(ctx context.Context, enrollment *models.Enrollment) error {
+	enrollment.IdentityKey = ""
+	if !isActiveEnrollment(enrollment) {
+		return nil
+	}
+	enrollment.IdentityKey = enrollmentIdentityKey(enrollment)
+
+	kinds := bson.A{"", nil, models.EnrollmentKindIndividual}
+	if enrollment.Kind == models.EnrollmentKindGroup {
+		kinds = bson.A{models.EnrollmentKindGroup}
+	}
+	candidates := []*models.Enrollment{}
+	err := c.enrollmentCollection.Find(ctx, bson.M{
+		"enrollmentid": bson.M{"$ne": enrollment.EnrollmentId},
+		"practiceid":   enrollment.PracticeId,
+		"providerid":   enrollment.ProviderId,
+		"kind":         bson.M{"$in": kinds},
+	}, &candidates)
+	if err != nil {
+		return err
+	}
+
+	for _, candidate := range candidates {
+		if isActiveEnrollment(candidate) && sameEnrollment(candidate, enrollment) {
+			return duplicateEnrollmentError(candidate.EnrollmentId)
+		}
+	}
+	return nil
+}
+
+// saveEnrollment saves an enrollment checked by checkDuplicateEnrollment,
+// returning a *ConflictError when an active duplicate was saved meanwhile.
+func (c *controller) saveEnrollment(ctx context.Context, enrollment *models.Enrollment) error {
+	err := c.enrollmentCollection.Upsert(ctx, bson.M{"enrollmentid": enrollment.EnrollmentId}, enrollment)
+	if !mongodriver.IsDuplicateKeyError(err) {
+		return err
+	}
+
+	existing := &models.Enrollment{}
+	if err := c.enrollmentCollection.FindOne(ctx, bson.M{"identitykey": enrollment.IdentityKey}, existing); err != nil {
+		return err
+	}
+	return duplicateEnrollmentError(existing.EnrollmentId)
+}
+
+func duplicateEnrollmentError(existingId string) *ConflictError {
+	return &ConflictError{
+		ExistingId: existingId,
+		Message:    "an active enrollment already exists for this provider, payer, state, location and type",
+	}
+}
+
+// enrollmentIdentityKey joins what makes two enrollments duplicates, as
+// sameEnrollment compares them, with their practice and kind.
+func enrollmentIdentityKey(enrollment *models.Enrollment) string {
+	kind := enrollment.Kind
+	if kind == "" {
+		kind = models.EnrollmentKindIndividual
+	}
+	return strings.Join([]string{
+		enrollment.PracticeId,
+		kind,
+		enrollment.ProviderId,
+		enrollment.LocationId,
+		normalizeEnrollmentField(enrollment.Payer),
+		normalizeEnrollmentField(enrollment.State),
+		normalizeEnrollmentField(enrollment.Type),
+	}, "|")
+}
+
+func isActiveEnrollment(enrollment *models.Enrollment) bool {
+	switch strings.ToLower(strings.TrimSpace(enrollment.Status)) {
+	case models.EnrollmentStatusDenied, models.EnrollmentStatusWithdrawn, models.EnrollmentStatusTerminated:
+		return false
+	}
+	return true
+}
+
+func sameEnrollment(a *models.Enrollment, b *models.Enrollment) bool {
+	return a.ProviderId == b.ProviderId &&
+		a.LocationId == b.LocationId &&
+		normalizeEnrollmentField(a.Payer) == normalizeEnrollmentField(b.Payer) &&
+		normalizeEnrollmentField(a.State) == normalizeEnrollmentField(b.State) &&
+		normalizeEnrollmentField(a.Type) == normalizeEnrollmentField(b.Type)
+}
+
+func exactDuplicates(group []*models.Enrollment) bool {
+	for _, enrollment := range group[1:] {
+		if !sameEnrollment(group[0], enrollment) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeEnrollmentField folds case and drops punctuation and spacing so
+// "BCBS " and "bcbs" compare equal.
+func normalizeEnrollmentField(value string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, value)
+}
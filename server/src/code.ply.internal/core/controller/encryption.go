@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"code.ply.internal/core/config"
+	"code.ply.internal/core/encryption"
+	"code.ply.internal/core/gateway/mongo"
+	"code.ply.internal/core/models"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func encryptionParams(cfg config.EncryptionConfig) encryption.Params {
+	p := encryption.Params{
+		ActiveKeyId: cfg.ActiveKeyId,
+	}
+	for _, key := range cfg.Keys {
+		p.Keys = append(p.Keys, encryption.KeyParams{
+			Id:   key.Id,
+			File: key.File,
+			Env:  key.Env,
+		})
+	}
+	return p
+}
+
+// encryptDocument returns the form of content to store for doc. When
+// encryption is enabled it generates the document's data key, records the
+// wrapped key on doc and returns the encrypted content.
+func (c *controller) encryptDocument(doc *models.Document, content io.Reader) (io.Reader, error) {
+	if !c.documentKeys.Enabled() {
+		return content, nil
+	}
+
+	dataKey, wrappedKey, err := c.documentKeys.NewDataKey(doc.DocumentId)
+	if err != nil {
+		return nil, err
+	}
+	doc.KeyId = c.documentKeys.ActiveKeyId()
+	doc.WrappedKey = wrappedKey
+
+	return encryption.NewEncryptReader(dataKey, content)
+}
+
+func (c *controller) openEncryptedDocument(ctx context.Context, doc *models.Document, offset int64, length int64) (io.ReadCloser, error) {
+	dataKey, err := c.documentKeys.Unwrap(doc.KeyId, doc.DocumentId, doc.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	size := doc.Size
+	if size == 0 {
+		size, err = c.StatDocument(ctx, doc)
+		if err != nil {
+			return nil, fmt.Errorf("error opening file: %w", err)
+		}
+	}
+
+	storedOffset, storedLength := encryption.CiphertextRange(offset, length, size)
+	body, err := c.documentStorage.Get(ctx, documentStorageKey(doc), storedOffset, storedLength)
+	if err != nil {
+		return nil, fmt.Errorf("error opening file: %w", err)
+	}
+
+	plaintext, err := encryption.NewDecryptReader(dataKey, body, offset, length, size)
+	if err != nil {
+		body.Close()
+		return nil, err
+	}
+	return &decryptedBody{Reader: plaintext, Closer: body}, nil
+}
+
+type decryptedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// RotateDocumentKeys rewraps the data key of every encrypted document,
+// document version and resumable upload that is not wrapped with the active
+// master key, and returns how many were rewrapped. Stored files are not
+// rewritten.
+func (c *controller) RotateDocumentKeys(ctx context.Context) (int, error) {
+	if !c.documentKeys.Enabled() {
+		return 0, fmt.Errorf("no active master key is configured")
+	}
+
+	filter := bson.M{
+		"keyid": bson.M{
+			"$exists": true,
+			"$nin":    bson.A{"", c.documentKeys.ActiveKeyId()},
+		},
+	}
+
+	docs := []*models.Document{}
+	err := c.documentCollection.Find(ctx, filter, &docs)
+	if err != nil {
+		return 0, err
+	}
+
+	versions := []*models.DocumentVersion{}
+	err = c.documentVersionCollection.Find(ctx, filter, &versions)
+	if err != nil {
+		return 0, err
+	}
+
+	uploads := []*models.ResumableUpload{}
+	err = c.resumableUploadCollection.Find(ctx, filter, &uploads)
+	if err != nil {
+		return 0, err
+	}
+
+	rotated := 0
+	for _, doc := range docs {
+		rewrapped, err := c.rewrapDocumentKey(ctx, c.documentCollection, bson.M{"documentid": doc.DocumentId}, doc.KeyId, doc.DocumentId, doc.WrappedKey)
+		if err != nil {
+			return rotated, err
+		}
+		if rewrapped {
+			rotated++
+		}
+	}
+	for _, version := range versions {
+		rewrapped, err := c.rewrapDocumentKey(ctx, c.documentVersionCollection, bson.M{"documentid": version.DocumentId, "version": version.Version}, version.KeyId, version.DocumentId, version.WrappedKey)
+		if err != nil {
+			return rotated, err
+		}
+		if rewrapped {
+			rotated++
+		}
+	}
+	for _, upload := range uploads {
+		rewrapped, err := c.rewrapDocumentKey(ctx, c.resumableUploadCollection, bson.M{"uploadid": upload.UploadId}, upload.KeyId, upload.UploadId, upload.WrappedKey)
+		if err != nil {
+			return rotated, err
+		}
+		if rewrapped {
+			rotated++
+		}
+	}
+	return rotated, nil
+}
+
+// rewrapDocumentKey rewraps a data key bound to ownerId, the id of the
+// document or upload it protects. It reports false, with nothing to do, when
+// the record was deleted or rewrapped by someone else since it was read.
+func (c *controller) rewrapDocumentKey(ctx context.Context, collection mongo.Gateway, filter bson.M, keyId string, ownerId string, wrappedKey []byte) (bool, error) {
+	rewrapped, err := c.documentKeys.Rewrap(keyId, ownerId, wrappedKey)
+	if err != nil {
+		return false, fmt.Errorf("error rewrapping key of %s: %w", ownerId, err)
+	}
+
+	filter["keyid"] = keyId
+	return collection.Update(ctx, filter, bson.M{
+		"keyid":      c.documentKeys.ActiveKeyId(),
+		"wrappedkey": rewrapped,
+	})
+}
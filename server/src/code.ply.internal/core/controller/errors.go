@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"fmt"
+
+	"code.ply.internal/core/auth"
+
+	"code.ply.internal/core/models"
+)
+
+// ConflictError is returned when a request would duplicate or contradict an
+// existing record. ExistingId names that record.
+type ConflictError struct {
+	ExistingId string
+	Message    string
+}
+
+func (e *ConflictError) Error() string {
+	return e.Message
+}
+
+// UnsupportedContentTypeError is returned when an upload's detected media
+// type is not on the configured allowlist.
+type UnsupportedContentTypeError struct {
+	ContentType string
+}
+
+func (e *UnsupportedContentTypeError) Error() string {
+	return "file type " + e.ContentType + " is not allowed"
+}
+
+// ValidationError is returned when a request carries a value the record
+// cannot hold, such as an unknown type or a malformed date.
+type ValidationError struct {
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// UploadTooLargeError is returned when data sent to a resumable upload runs
+// past the size declared when it was created.
+type UploadTooLargeError struct {
+	Size int64
+}
+
+func (e *UploadTooLargeError) Error() string {
+	return fmt.Sprintf("upload exceeds its declared size of %d bytes", e.Size)
+}
+
+// ScanError is returned when a document's file cannot be served because it
+// has not scanned clean.
+type ScanError struct {
+	Status string
+}
+
+func (e *ScanError) Error() string {
+	switch e.Status {
+	case models.ScanInfected:
+		return "document is infected and has been quarantined"
+	case models.ScanFailed:
+		return "document could not be scanned for malware"
+	case models.ScanTooLarge:
+		return "document is too large to be scanned for malware"
+	}
+	return "document has not been scanned for malware yet"
+}
+
+// QuotaExceededError is returned when storing Size more bytes would take a
+// practice past its quota of Quota bytes, of which Used are in use.
+type QuotaExceededError struct {
+	Quota int64
+	Used  int64
+	Size  int64
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("upload of %d bytes exceeds the practice's storage quota: %d of %d bytes in use", e.Size, e.Used, e.Quota)
+}
+
+// SharedLinkError is returned when a shared link with a valid signature may
+// no longer be used, because it expired, was revoked or was already used.
+type SharedLinkError struct {
+	Reason string
+}
+
+func (e *SharedLinkError) Error() string {
+	return e.Reason
+}
+
+// AccessDeniedError is returned when the caller lacks Permission over
+// Resource, which is nil for operations that need admin.
+type AccessDeniedError struct {
+	Permission string
+	Resource   *models.Resource
+}
+
+func (e *AccessDeniedError) Error() string {
+	if e.Resource == nil {
+		return "not allowed to " + e.Permission + " here"
+	}
+	return "not allowed to " + e.Permission + " " + e.Resource.Kind + " " + e.Resource.Id
+}
+
+// AccountLockedError is returned when a second factor is checked for an
+// account locked after too many wrong passwords or codes, until the time
+// Until. Logging in with a password refuses locked accounts with
+// ErrInvalidLogin instead, as it does unknown emails.
+type AccountLockedError struct {
+	Until string
+}
+
+func (e *AccountLockedError) Error() string {
+	return "account is locked after too many failed logins until " + e.Until
+}
+
+// MfaRequiredError is returned when a principal of Kind holding Role, which
+// the MFA policy covers, did not log in with a second factor.
+type MfaRequiredError struct {
+	Role string
+	Kind string
+}
+
+func (e *MfaRequiredError) Error() string {
+	if e.Kind == auth.PrincipalService {
+		return "API keys cannot use multi-factor authentication, which is required for the " + e.Role + " role"
+	}
+	return "multi-factor authentication is required for the " + e.Role + " role"
+}
@@ -0,0 +1,330 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"code.ply.internal/core/gateway/extractor"
+	"code.ply.internal/core/models"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+const defaultExtractionAttempts = 3
+
+// Record fields extracted values can be applied to
+const (
+	TargetProviderName      = "provider.name"
+	TargetProviderSsn       = "provider.ssn"
+	TargetPracticeName      = "practice.name"
+	TargetPracticeEin       = "practice.ein"
+	TargetPracticeOwnerName = "practice.owner_name"
+)
+
+// extractionTargets suggests a target for the labels commonly printed on
+// credentialing documents, keyed by normalizeExtractedKey.
+var extractionTargets = map[string]string{
+	"name":                           TargetProviderName,
+	"provider name":                  TargetProviderName,
+	"physician name":                 TargetProviderName,
+	"full name":                      TargetProviderName,
+	"licensee":                       TargetProviderName,
+	"licensee name":                  TargetProviderName,
+	"ssn":                            TargetProviderSsn,
+	"social security number":         TargetProviderSsn,
+	"social security no":             TargetProviderSsn,
+	"practice name":                  TargetPracticeName,
+	"group name":                     TargetPracticeName,
+	"business name":                  TargetPracticeName,
+	"legal business name":            TargetPracticeName,
+	"ein":                            TargetPracticeEin,
+	"tin":                            TargetPracticeEin,
+	"tax id":                         TargetPracticeEin,
+	"federal tax id":                 TargetPracticeEin,
+	"employer identification number": TargetPracticeEin,
+	"taxpayer identification number": TargetPracticeEin,
+	"owner":                          TargetPracticeOwnerName,
+	"owner name":                     TargetPracticeOwnerName,
+}
+
+// targetFields sets the record field of each target on a provider or
+// practice.
+var targetFields = map[string]func(*models.Provider, *models.Practice, string){
+	TargetProviderName:      func(p *models.Provider, _ *models.Practice, v string) { p.Name = v },
+	TargetProviderSsn:       func(p *models.Provider, _ *models.Practice, v string) { p.Ssn = v },
+	TargetPracticeName:      func(_ *models.Provider, p *models.Practice, v string) { p.Name = v },
+	TargetPracticeEin:       func(_ *models.Provider, p *models.Practice, v string) { p.Ein = v },
+	TargetPracticeOwnerName: func(_ *models.Provider, p *models.Practice, v string) { p.OwnerName = v },
+}
+
+var nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
+
+func normalizeExtractedKey(key string) string {
+	return strings.TrimSpace(nonAlphanumeric.ReplaceAllString(strings.ToLower(key), " "))
+}
+
+// QueueDocumentExtraction queues a document to have its fields extracted
+// again, replacing any fields found before.
+func (c *controller) QueueDocumentExtraction(ctx context.Context, documentId string) error {
+	if c.extractor == nil {
+		return errors.New("document extraction is disabled")
+	}
+
+	doc, err := c.GetDocument(ctx, documentId)
+	if err != nil {
+		return err
+	}
+
+	if err := c.queueExtraction(ctx, doc); err != nil {
+		return err
+	}
+	return c.documentCollection.Upsert(ctx, bson.M{"documentid": documentId}, bson.M{
+		"extractionstatus": doc.ExtractionStatus,
+		"extractedfields":  doc.ExtractedFields,
+	})
+}
+
+// queueExtraction adds an extraction job for doc and marks doc pending. The
+// caller saves doc. Jobs whose document was never saved are dropped when
+// they run.
+func (c *controller) queueExtraction(ctx context.Context, doc *models.Document) error {
+	if c.extractor == nil {
+		return nil
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	job := &models.ExtractionJob{
+		JobId:      uuid.New().String(),
+		DocumentId: doc.DocumentId,
+		Status:     models.ExtractionPending,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	err := c.extractionJobCollection.Upsert(ctx, bson.M{"jobid": job.JobId}, job)
+	if err != nil {
+		return err
+	}
+
+	doc.ExtractionStatus = models.ExtractionPending
+	doc.ExtractedFields = nil
+	return nil
+}
+
+// ProcessExtractionJobs runs every queued extraction job, and retries failed
+// ones until they reach the configured number of attempts. It returns how
+// many jobs ran. Jobs left running by an interrupted pass are picked up
+// again, so only one pass may run at a time.
+func (c *controller) ProcessExtractionJobs(ctx context.Context) (int, error) {
+	if c.extractor == nil {
+		return 0, nil
+	}
+
+	maxAttempts := c.extraction.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultExtractionAttempts
+	}
+
+	jobs := []*models.ExtractionJob{}
+	err := c.extractionJobCollection.Find(ctx, bson.M{
+		"$or": bson.A{
+			bson.M{"status": bson.M{"$in": bson.A{models.ExtractionPending, models.ExtractionRunning}}},
+			bson.M{"status": models.ExtractionFailed, "attempts": bson.M{"$lt": maxAttempts}},
+		},
+	}, &jobs)
+	if err != nil {
+		return 0, err
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].CreatedAt < jobs[j].CreatedAt
+	})
+
+	var errs []error
+	for _, job := range jobs {
+		if err := c.runExtractionJob(ctx, job); err != nil {
+			errs = append(errs, fmt.Errorf("extraction of document %s: %w", job.DocumentId, err))
+		}
+	}
+	return len(jobs), errors.Join(errs...)
+}
+
+func (c *controller) runExtractionJob(ctx context.Context, job *models.ExtractionJob) error {
+	doc, err := c.GetDocument(ctx, job.DocumentId)
+	if err == mongodriver.ErrNoDocuments {
+		return c.extractionJobCollection.DeleteOne(ctx, bson.M{"jobid": job.JobId})
+	}
+	if err != nil {
+		return err
+	}
+
+	// Jobs wait for their file to be scanned before it is sent anywhere
+	if c.scanner != nil && (doc.ScanStatus == "" || doc.ScanStatus == models.ScanFailed) {
+		return nil
+	}
+
+	job.Attempts++
+	if err := c.setExtractionStatus(ctx, job, doc, models.ExtractionRunning, ""); err != nil {
+		return err
+	}
+
+	fields, err := c.extractDocument(ctx, doc)
+	if err != nil {
+		if statusErr := c.setExtractionStatus(ctx, job, doc, models.ExtractionFailed, err.Error()); statusErr != nil {
+			return statusErr
+		}
+		return err
+	}
+
+	err = c.documentCollection.Upsert(ctx, bson.M{"documentid": doc.DocumentId}, bson.M{
+		"extractedfields": fields,
+	})
+	if err != nil {
+		return err
+	}
+	return c.setExtractionStatus(ctx, job, doc, models.ExtractionCompleted, "")
+}
+
+func (c *controller) extractDocument(ctx context.Context, doc *models.Document) ([]*models.ExtractedField, error) {
+	// Only files that may be downloaded are passed on to the extraction
+	// service, so none that have not scanned clean while scanning is enabled
+	if err := c.DocumentDownloadable(doc); err != nil {
+		return nil, err
+	}
+
+	content, err := c.OpenDocument(ctx, doc, 0, -1)
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	found, err := c.extractor.Extract(ctx, extractor.Document{
+		DocumentId:  doc.DocumentId,
+		FileName:    doc.FileName,
+		ContentType: doc.ContentType,
+	}, content)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := []*models.ExtractedField{}
+	for _, field := range found {
+		fields = append(fields, &models.ExtractedField{
+			Key:        field.Key,
+			Value:      field.Value,
+			Confidence: field.Confidence,
+			Page:       field.Page,
+			Target:     extractionTargets[normalizeExtractedKey(field.Key)],
+		})
+	}
+	return fields, nil
+}
+
+func (c *controller) setExtractionStatus(ctx context.Context, job *models.ExtractionJob, doc *models.Document, status string, message string) error {
+	job.Status = status
+	job.Error = message
+	job.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	err := c.extractionJobCollection.Upsert(ctx, bson.M{"jobid": job.JobId}, job)
+	if err != nil {
+		return err
+	}
+
+	return c.documentCollection.Upsert(ctx, bson.M{"documentid": doc.DocumentId}, bson.M{
+		"extractionstatus": status,
+	})
+}
+
+// ApplyExtractedFields copies reviewed extracted values onto the document's
+// provider or practice. Each application names an extracted field by key and
+// may override its target and value. The records are validated as their
+// updates are, the provider must be affiliated with the document's
+// practice, and practice targets need manage on the practice.
+func (c *controller) ApplyExtractedFields(ctx context.Context, documentId string, applications []*models.ExtractedField) error {
+	doc, err := c.GetDocument(ctx, documentId)
+	if err != nil {
+		return err
+	}
+
+	var provider *models.Provider
+	var practice *models.Practice
+	for _, application := range applications {
+		field := findExtractedField(doc.ExtractedFields, application.Key)
+		if field == nil {
+			return &ValidationError{Message: fmt.Sprintf("no extracted field %q", application.Key)}
+		}
+
+		target := field.Target
+		if application.Target != "" {
+			target = application.Target
+		}
+		value := field.Value
+		if application.Value != "" {
+			value = application.Value
+		}
+
+		setField, ok := targetFields[target]
+		if !ok {
+			return &ValidationError{Message: fmt.Sprintf("unknown target %q for field %q", target, field.Key)}
+		}
+		if strings.HasPrefix(target, "provider.") && provider == nil {
+			if provider, err = c.extractionProvider(ctx, doc); err != nil {
+				return err
+			}
+		}
+		if strings.HasPrefix(target, "practice.") && practice == nil {
+			// Writing the document is enough to fill in its provider, but the
+			// practice's own record takes what a direct update of it takes.
+			resource := &models.Resource{Kind: models.ResourcePractice, Id: doc.PracticeId}
+			if err := c.Authorize(ctx, models.PermissionManage, []*models.Resource{resource}); err != nil {
+				return err
+			}
+			practice, err = c.ReadPractice(ctx, doc.PracticeId)
+			if err := linkError(err, "practice", doc.PracticeId); err != nil {
+				return err
+			}
+		}
+		setField(provider, practice, value)
+		field.Applied = true
+	}
+
+	if provider != nil {
+		if err := c.UpdateProvider(ctx, provider); err != nil {
+			return err
+		}
+	}
+	if practice != nil {
+		if err := c.UpdatePractice(ctx, practice); err != nil {
+			return err
+		}
+	}
+
+	return c.documentCollection.Upsert(ctx, bson.M{"documentid": documentId}, bson.M{
+		"extractedfields": doc.ExtractedFields,
+	})
+}
+
+// extractionProvider returns the provider a document is linked to, once it
+// is checked to be affiliated with the document's practice.
+func (c *controller) extractionProvider(ctx context.Context, doc *models.Document) (*models.Provider, error) {
+	if doc.ProviderId == "" {
+		return nil, &ValidationError{Message: "document is not linked to a provider"}
+	}
+	if err := c.checkProviderPractice(ctx, doc.ProviderId, doc.PracticeId); err != nil {
+		return nil, err
+	}
+	return c.ReadProvider(ctx, doc.ProviderId)
+}
+
+func findExtractedField(fields []*models.ExtractedField, key string) *models.ExtractedField {
+	for _, field := range fields {
+		if field.Key == key {
+			return field
+		}
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"code.ply.internal/core/gateway/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// EnsureIndexes creates the indexes queries and uniqueness rely on, so it
+// runs before the service starts.
+func (c *controller) EnsureIndexes(ctx context.Context) error {
+	indexes := []struct {
+		collection mongo.Gateway
+		name       string
+		unique     bool
+		partial    interface{}
+		keys       []string
+	}{
+		{c.documentTextCollection, "document text", false, nil, []string{"practiceid", "terms"}},
+		{c.documentVersionCollection, "document versions", true, nil, []string{"documentid", "version"}},
+		// Only active enrollments have an identity key
+		{c.enrollmentCollection, "active enrollments", true, bson.M{"identitykey": bson.M{"$gt": ""}}, []string{"identitykey"}},
+	}
+
+	for _, index := range indexes {
+		if err := index.collection.EnsureIndex(ctx, index.unique, index.partial, index.keys...); err != nil {
+			return fmt.Errorf("error indexing %s: %w", index.name, err)
+		}
+	}
+	return nil
+}
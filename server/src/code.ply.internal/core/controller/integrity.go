@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	"code.ply.internal/core/gateway/storage"
+	"code.ply.internal/core/models"
+	"go.mongodb.org/mongo-driver/bson"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+// VerifyDocuments re-hashes the stored file of every document, or of one
+// practice's documents, and reports files that are missing or no longer match
+// their checksum. Documents uploaded before checksums existed have theirs
+// recorded instead.
+func (c *controller) VerifyDocuments(ctx context.Context, practiceId string) (*models.VerificationReport, error) {
+	filter := bson.M{}
+	if practiceId != "" {
+		filter["practiceid"] = practiceId
+	}
+
+	docs := []*models.Document{}
+	err := c.documentCollection.Find(ctx, filter, &docs)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.VerificationReport{}
+	for _, doc := range docs {
+		report.Checked++
+
+		checksum, size, err := c.hashDocument(ctx, doc)
+		if err != nil {
+			status := models.VerificationError
+			if errors.Is(err, storage.ErrNotFound) {
+				status = models.VerificationMissing
+			}
+			report.Problems = append(report.Problems, &models.DocumentVerification{
+				DocumentId:     doc.DocumentId,
+				PracticeId:     doc.PracticeId,
+				Status:         status,
+				ExpectedSha256: doc.Sha256,
+				Message:        err.Error(),
+			})
+			continue
+		}
+
+		// Only the checksum and size are recorded, and only if the file
+		// hashed is still the document's and still has none
+		if doc.Sha256 == "" {
+			recorded, err := c.documentCollection.Update(ctx, bson.M{
+				"documentid":  doc.DocumentId,
+				"storagepath": doc.StoragePath,
+				"sha256":      bson.M{"$in": bson.A{"", nil}},
+			}, bson.M{"sha256": checksum, "size": size})
+			if err != nil {
+				return nil, err
+			}
+			if recorded {
+				report.Recorded++
+			}
+			continue
+		}
+
+		if checksum != doc.Sha256 {
+			report.Problems = append(report.Problems, &models.DocumentVerification{
+				DocumentId:     doc.DocumentId,
+				PracticeId:     doc.PracticeId,
+				Status:         models.VerificationCorrupt,
+				ExpectedSha256: doc.Sha256,
+				ActualSha256:   checksum,
+			})
+		}
+	}
+	return report, nil
+}
+
+func (c *controller) hashDocument(ctx context.Context, doc *models.Document) (string, int64, error) {
+	body, err := c.OpenDocument(ctx, doc, 0, -1)
+	if err != nil {
+		return "", 0, err
+	}
+	defer body.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, body)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+// findDocumentByChecksum returns the practice's document with the given
+// content, or nil when there is none.
+func (c *controller) findDocumentByChecksum(ctx context.Context, practiceId string, checksum string) (*models.Document, error) {
+	doc := &models.Document{}
+	err := c.documentCollection.FindOne(ctx, bson.M{"practiceid": practiceId, "sha256": checksum}, doc)
+	if err == mongodriver.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
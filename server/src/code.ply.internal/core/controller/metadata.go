@@ -0,0 +1,152 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"code.ply.internal/core/models"
+	"go.mongodb.org/mongo-driver/bson"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+var documentTypes = map[string]bool{
+	models.DocumentTypeW9:                 true,
+	models.DocumentTypeLicense:            true,
+	models.DocumentTypeCOI:                true,
+	models.DocumentTypeCV:                 true,
+	models.DocumentTypeBoardCertification: true,
+	models.DocumentTypePayerLetter:        true,
+	models.DocumentTypeDEA:                true,
+	models.DocumentTypeOther:              true,
+}
+
+// UpdateDocumentMetadata replaces a document's type, links and expiration
+// date with those of metadata. Empty fields clear the stored value.
+func (c *controller) UpdateDocumentMetadata(ctx context.Context, documentId string, metadata *models.Document) error {
+	doc, err := c.GetDocument(ctx, documentId)
+	if err != nil {
+		return err
+	}
+
+	if err := c.validateDocumentMetadata(ctx, doc.PracticeId, metadata); err != nil {
+		return err
+	}
+
+	// Usage by type follows the document to its new type
+	var size int64
+	retyped := documentUsageType(metadata.DocumentType) != documentUsageType(doc.DocumentType)
+	if retyped {
+		if _, err := c.storageUsage(ctx, doc.PracticeId); err != nil {
+			return err
+		}
+		if size, err = c.documentBytes(ctx, doc); err != nil {
+			return err
+		}
+	}
+
+	err = c.documentCollection.Upsert(ctx, bson.M{"documentid": documentId}, bson.M{
+		"documenttype":   metadata.DocumentType,
+		"providerid":     metadata.ProviderId,
+		"locationid":     metadata.LocationId,
+		"enrollmentid":   metadata.EnrollmentId,
+		"expirationdate": metadata.ExpirationDate,
+	})
+	if err != nil {
+		return err
+	}
+
+	if retyped {
+		c.chargeUsage(ctx, doc.PracticeId, doc.DocumentType, -size, -1)
+		c.chargeUsage(ctx, doc.PracticeId, metadata.DocumentType, size, 1)
+	}
+	return nil
+}
+
+// validateDocumentMetadata checks the document type and expiration date and
+// that the linked provider, location and enrollment belong to the practice.
+// Providers can work across practices, so they need only be affiliated with
+// it.
+func (c *controller) validateDocumentMetadata(ctx context.Context, practiceId string, metadata *models.Document) error {
+	if metadata.DocumentType != "" && !documentTypes[metadata.DocumentType] {
+		return &ValidationError{Message: fmt.Sprintf("unknown document type %q", metadata.DocumentType)}
+	}
+
+	if metadata.ExpirationDate != "" {
+		if _, err := time.Parse(models.DateLayout, metadata.ExpirationDate); err != nil {
+			return &ValidationError{Message: fmt.Sprintf("invalid expiration date %q", metadata.ExpirationDate)}
+		}
+	}
+
+	if metadata.ProviderId != "" {
+		if err := c.checkProviderPractice(ctx, metadata.ProviderId, practiceId); err != nil {
+			return err
+		}
+	}
+
+	if metadata.LocationId != "" {
+		location, err := c.ReadLocation(ctx, metadata.LocationId)
+		if err := linkError(err, "location", metadata.LocationId); err != nil {
+			return err
+		}
+		if location.PracticeId != practiceId {
+			return &ValidationError{Message: fmt.Sprintf("location %s belongs to another practice", metadata.LocationId)}
+		}
+	}
+
+	if metadata.EnrollmentId != "" {
+		enrollment, err := c.ReadEnrollment(ctx, metadata.EnrollmentId)
+		if err := linkError(err, "enrollment", metadata.EnrollmentId); err != nil {
+			return err
+		}
+		if enrollment.PracticeId != practiceId {
+			return &ValidationError{Message: fmt.Sprintf("enrollment %s belongs to another practice", metadata.EnrollmentId)}
+		}
+	}
+
+	return nil
+}
+
+// checkProviderPractice returns a *ValidationError unless the provider exists
+// and is affiliated with the practice, so documents and what is extracted
+// from them reach only providers the practice's grants cover.
+func (c *controller) checkProviderPractice(ctx context.Context, providerId string, practiceId string) error {
+	practiceIds, err := c.providerPractices(ctx, providerId)
+	if err := linkError(err, "provider", providerId); err != nil {
+		return err
+	}
+	for _, id := range practiceIds {
+		if id == practiceId {
+			return nil
+		}
+	}
+	return &ValidationError{Message: fmt.Sprintf("provider %s is not affiliated with the practice", providerId)}
+}
+
+func linkError(err error, kind string, id string) error {
+	if err == mongodriver.ErrNoDocuments {
+		return &ValidationError{Message: fmt.Sprintf("%s %s does not exist", kind, id)}
+	}
+	return err
+}
+
+func documentFilter(practiceId string, filter *models.DocumentFilter) bson.M {
+	query := bson.M{"practiceid": practiceId}
+	if filter == nil {
+		return query
+	}
+
+	if filter.DocumentType != "" {
+		query["documenttype"] = filter.DocumentType
+	}
+	if filter.ProviderId != "" {
+		query["providerid"] = filter.ProviderId
+	}
+	if filter.LocationId != "" {
+		query["locationid"] = filter.LocationId
+	}
+	if filter.EnrollmentId != "" {
+		query["enrollmentid"] = filter.EnrollmentId
+	}
+	return query
+}
@@ -0,0 +1,426 @@
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"code.ply.internal/core/auth"
+	"code.ply.internal/core/config"
+	"code.ply.internal/core/encryption"
+	"code.ply.internal/core/models"
+	"go.mongodb.org/mongo-driver/bson"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	defaultMfaIssuer = "Ply"
+
+	recoveryCodeCount = 10
+
+	// totpKeyLabel derives the key TOTP secrets are encrypted with from the
+	// session signing key
+	totpKeyLabel = "totp"
+)
+
+var (
+	ErrInvalidMfaCode      = errors.New("multi-factor code is incorrect")
+	ErrInvalidMfaChallenge = errors.New("login has expired, log in again")
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// loadTotpKey reads the session signing key and derives the key TOTP secrets
+// are encrypted with from it. It returns nil when none is configured.
+func loadTotpKey(cfg config.AccountsConfig) ([]byte, error) {
+	if cfg.SigningKeyFile == "" && cfg.SigningKeyEnv == "" {
+		return nil, nil
+	}
+	key, err := encryption.LoadKey(encryption.KeyParams{File: cfg.SigningKeyFile, Env: cfg.SigningKeyEnv})
+	if err != nil {
+		return nil, fmt.Errorf("error loading session signing key: %w", err)
+	}
+	return encryption.DeriveKey(key, totpKeyLabel), nil
+}
+
+// StartMfaEnrollment gives the user logged in on ctx a new TOTP secret,
+// which takes effect once ConfirmMfaEnrollment checks a code from it.
+func (c *controller) StartMfaEnrollment(ctx context.Context) (*models.MfaEnrollment, error) {
+	user, err := c.sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user.MfaEnabled {
+		return nil, &ConflictError{ExistingId: user.UserId, Message: "multi-factor authentication is already enabled"}
+	}
+
+	secret, err := auth.GenerateTotpSecret()
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := encryption.Seal(c.totpKey, secret, user.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.userCollection.Update(ctx, bson.M{"userid": user.UserId}, bson.M{"pendingtotpsecret": sealed}); err != nil {
+		return nil, err
+	}
+
+	issuer := c.accounts.MfaIssuer
+	if issuer == "" {
+		issuer = defaultMfaIssuer
+	}
+	return &models.MfaEnrollment{
+		Secret:          auth.EncodeTotpSecret(secret),
+		ProvisioningUri: auth.TotpProvisioningUri(issuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmMfaEnrollment enables multi-factor authentication for the user
+// logged in on ctx once code checks out against their new secret. It returns
+// their recovery codes and a session that counts as multi-factor.
+func (c *controller) ConfirmMfaEnrollment(ctx context.Context, code string) (*models.MfaRecoveryCodes, error) {
+	user, err := c.sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user.PendingTotpSecret == nil {
+		return nil, &ValidationError{Message: "no multi-factor enrollment is in progress"}
+	}
+	secret, err := encryption.Open(c.totpKey, user.PendingTotpSecret, user.UserId)
+	if err != nil {
+		return nil, err
+	}
+	step, ok := auth.VerifyTotp(secret, code, time.Now(), user.LastTotpStep)
+	if !ok {
+		return nil, ErrInvalidMfaCode
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.userCollection.Update(ctx, bson.M{"userid": user.UserId}, bson.M{
+		"mfaenabled":        true,
+		"totpsecret":        user.PendingTotpSecret,
+		"pendingtotpsecret": nil,
+		"lasttotpstep":      step,
+		"recoverycodes":     hashes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	user.MfaEnabled = true
+	session, err := c.startSession(ctx, user, true)
+	if err != nil {
+		return nil, err
+	}
+	return &models.MfaRecoveryCodes{RecoveryCodes: codes, Session: session}, nil
+}
+
+// DisableMfa turns multi-factor authentication off for the user logged in
+// on ctx, given a current code. Users whose roles require it cannot.
+func (c *controller) DisableMfa(ctx context.Context, code string) error {
+	user, err := c.sessionUser(ctx)
+	if err != nil {
+		return err
+	}
+	if !user.MfaEnabled {
+		return &ValidationError{Message: "multi-factor authentication is not enabled"}
+	}
+	policy, err := c.ReadMfaPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	role, err := c.mfaRequiredRole(ctx, policy, models.SubjectAccount, user.UserId)
+	if err != nil {
+		return err
+	}
+	if role != "" {
+		return &ConflictError{ExistingId: user.UserId, Message: "multi-factor authentication is required for the " + role + " role"}
+	}
+	if err := c.checkSecondFactor(ctx, user, code); err != nil {
+		return err
+	}
+
+	_, err = c.userCollection.Update(ctx, bson.M{"userid": user.UserId}, mfaResetFields())
+	return err
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the user logged in
+// on ctx, given a current code.
+func (c *controller) RegenerateRecoveryCodes(ctx context.Context, code string) (*models.MfaRecoveryCodes, error) {
+	user, err := c.sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !user.MfaEnabled {
+		return nil, &ValidationError{Message: "multi-factor authentication is not enabled"}
+	}
+	if err := c.checkSecondFactor(ctx, user, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.userCollection.Update(ctx, bson.M{"userid": user.UserId}, bson.M{"recoverycodes": hashes}); err != nil {
+		return nil, err
+	}
+	return &models.MfaRecoveryCodes{RecoveryCodes: codes}, nil
+}
+
+// CompleteMfaLogin exchanges the token Login gave a user with multi-factor
+// authentication, and a TOTP or recovery code, for a session. Wrong codes
+// count towards locking the account as wrong passwords do.
+func (c *controller) CompleteMfaLogin(ctx context.Context, mfaToken string, code string) (*models.Session, error) {
+	if c.sessions == nil {
+		return nil, ErrAccountsDisabled
+	}
+	userId, err := c.sessions.VerifyChallenge(mfaToken)
+	if err != nil {
+		return nil, ErrInvalidMfaChallenge
+	}
+
+	user := &models.User{}
+	err = c.userCollection.FindOne(ctx, bson.M{"userid": userId}, user)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return nil, ErrInvalidMfaChallenge
+	}
+	if err != nil {
+		return nil, err
+	}
+	if user.Status != models.UserActive || !user.MfaEnabled {
+		return nil, ErrInvalidMfaChallenge
+	}
+
+	if err := c.checkSecondFactor(ctx, user, code); err != nil {
+		return nil, err
+	}
+	return c.startSession(ctx, user, true)
+}
+
+// checkSecondFactor verifies a code of user as verifySecondFactor does, but
+// counts wrong codes towards locking the account as wrong passwords do and
+// refuses codes while it is locked. Wherever a code is asked for, it could
+// otherwise be guessed by someone who has only the password.
+func (c *controller) checkSecondFactor(ctx context.Context, user *models.User, code string) error {
+	now := time.Now()
+	if user.LockedUntil != "" {
+		lockedUntil, err := time.Parse(time.RFC3339, user.LockedUntil)
+		if err == nil && now.Before(lockedUntil) {
+			return &AccountLockedError{Until: user.LockedUntil}
+		}
+	}
+
+	err := c.verifySecondFactor(ctx, user, code)
+	if errors.Is(err, ErrInvalidMfaCode) {
+		if err := c.recordFailedLogin(ctx, user, now); err != nil {
+			return err
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if user.FailedLogins != 0 || user.LockedUntil != "" {
+		_, err := c.userCollection.Update(ctx, bson.M{"userid": user.UserId}, bson.M{"failedlogins": 0, "lockeduntil": ""})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifySecondFactor checks a TOTP code, or uses up a recovery code, of
+// user. It returns ErrInvalidMfaCode when code is neither.
+func (c *controller) verifySecondFactor(ctx context.Context, user *models.User, code string) error {
+	secret, err := encryption.Open(c.totpKey, user.TotpSecret, user.UserId)
+	if err != nil {
+		return err
+	}
+
+	// Each code is claimed by moving past its time step, so it is used once
+	// even when raced for
+	if step, ok := auth.VerifyTotp(secret, code, time.Now(), user.LastTotpStep); ok {
+		claimed, err := c.userCollection.Update(ctx, bson.M{"userid": user.UserId, "lasttotpstep": user.LastTotpStep}, bson.M{
+			"lasttotpstep": step,
+		})
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			return ErrInvalidMfaCode
+		}
+		user.LastTotpStep = step
+		return nil
+	}
+
+	hash := hashUserToken(normalizeRecoveryCode(code))
+	remaining := []string{}
+	for _, recoveryCode := range user.RecoveryCodes {
+		if recoveryCode != hash {
+			remaining = append(remaining, recoveryCode)
+		}
+	}
+	if len(remaining) == len(user.RecoveryCodes) {
+		return ErrInvalidMfaCode
+	}
+	claimed, err := c.userCollection.Update(ctx, bson.M{"userid": user.UserId, "recoverycodes": hash}, bson.M{
+		"recoverycodes": remaining,
+	})
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return ErrInvalidMfaCode
+	}
+	user.RecoveryCodes = remaining
+	return nil
+}
+
+// CheckMfa returns an *MfaRequiredError when the principal on ctx holds a
+// role the MFA policy covers but did not log in with a second factor. Users
+// of the configured issuer count as having done so when their token's amr
+// claim says so, and services never do unless the policy exempts them.
+func (c *controller) CheckMfa(ctx context.Context) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || principal.Mfa {
+		return nil
+	}
+	policy, err := c.ReadMfaPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if principal.Kind == auth.PrincipalService && policy.ExemptServices {
+		return nil
+	}
+	role, err := c.mfaRequiredRole(ctx, policy, principalSubjectKind(principal), principal.Subject)
+	if err != nil {
+		return err
+	}
+	if role != "" {
+		return &MfaRequiredError{Role: role, Kind: principal.Kind}
+	}
+	return nil
+}
+
+// mfaRequiredRole returns a role the subject of subjectKind holds that
+// policy covers, or "" when it holds none.
+func (c *controller) mfaRequiredRole(ctx context.Context, policy *models.MfaPolicy, subjectKind string, subject string) (string, error) {
+	if len(policy.RequiredRoles) == 0 {
+		return "", nil
+	}
+	required := map[string]bool{}
+	for _, role := range policy.RequiredRoles {
+		required[role] = true
+	}
+
+	if c.admins[adminKey(subjectKind, subject)] && required[models.RoleAdmin] {
+		return models.RoleAdmin, nil
+	}
+	grants, err := c.ListRoleGrants(ctx, subjectKind, subject)
+	if err != nil {
+		return "", err
+	}
+	for _, grant := range grants {
+		if required[grant.Role] {
+			return grant.Role, nil
+		}
+	}
+	return "", nil
+}
+
+// ReadMfaPolicy returns the MFA policy, which requires no one to use
+// multi-factor authentication until it is set.
+func (c *controller) ReadMfaPolicy(ctx context.Context) (*models.MfaPolicy, error) {
+	policy := &models.MfaPolicy{}
+	err := c.mfaPolicyCollection.FindOne(ctx, bson.M{}, policy)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return &models.MfaPolicy{RequiredRoles: []string{}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if policy.RequiredRoles == nil {
+		policy.RequiredRoles = []string{}
+	}
+	return policy, nil
+}
+
+func (c *controller) UpdateMfaPolicy(ctx context.Context, policy *models.MfaPolicy) (*models.MfaPolicy, error) {
+	for _, role := range policy.RequiredRoles {
+		if _, ok := roleLevels[role]; !ok {
+			return nil, &ValidationError{Message: "unknown role " + role}
+		}
+	}
+	if policy.RequiredRoles == nil {
+		policy.RequiredRoles = []string{}
+	}
+
+	policy.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	if err := c.mfaPolicyCollection.Upsert(ctx, bson.M{}, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// ResetUserMfa turns off multi-factor authentication for a user who lost
+// their authenticator and recovery codes, and ends their sessions. They can
+// enroll again after logging in with their password.
+func (c *controller) ResetUserMfa(ctx context.Context, userId string) error {
+	fields := mfaResetFields()
+	fields["tokensvalidafter"] = time.Now().UTC().Format(time.RFC3339)
+	matched, err := c.userCollection.Update(ctx, bson.M{"userid": userId}, fields)
+	if err != nil {
+		return err
+	}
+	if !matched {
+		return mongodriver.ErrNoDocuments
+	}
+	return nil
+}
+
+// mfaResetFields are the user fields that turn multi-factor authentication
+// off.
+func mfaResetFields() bson.M {
+	return bson.M{
+		"mfaenabled":        false,
+		"totpsecret":        nil,
+		"pendingtotpsecret": nil,
+		"lasttotpstep":      0,
+		"recoverycodes":     []string{},
+	}
+}
+
+// generateRecoveryCodes returns new recovery codes and the hashes they are
+// kept by.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		random := make([]byte, 8)
+		if _, err := rand.Read(random); err != nil {
+			return nil, nil, err
+		}
+		encoded := strings.ToLower(recoveryCodeEncoding.EncodeToString(random))[:10]
+		codes[i] = encoded[:5] + "-" + encoded[5:]
+		hashes[i] = hashUserToken(encoded)
+	}
+	return codes, hashes, nil
+}
+
+// normalizeRecoveryCode lets recovery codes be typed without their dash or
+// in upper case.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"context"
+
+	"code.ply.internal/core/models"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (c *controller) CreateOrganization(ctx context.Context, organization *models.Organization) (string, error) {
+	organization.OrganizationId = uuid.New().String()
+	err := c.organizationCollection.Upsert(ctx, bson.M{"organizationid": organization.OrganizationId}, organization)
+	if err != nil {
+		return "", err
+	}
+	return organization.OrganizationId, nil
+}
+
+func (c *controller) ListOrganizations(ctx context.Context) ([]*models.Organization, error) {
+	organizations := []*models.Organization{}
+	err := c.organizationCollection.Find(ctx, bson.M{}, &organizations)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only organizations granted as a whole are listed
+	granted, err := c.callerAccess(ctx)
+	if err != nil || granted == nil {
+		return organizations, err
+	}
+	visible := []*models.Organization{}
+	for _, organization := range organizations {
+		if granted.organizations[organization.OrganizationId] > 0 {
+			visible = append(visible, organization)
+		}
+	}
+	return visible, nil
+}
+
+func (c *controller) ReadOrganization(ctx context.Context, organizationId string) (*models.Organization, error) {
+	organization := &models.Organization{}
+	err := c.organizationCollection.FindOne(ctx, bson.M{"organizationid": organizationId}, organization)
+	if err != nil {
+		return nil, err
+	}
+	return organization, nil
+}
+
+func (c *controller) UpdateOrganization(ctx context.Context, organization *models.Organization) error {
+	return c.organizationCollection.Upsert(ctx, bson.M{"organizationid": organization.OrganizationId}, organization)
+}
+
+func (c *controller) ListOrganizationPractices(ctx context.Context, organizationId string) ([]*models.Practice, error) {
+	practices := []*models.Practice{}
+	err := c.practiceCollection.Find(ctx, bson.M{"organizationid": organizationId}, &practices)
+	if err != nil {
+		return nil, err
+	}
+	return practices, nil
+}
+
+func (c *controller) ListOrganizationProviders(ctx context.Context, organizationId string) ([]*models.Provider, error) {
+	practiceIds, err := c.organizationPracticeIds(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	// a provider affiliated with several child practices is listed once
+	seen := map[string]bool{}
+	providers := []*models.Provider{}
+	for _, practiceId := range practiceIds {
+		practiceProviders, err := c.ListProviders(ctx, practiceId)
+		if err != nil {
+			return nil, err
+		}
+		for _, provider := range practiceProviders {
+			if seen[provider.ProviderId] {
+				continue
+			}
+			seen[provider.ProviderId] = true
+			providers = append(providers, provider)
+		}
+	}
+	return providers, nil
+}
+
+func (c *controller) ListOrganizationEnrollments(ctx context.Context, organizationId string) ([]*models.Enrollment, error) {
+	practiceIds, err := c.organizationPracticeIds(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollments := []*models.Enrollment{}
+	err = c.enrollmentCollection.Find(ctx, bson.M{"practiceid": bson.M{"$in": practiceIds}}, &enrollments)
+	if err != nil {
+		return nil, err
+	}
+	return enrollments, nil
+}
+
+func (c *controller) ListOrganizationOpenTasks(ctx context.Context, organizationId string) ([]*models.Task, error) {
+	practiceIds, err := c.organizationPracticeIds(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := []*models.Task{}
+	err = c.taskCollection.Find(ctx, bson.M{
+		"practiceid": bson.M{"$in": practiceIds},
+		"status":     bson.M{"$ne": models.TaskStatusCompleted},
+	}, &tasks)
+	if err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+func (c *controller) organizationPracticeIds(ctx context.Context, organizationId string) ([]string, error) {
+	practices, err := c.ListOrganizationPractices(ctx, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	practiceIds := make([]string, 0, len(practices))
+	for _, practice := range practices {
+		practiceIds = append(practiceIds, practice.PracticeId)
+	}
+	return practiceIds, nil
+}
@@ -0,0 +1,174 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"path"
+
+	"code.ply.internal/core/encryption"
+	"code.ply.internal/core/models"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// previewPrefix is the storage prefix generated previews are kept under
+	previewPrefix = "previews"
+
+	// previewKeyLabel, with a preview's salt, derives the key a preview of an
+	// encrypted document is encrypted with from the document's data key
+	previewKeyLabel = "preview"
+
+	previewSaltSize = 16
+)
+
+var ErrPreviewUnavailable = errors.New("no preview is available for this document")
+
+// GeneratePreviews generates a preview of the current version of every
+// document that has none yet, and returns how many it generated. Documents
+// that may not be downloaded yet, such as ones still to be scanned, are left
+// for a later run.
+func (c *controller) GeneratePreviews(ctx context.Context) (int, error) {
+	// Documents from before versioning have no current version, and ones
+	// never previewed no preview version
+	docs := []*models.Document{}
+	err := c.documentCollection.Find(ctx, bson.M{
+		"$expr": bson.M{"$ne": bson.A{
+			bson.M{"$ifNull": bson.A{"$previewversion", -1}},
+			bson.M{"$ifNull": bson.A{"$currentversion", 0}},
+		}},
+	}, &docs)
+	if err != nil {
+		return 0, err
+	}
+
+	generated := 0
+	var errs []error
+	for _, doc := range docs {
+		if c.DocumentDownloadable(doc) != nil {
+			continue
+		}
+
+		status := models.PreviewReady
+		if !c.previews.Supports(doc.ContentType) {
+			status = models.PreviewUnsupported
+		} else if err := c.generatePreview(ctx, doc); err != nil {
+			log.Printf("error generating preview of document %s: %v", doc.DocumentId, err)
+			status = models.PreviewFailed
+		}
+
+		saved, err := c.documentCollection.Update(ctx, bson.M{"documentid": doc.DocumentId}, bson.M{
+			"previewversion": doc.CurrentVersion,
+			"previewstatus":  status,
+			"previewpath":    doc.PreviewPath,
+			"previewsize":    doc.PreviewSize,
+			"previewsalt":    doc.PreviewSalt,
+		})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		// The document was deleted while its preview was made
+		if !saved {
+			if status == models.PreviewReady {
+				c.documentStorage.Delete(ctx, doc.PreviewPath)
+			}
+			continue
+		}
+		if status == models.PreviewReady {
+			generated++
+		}
+	}
+	return generated, errors.Join(errs...)
+}
+
+// generatePreview renders and stores a preview of doc's current file,
+// encrypted when the file is, and records where on doc.
+func (c *controller) generatePreview(ctx context.Context, doc *models.Document) error {
+	content, err := c.OpenDocument(ctx, doc, 0, -1)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	preview, err := c.previews.Generate(ctx, doc.ContentType, content)
+	if err != nil {
+		return err
+	}
+
+	// Segment nonces follow from their position, so a preview generated
+	// again, whose bytes may differ, must not reuse the key of the last one
+	var body io.Reader = bytes.NewReader(preview)
+	var salt []byte
+	if doc.WrappedKey != nil {
+		salt = make([]byte, previewSaltSize)
+		if _, err := rand.Read(salt); err != nil {
+			return err
+		}
+		key, err := c.previewKey(doc, salt)
+		if err != nil {
+			return err
+		}
+		body, err = encryption.NewEncryptReader(key, body)
+		if err != nil {
+			return err
+		}
+	}
+
+	storageKey := path.Join(previewPrefix, doc.PracticeId, doc.DocumentId+".png")
+	if _, err := c.documentStorage.Put(ctx, storageKey, body); err != nil {
+		return err
+	}
+	doc.PreviewPath = storageKey
+	doc.PreviewSize = int64(len(preview))
+	doc.PreviewSalt = salt
+	return nil
+}
+
+// OpenDocumentPreview streams the preview of doc's current version. It
+// returns ErrPreviewUnavailable when there is none.
+func (c *controller) OpenDocumentPreview(ctx context.Context, doc *models.Document) (io.ReadCloser, int64, error) {
+	if !previewAvailable(doc) {
+		return nil, 0, ErrPreviewUnavailable
+	}
+
+	body, err := c.documentStorage.Get(ctx, doc.PreviewPath, 0, -1)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error opening preview: %w", err)
+	}
+	if doc.WrappedKey == nil {
+		return body, doc.PreviewSize, nil
+	}
+
+	key, err := c.previewKey(doc, doc.PreviewSalt)
+	if err != nil {
+		body.Close()
+		return nil, 0, err
+	}
+	plaintext, err := encryption.NewDecryptReader(key, body, 0, -1, doc.PreviewSize)
+	if err != nil {
+		body.Close()
+		return nil, 0, err
+	}
+	return &decryptedBody{Reader: plaintext, Closer: body}, doc.PreviewSize, nil
+}
+
+// previewKey derives the key of a preview of doc from its salt. Previews
+// from before salts were kept have none.
+func (c *controller) previewKey(doc *models.Document, salt []byte) ([]byte, error) {
+	dataKey, err := c.documentKeys.Unwrap(doc.KeyId, doc.DocumentId, doc.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	return encryption.DeriveKey(dataKey, previewKeyLabel+hex.EncodeToString(salt)), nil
+}
+
+// previewAvailable reports whether doc has a preview of its current version.
+func previewAvailable(doc *models.Document) bool {
+	return doc.PreviewStatus == models.PreviewReady && doc.PreviewVersion == doc.CurrentVersion
+}
@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"code.ply.internal/core/models"
+	"go.mongodb.org/mongo-driver/bson"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+func (c *controller) ListDependentEnrollments(ctx context.Context, groupEnrollmentId string) ([]*models.Enrollment, error) {
+	enrollments := []*models.Enrollment{}
+	err := c.enrollmentCollection.Find(ctx, bson.M{"groupenrollmentid": groupEnrollmentId}, &enrollments)
+	if err != nil {
+		return nil, err
+	}
+	return enrollments, nil
+}
+
+// checkGroupEnrollment validates the link between an individual enrollment
+// and its group enrollment, and holds the individual back from submission
+// until the group has been approved.
+func (c *controller) checkGroupEnrollment(ctx context.Context, enrollment *models.Enrollment) error {
+	if enrollment.GroupEnrollmentId == "" {
+		return nil
+	}
+	if enrollment.Kind != models.EnrollmentKindIndividual {
+		return &ConflictError{
+			ExistingId: enrollment.GroupEnrollmentId,
+			Message:    "only individual enrollments can reference a group enrollment",
+		}
+	}
+
+	group, err := c.ReadEnrollment(ctx, enrollment.GroupEnrollmentId)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return &ValidationError{Message: fmt.Sprintf("group enrollment %s does not exist", enrollment.GroupEnrollmentId)}
+	}
+	if err != nil {
+		return fmt.Errorf("error reading group enrollment: %w", err)
+	}
+	if group.Kind != models.EnrollmentKindGroup {
+		return &ConflictError{
+			ExistingId: group.EnrollmentId,
+			Message:    "the referenced enrollment is not a group enrollment",
+		}
+	}
+	if group.PracticeId != enrollment.PracticeId ||
+		normalizeEnrollmentField(group.Payer) != normalizeEnrollmentField(enrollment.Payer) {
+		return &ConflictError{
+			ExistingId: group.EnrollmentId,
+			Message:    "the group enrollment must belong to the same practice and payer",
+		}
+	}
+
+	if isSubmittedEnrollment(enrollment) && !strings.EqualFold(group.Status, models.EnrollmentStatusApproved) {
+		return &ConflictError{
+			ExistingId: group.EnrollmentId,
+			Message:    "an individual enrollment cannot be submitted before its group enrollment is approved",
+		}
+	}
+	return nil
+}
+
+// checkDependentEnrollments keeps a group enrollment in place while
+// individual enrollments depend on it. updated is the group's new state, or
+// nil when it is being deleted: a group cannot be deleted or stop being a
+// group while any individual enrollment references it, nor leave the approved
+// status while any of them is submitted or approved on the strength of it.
+func (c *controller) checkDependentEnrollments(ctx context.Context, enrollmentId string, updated *models.Enrollment) error {
+	stored, err := c.ReadEnrollment(ctx, enrollmentId)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if stored.Kind != models.EnrollmentKindGroup {
+		return nil
+	}
+	regrouped := updated != nil && updated.Kind != models.EnrollmentKindGroup
+	if updated != nil && !regrouped && (!strings.EqualFold(stored.Status, models.EnrollmentStatusApproved) ||
+		strings.EqualFold(updated.Status, models.EnrollmentStatusApproved)) {
+		return nil
+	}
+
+	dependents, err := c.ListDependentEnrollments(ctx, enrollmentId)
+	if err != nil {
+		return err
+	}
+	for _, dependent := range dependents {
+		if updated == nil {
+			return &ConflictError{
+				ExistingId: dependent.EnrollmentId,
+				Message:    "the group enrollment cannot be deleted while individual enrollments reference it",
+			}
+		}
+		if regrouped {
+			return &ConflictError{
+				ExistingId: dependent.EnrollmentId,
+				Message:    "the group enrollment cannot change kind while individual enrollments reference it",
+			}
+		}
+		if isSubmittedEnrollment(dependent) {
+			return &ConflictError{
+				ExistingId: dependent.EnrollmentId,
+				Message:    "the group enrollment cannot leave the approved status while individual enrollments submitted under it remain",
+			}
+		}
+	}
+	return nil
+}
+
+func isSubmittedEnrollment(enrollment *models.Enrollment) bool {
+	switch strings.ToLower(strings.TrimSpace(enrollment.Status)) {
+	case models.EnrollmentStatusSubmitted, models.EnrollmentStatusApproved:
+		return true
+	}
+	return false
+}
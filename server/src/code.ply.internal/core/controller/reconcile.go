@@ -0,0 +1,277 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"code.ply.internal/core/encryption"
+	"code.ply.internal/core/gateway/storage"
+	"code.ply.internal/core/models"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const defaultOrphanGracePeriod = time.Hour
+
+// ReconcileStorage compares the files in storage with the document, version
+// and resumable upload records that reference them, previews included, and
+// reports files with no record, records with no file and files whose size
+// differs from their record. Sizes are compared as stored, so encrypted files
+// are expected to be larger than the recorded plaintext. options choose which
+// problems are fixed as they are found.
+//
+// Records are read before storage is listed, so a file written after its
+// record was read is at worst reported as an orphan, which the grace period
+// covers. Files of records uploaded within the grace period are not reported
+// missing either, and are looked up again before their records are deleted.
+func (c *controller) ReconcileStorage(ctx context.Context, options *models.ReconciliationOptions) (*models.ReconciliationReport, error) {
+	docs := []*models.Document{}
+	if err := c.documentCollection.Find(ctx, bson.M{}, &docs); err != nil {
+		return nil, err
+	}
+	versions := []*models.DocumentVersion{}
+	if err := c.documentVersionCollection.Find(ctx, bson.M{}, &versions); err != nil {
+		return nil, err
+	}
+	uploads := []*models.ResumableUpload{}
+	if err := c.resumableUploadCollection.Find(ctx, bson.M{}, &uploads); err != nil {
+		return nil, err
+	}
+
+	files, err := c.documentStorage.List(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	stored := map[string]*storage.Object{}
+	for _, file := range files {
+		stored[file.Key] = file
+	}
+
+	grace := c.documents.OrphanGracePeriod
+	if grace <= 0 {
+		grace = defaultOrphanGracePeriod
+	}
+	uploadedAt := map[string]string{}
+	for _, version := range versions {
+		uploadedAt[fmt.Sprintf("%s/%d", version.DocumentId, version.Version)] = version.UploadedAt
+	}
+
+	report := &models.ReconciliationReport{Files: len(files)}
+	referenced := map[string]bool{}
+	byId := map[string]*models.Document{}
+
+	for _, doc := range docs {
+		byId[doc.DocumentId] = doc
+		key := documentStorageKey(doc)
+		referenced[key] = true
+		report.Records++
+		if doc.PreviewPath != "" {
+			referenced[doc.PreviewPath] = true
+		}
+
+		recent := withinGrace(uploadedAt[fmt.Sprintf("%s/%d", doc.DocumentId, doc.CurrentVersion)], grace)
+		if problem := fileDiscrepancy(doc, stored[key]); problem != nil && !(recent && problem.Kind == models.DiscrepancyMissingFile) {
+			c.resolveDiscrepancy(ctx, problem, doc, 0, options)
+			report.Problems = append(report.Problems, problem)
+		}
+	}
+
+	// The current version shares its document's file, which is checked above
+	for _, version := range versions {
+		// Versions still being uploaded have no file yet
+		if version.StoragePath == "" {
+			continue
+		}
+		key := strings.TrimPrefix(version.StoragePath, legacyUploadDir)
+		if referenced[key] {
+			continue
+		}
+		referenced[key] = true
+		report.Records++
+
+		doc := &models.Document{DocumentId: version.DocumentId}
+		if current, ok := byId[version.DocumentId]; ok {
+			copied := *current
+			doc = &copied
+		}
+		applyDocumentVersion(doc, version)
+
+		recent := withinGrace(version.UploadedAt, grace)
+		if problem := fileDiscrepancy(doc, stored[key]); problem != nil && !(recent && problem.Kind == models.DiscrepancyMissingFile) {
+			problem.Version = version.Version
+			c.resolveDiscrepancy(ctx, problem, doc, version.Version, options)
+			report.Problems = append(report.Problems, problem)
+		}
+	}
+
+	for _, upload := range uploads {
+		for _, chunk := range upload.Chunks {
+			referenced[resumableChunkStorageKey(upload.UploadId, chunk.ChunkId)] = true
+		}
+	}
+
+	// Files written moments ago may belong to uploads whose records are not
+	// saved yet
+	for _, file := range files {
+		if referenced[file.Key] || time.Since(file.Modified) < grace {
+			continue
+		}
+
+		problem := &models.StorageDiscrepancy{
+			Kind:       models.DiscrepancyOrphanFile,
+			Key:        file.Key,
+			ActualSize: file.Size,
+		}
+		if options.Delete {
+			if err := c.documentStorage.Delete(ctx, file.Key); err != nil {
+				problem.Message = err.Error()
+			} else {
+				problem.Action = models.DiscrepancyDeleted
+			}
+		}
+		report.Problems = append(report.Problems, problem)
+	}
+	return report, nil
+}
+
+// withinGrace reports whether a record uploaded at uploadedAt is too recent
+// for a missing file to be trusted. Records from before upload times were
+// kept never are.
+func withinGrace(uploadedAt string, grace time.Duration) bool {
+	uploaded, err := time.Parse(time.RFC3339, uploadedAt)
+	return err == nil && time.Since(uploaded) < grace
+}
+
+// fileDiscrepancy compares doc's current file fields with the stored file,
+// which is nil when there is none. It returns nil when they agree.
+func fileDiscrepancy(doc *models.Document, file *storage.Object) *models.StorageDiscrepancy {
+	expected := doc.Size
+	if doc.WrappedKey != nil {
+		expected = encryption.EncryptedSize(doc.Size)
+	}
+
+	problem := &models.StorageDiscrepancy{
+		Key:          documentStorageKey(doc),
+		DocumentId:   doc.DocumentId,
+		PracticeId:   doc.PracticeId,
+		ExpectedSize: expected,
+	}
+	if file == nil {
+		problem.Kind = models.DiscrepancyMissingFile
+		return problem
+	}
+
+	// Documents uploaded before sizes were recorded have nothing to compare
+	if doc.Size == 0 && doc.Sha256 == "" {
+		return nil
+	}
+	if file.Size == expected {
+		return nil
+	}
+	problem.Kind = models.DiscrepancySizeMismatch
+	problem.ActualSize = file.Size
+	return problem
+}
+
+// resolveDiscrepancy applies options to a problem with doc's file, or with
+// the file of one of its earlier versions when version is set, and records
+// what was done on the problem.
+func (c *controller) resolveDiscrepancy(ctx context.Context, problem *models.StorageDiscrepancy, doc *models.Document, version int, options *models.ReconciliationOptions) {
+	var err error
+	switch {
+	case problem.Kind == models.DiscrepancyMissingFile && options.Delete:
+		// The file may have been written since storage was listed
+		if _, err := c.documentStorage.Stat(ctx, problem.Key); !errors.Is(err, storage.ErrNotFound) {
+			problem.Message = "file was found again; not deleted"
+			if err != nil {
+				problem.Message = err.Error()
+			}
+			return
+		}
+		if version == 0 {
+			err = c.DeleteDocument(ctx, doc.DocumentId)
+		} else {
+			err = c.deleteVersionRecord(ctx, doc, version)
+		}
+		problem.Action = models.DiscrepancyDeleted
+
+	case problem.Kind == models.DiscrepancySizeMismatch && options.Repair:
+		err = c.recordActualFile(ctx, doc, version)
+		problem.Action = models.DiscrepancyRepaired
+
+	default:
+		return
+	}
+
+	if err != nil {
+		problem.Action = ""
+		problem.Message = err.Error()
+	}
+}
+
+// recordActualFile re-hashes doc's stored file and records its actual size
+// on the document and its current version, or on one of its earlier
+// versions when version is set. Only records from before checksums were kept
+// take the file's checksum; a file that no longer matches its checksum has
+// changed or is corrupt, and is left for someone to look at.
+func (c *controller) recordActualFile(ctx context.Context, doc *models.Document, version int) error {
+	checksum, size, err := c.hashDocument(ctx, doc)
+	if err != nil {
+		return err
+	}
+	if doc.Sha256 != "" && checksum != doc.Sha256 {
+		return errors.New("file does not match its recorded checksum; not repaired")
+	}
+	if doc.PracticeId != "" {
+		if _, err := c.storageUsage(ctx, doc.PracticeId); err != nil {
+			return err
+		}
+	}
+	fields := bson.M{"size": size, "sha256": checksum}
+
+	// Records deleted since they were read are left deleted. The current
+	// version may have no record of its own if it predates versioning.
+	earlier := version != 0
+	if !earlier {
+		updated, err := c.documentCollection.Update(ctx, bson.M{"documentid": doc.DocumentId}, fields)
+		if err != nil || !updated {
+			return err
+		}
+		version = doc.CurrentVersion
+	}
+	if version != 0 {
+		updated, err := c.documentVersionCollection.Update(ctx, bson.M{"documentid": doc.DocumentId, "version": version}, fields)
+		if err != nil {
+			return err
+		}
+		if !updated && earlier {
+			return nil
+		}
+	}
+
+	if doc.PracticeId != "" {
+		c.chargeUsage(ctx, doc.PracticeId, doc.DocumentType, size-doc.Size, 0)
+	}
+	return nil
+}
+
+// deleteVersionRecord deletes the record of one of doc's earlier versions.
+func (c *controller) deleteVersionRecord(ctx context.Context, doc *models.Document, version int) error {
+	if doc.PracticeId != "" {
+		if _, err := c.storageUsage(ctx, doc.PracticeId); err != nil {
+			return err
+		}
+	}
+
+	err := c.documentVersionCollection.DeleteOne(ctx, bson.M{"documentid": doc.DocumentId, "version": version})
+	if err != nil {
+		return err
+	}
+
+	if doc.PracticeId != "" {
+		c.chargeUsage(ctx, doc.PracticeId, doc.DocumentType, -doc.Size, 0)
+	}
+	return nil
+}
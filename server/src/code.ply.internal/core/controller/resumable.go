@@ -0,0 +1,420 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"path"
+	"time"
+
+	"code.ply.internal/core/encryption"
+	"code.ply.internal/core/gateway/storage"
+	"code.ply.internal/core/models"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// resumableUploadPrefix is the storage prefix chunks are kept under
+	// until their upload is finalized
+	resumableUploadPrefix = "resumable"
+
+	defaultResumableUploadExpiry = 24 * time.Hour
+)
+
+// CreateResumableUpload starts an upload of upload.Size bytes for a
+// practice. The metadata on upload is checked, and the quota for it
+// reserved, now so a long transfer isn't wasted on a document that would be
+// rejected.
+func (c *controller) CreateResumableUpload(ctx context.Context, upload *models.ResumableUpload) (*models.ResumableUpload, error) {
+	if upload.FileName == "" {
+		return nil, &ValidationError{Message: "fileName is required"}
+	}
+	if upload.Size <= 0 {
+		return nil, &ValidationError{Message: "size must be positive"}
+	}
+	if limit := c.documents.MaxResumableUploadBytes; limit > 0 && upload.Size > limit {
+		return nil, &ValidationError{Message: fmt.Sprintf("size exceeds the limit of %d bytes", limit)}
+	}
+	if err := c.validateDocumentMetadata(ctx, upload.PracticeId, uploadDocument(upload)); err != nil {
+		return nil, err
+	}
+
+	upload.UploadId = uuid.New().String()
+	upload.Offset = 0
+	upload.Chunks = nil
+	upload.State = ""
+	if c.documentKeys.Enabled() {
+		_, wrappedKey, err := c.documentKeys.NewDataKey(upload.UploadId)
+		if err != nil {
+			return nil, err
+		}
+		upload.KeyId = c.documentKeys.ActiveKeyId()
+		upload.WrappedKey = wrappedKey
+	}
+
+	if err := c.reserveQuota(ctx, upload.PracticeId, upload.DocumentType, upload.Size); err != nil {
+		return nil, err
+	}
+	upload.Reserved = upload.Size
+	if err := c.saveResumableUpload(ctx, upload); err != nil {
+		c.chargeUsage(ctx, upload.PracticeId, upload.DocumentType, -upload.Reserved, 0)
+		return nil, err
+	}
+	return upload, nil
+}
+
+func (c *controller) ReadResumableUpload(ctx context.Context, uploadId string) (*models.ResumableUpload, error) {
+	upload := &models.ResumableUpload{}
+	err := c.resumableUploadCollection.FindOne(ctx, bson.M{"uploadid": uploadId}, upload)
+	if err != nil {
+		return nil, err
+	}
+	return upload, nil
+}
+
+// AppendResumableUpload stores chunk as the data at offset, which must be
+// the upload's current offset. Each chunk is stored as its own object, so a
+// dropped connection loses only the chunk in flight.
+func (c *controller) AppendResumableUpload(ctx context.Context, uploadId string, offset int64, chunk io.Reader) (*models.ResumableUpload, error) {
+	upload, err := c.ReadResumableUpload(ctx, uploadId)
+	if err != nil {
+		return nil, err
+	}
+	if upload.State != "" {
+		return nil, &ConflictError{
+			ExistingId: upload.UploadId,
+			Message:    "upload is being finalized or deleted",
+		}
+	}
+	if offset != upload.Offset {
+		return nil, &ConflictError{
+			ExistingId: upload.UploadId,
+			Message:    fmt.Sprintf("upload is at offset %d, not %d", upload.Offset, offset),
+		}
+	}
+
+	// Read one byte past what is left so an oversized chunk is noticed
+	remaining := upload.Size - upload.Offset
+	content := io.LimitReader(chunk, remaining+1)
+
+	chunkId := uuid.New().String()
+	body := content
+	if upload.WrappedKey != nil {
+		dataKey, err := c.resumableChunkKey(upload, chunkId)
+		if err != nil {
+			return nil, err
+		}
+		body, err = encryption.NewEncryptReader(dataKey, content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	storageKey := resumableChunkStorageKey(upload.UploadId, chunkId)
+	size, err := c.documentStorage.Put(ctx, storageKey, body)
+	if err != nil {
+		return nil, err
+	}
+	if upload.WrappedKey != nil {
+		size = encryption.PlaintextSize(size)
+	}
+
+	if size > remaining {
+		c.documentStorage.Delete(ctx, storageKey)
+		return nil, &UploadTooLargeError{Size: upload.Size}
+	}
+	if size == 0 {
+		c.documentStorage.Delete(ctx, storageKey)
+		return upload, nil
+	}
+
+	// Only advance the offset if no other chunk was stored at it meanwhile;
+	// the chunks change only with the offset, so they are still as read
+	upload.Chunks = append(upload.Chunks, &models.UploadChunk{ChunkId: chunkId, Size: size})
+	upload.Offset += size
+	upload.ExpiresAt = c.resumableUploadExpiry()
+	filter := receivingUploadFilter(upload.UploadId)
+	filter["offset"] = offset
+	advanced, err := c.resumableUploadCollection.Update(ctx, filter, bson.M{
+		"chunks":    upload.Chunks,
+		"offset":    upload.Offset,
+		"expiresat": upload.ExpiresAt,
+	})
+	if err != nil || !advanced {
+		c.documentStorage.Delete(ctx, storageKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !advanced {
+		return nil, &ConflictError{
+			ExistingId: upload.UploadId,
+			Message:    fmt.Sprintf("another chunk was stored at offset %d first, or the upload is being finalized", offset),
+		}
+	}
+	return upload, nil
+}
+
+// FinalizeResumableUpload turns a complete upload into a document, going
+// through the same checks and processing as a single request upload. The
+// upload is claimed first, so concurrent finalizes make one document; the
+// quota reserved for it is handed over to the document.
+func (c *controller) FinalizeResumableUpload(ctx context.Context, uploadId string) (string, error) {
+	upload, err := c.ReadResumableUpload(ctx, uploadId)
+	if err != nil {
+		return "", err
+	}
+	if upload.Offset != upload.Size {
+		return "", &ConflictError{
+			ExistingId: upload.UploadId,
+			Message:    fmt.Sprintf("upload has received %d of %d bytes", upload.Offset, upload.Size),
+		}
+	}
+	if err := c.claimResumableUpload(ctx, upload, models.UploadFinalizing); err != nil {
+		return "", err
+	}
+
+	// An upload that failed to finalize gave up its reservation
+	doc := uploadDocument(upload)
+	err = c.validateDocumentMetadata(ctx, doc.PracticeId, doc)
+	if err == nil && upload.Reserved != upload.Size {
+		err = c.reserveQuota(ctx, doc.PracticeId, doc.DocumentType, upload.Size-upload.Reserved)
+	}
+	if err != nil {
+		c.releaseResumableUpload(ctx, upload, upload.Reserved)
+		return "", err
+	}
+
+	content := &chunkReader{ctx: ctx, controller: c, upload: upload}
+	defer content.Close()
+
+	// The reservation belongs to the document from here, and is released
+	// with it if it isn't created
+	documentId, err := c.storeNewDocument(ctx, doc, content, upload.Size)
+	if err != nil {
+		c.releaseResumableUpload(ctx, upload, 0)
+		return "", err
+	}
+	upload.Reserved = 0
+	if err := c.discardResumableUpload(ctx, upload); err != nil {
+		return "", err
+	}
+	return documentId, nil
+}
+
+// DeleteResumableUpload discards an upload and its stored chunks, and
+// releases its quota. An upload being finalized can't be deleted.
+func (c *controller) DeleteResumableUpload(ctx context.Context, uploadId string) error {
+	upload, err := c.ReadResumableUpload(ctx, uploadId)
+	if err != nil {
+		return err
+	}
+	if upload.State != models.UploadDeleting {
+		if err := c.claimResumableUpload(ctx, upload, models.UploadDeleting); err != nil {
+			return err
+		}
+	}
+	return c.discardResumableUpload(ctx, upload)
+}
+
+// claimResumableUpload moves an upload that is still receiving chunks to
+// state, and takes over the quota it reserved from the record. It returns a
+// *ConflictError when the upload is being finalized or deleted already.
+func (c *controller) claimResumableUpload(ctx context.Context, upload *models.ResumableUpload, state string) error {
+	claimed, err := c.resumableUploadCollection.Update(ctx, receivingUploadFilter(upload.UploadId), bson.M{
+		"state":    state,
+		"reserved": 0,
+	})
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return &ConflictError{
+			ExistingId: upload.UploadId,
+			Message:    "upload is being finalized or deleted",
+		}
+	}
+	upload.State = state
+	return nil
+}
+
+// receivingUploadFilter matches an upload that is still receiving chunks.
+func receivingUploadFilter(uploadId string) bson.M {
+	return bson.M{"uploadid": uploadId, "state": bson.M{"$nin": bson.A{models.UploadFinalizing, models.UploadDeleting}}}
+}
+
+// releaseResumableUpload returns an upload whose finalize failed to
+// receiving chunks, holding reserved bytes of quota.
+func (c *controller) releaseResumableUpload(ctx context.Context, upload *models.ResumableUpload, reserved int64) {
+	_, err := c.resumableUploadCollection.Update(ctx, bson.M{"uploadid": upload.UploadId}, bson.M{
+		"state":    "",
+		"reserved": reserved,
+	})
+	if err != nil {
+		log.Printf("error releasing resumable upload %s: %v", upload.UploadId, err)
+	}
+}
+
+// discardResumableUpload releases the quota a claimed upload holds and
+// deletes its chunks and record. The record is kept if a chunk can't be
+// deleted so the delete can be retried; chunks that are already gone are
+// fine.
+func (c *controller) discardResumableUpload(ctx context.Context, upload *models.ResumableUpload) error {
+	if upload.Reserved != 0 {
+		c.chargeUsage(ctx, upload.PracticeId, upload.DocumentType, -upload.Reserved, 0)
+		upload.Reserved = 0
+	}
+
+	for _, chunk := range upload.Chunks {
+		err := c.documentStorage.Delete(ctx, resumableChunkStorageKey(upload.UploadId, chunk.ChunkId))
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return fmt.Errorf("error deleting chunk: %w", err)
+		}
+	}
+	return c.resumableUploadCollection.DeleteOne(ctx, bson.M{"uploadid": upload.UploadId})
+}
+
+// CleanupResumableUploads deletes uploads that have expired without being
+// finalized, and returns how many it deleted.
+func (c *controller) CleanupResumableUploads(ctx context.Context) (int, error) {
+	uploads := []*models.ResumableUpload{}
+	err := c.resumableUploadCollection.Find(ctx, bson.M{
+		"expiresat": bson.M{"$lt": time.Now().UTC().Format(time.RFC3339)},
+	}, &uploads)
+	if err != nil {
+		return 0, err
+	}
+
+	// An upload left finalizing or deleting this long was abandoned part
+	// way, and its claim already took its reservation off the record
+	deleted := 0
+	for _, upload := range uploads {
+		if upload.State == "" {
+			err := c.claimResumableUpload(ctx, upload, models.UploadDeleting)
+			var conflict *ConflictError
+			if errors.As(err, &conflict) {
+				continue
+			}
+			if err != nil {
+				return deleted, err
+			}
+		}
+		if err := c.discardResumableUpload(ctx, upload); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+func (c *controller) saveResumableUpload(ctx context.Context, upload *models.ResumableUpload) error {
+	upload.ExpiresAt = c.resumableUploadExpiry()
+	return c.resumableUploadCollection.Upsert(ctx, bson.M{"uploadid": upload.UploadId}, upload)
+}
+
+// resumableUploadExpiry returns when an upload that is sent to now expires.
+func (c *controller) resumableUploadExpiry() string {
+	expiry := c.documents.ResumableUploadExpiry
+	if expiry <= 0 {
+		expiry = defaultResumableUploadExpiry
+	}
+	return time.Now().UTC().Add(expiry).Format(time.RFC3339)
+}
+
+// resumableChunkKey returns the key a chunk is encrypted with. Every chunk,
+// including a retried one, has a new id and so its own key.
+func (c *controller) resumableChunkKey(upload *models.ResumableUpload, chunkId string) ([]byte, error) {
+	dataKey, err := c.documentKeys.Unwrap(upload.KeyId, upload.UploadId, upload.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	return encryption.DeriveKey(dataKey, chunkId), nil
+}
+
+func resumableChunkStorageKey(uploadId string, chunkId string) string {
+	return path.Join(resumableUploadPrefix, uploadId, chunkId)
+}
+
+// uploadDocument returns the document an upload is finalized into, with the
+// upload's declared size.
+func uploadDocument(upload *models.ResumableUpload) *models.Document {
+	return &models.Document{
+		PracticeId:     upload.PracticeId,
+		FileName:       upload.FileName,
+		Size:           upload.Size,
+		DocumentType:   upload.DocumentType,
+		ProviderId:     upload.ProviderId,
+		LocationId:     upload.LocationId,
+		EnrollmentId:   upload.EnrollmentId,
+		ExpirationDate: upload.ExpirationDate,
+	}
+}
+
+// chunkReader reads an upload's chunks in order, opening each only when the
+// previous one is exhausted.
+type chunkReader struct {
+	ctx        context.Context
+	controller *controller
+	upload     *models.ResumableUpload
+	next       int
+	current    io.ReadCloser
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for {
+		if r.current == nil {
+			if r.next == len(r.upload.Chunks) {
+				return 0, io.EOF
+			}
+			current, err := r.open(r.upload.Chunks[r.next])
+			if err != nil {
+				return 0, err
+			}
+			r.current = current
+			r.next++
+		}
+
+		n, err := r.current.Read(p)
+		if err == io.EOF {
+			r.current.Close()
+			r.current = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func (r *chunkReader) open(chunk *models.UploadChunk) (io.ReadCloser, error) {
+	body, err := r.controller.documentStorage.Get(r.ctx, resumableChunkStorageKey(r.upload.UploadId, chunk.ChunkId), 0, -1)
+	if err != nil {
+		return nil, fmt.Errorf("error opening chunk: %w", err)
+	}
+	if r.upload.WrappedKey == nil {
+		return body, nil
+	}
+
+	dataKey, err := r.controller.resumableChunkKey(r.upload, chunk.ChunkId)
+	if err != nil {
+		body.Close()
+		return nil, err
+	}
+	plaintext, err := encryption.NewDecryptReader(dataKey, body, 0, -1, chunk.Size)
+	if err != nil {
+		body.Close()
+		return nil, err
+	}
+	return &decryptedBody{Reader: plaintext, Closer: body}, nil
+}
+
+func (r *chunkReader) Close() error {
+	if r.current == nil {
+		return nil
+	}
+	return r.current.Close()
+}
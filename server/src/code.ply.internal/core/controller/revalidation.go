@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"code.ply.internal/core/models"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (c *controller) ListRevalidationsDue(ctx context.Context, practiceId string, withinDays int) ([]*models.Enrollment, error) {
+	cutoff := time.Now().AddDate(0, 0, withinDays).Format(models.DateLayout)
+	filter := bson.M{"revalidationduedate": bson.M{"$ne": "", "$lte": cutoff}}
+	if practiceId != "" {
+		filter["practiceid"] = practiceId
+	}
+
+	enrollments := []*models.Enrollment{}
+	err := c.enrollmentCollection.Find(ctx, filter, &enrollments)
+	if err != nil {
+		return nil, err
+	}
+
+	// dates are stored as YYYY-MM-DD so they sort lexically
+	sort.Slice(enrollments, func(i, j int) bool {
+		return enrollments[i].RevalidationDueDate < enrollments[j].RevalidationDueDate
+	})
+	return enrollments, nil
+}
+
+// scheduleRevalidation sets the revalidation due date from the payer's cycle,
+// counted from the effective date or, failing that, the approval date.
+func (c *controller) scheduleRevalidation(enrollment *models.Enrollment) error {
+	start := enrollment.EffectiveDate
+	if start == "" {
+		start = enrollment.ApprovalDate
+	}
+	cycleMonths := c.revalidationCycleMonths(enrollment.Payer)
+	if start == "" || cycleMonths <= 0 {
+		return nil
+	}
+
+	startDate, err := time.Parse(models.DateLayout, start)
+	if err != nil {
+		return fmt.Errorf("invalid enrollment date %q: %w", start, err)
+	}
+	enrollment.RevalidationDueDate = startDate.AddDate(0, cycleMonths, 0).Format(models.DateLayout)
+	return nil
+}
+
+func (c *controller) revalidationCycleMonths(payer string) int {
+	for _, rule := range c.revalidation.Payers {
+		if strings.EqualFold(strings.TrimSpace(rule.Payer), strings.TrimSpace(payer)) {
+			return rule.CycleMonths
+		}
+	}
+	return c.revalidation.DefaultCycleMonths
+}
@@ -0,0 +1,205 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"path"
+
+	"code.ply.internal/core/gateway/scanner"
+	"code.ply.internal/core/models"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// quarantinePrefix is where infected files are moved in storage, out of the
+// practices' folders.
+const quarantinePrefix = "quarantine"
+
+// pendingScan is a scan reading a copy of an upload while it is stored.
+type pendingScan struct {
+	pipe *io.PipeWriter
+	done chan scanOutcome
+}
+
+type scanOutcome struct {
+	result *scanner.Result
+	err    error
+}
+
+// scanStream starts scanning what is read through the returned reader. It
+// returns a nil scan and r itself when scanning is disabled.
+func (c *controller) scanStream(ctx context.Context, r io.Reader) (*pendingScan, io.Reader) {
+	if c.scanner == nil {
+		return nil, r
+	}
+
+	pr, pw := io.Pipe()
+	scan := &pendingScan{pipe: pw, done: make(chan scanOutcome, 1)}
+	go func() {
+		result, err := c.scanner.Scan(ctx, pr)
+		// Keep reading so a scanner that gives up early does not stall the
+		// upload
+		io.Copy(io.Discard, pr)
+		scan.done <- scanOutcome{result: result, err: err}
+	}()
+	return scan, io.TeeReader(r, pw)
+}
+
+// wait ends the scanned stream, with err when storing it failed, and returns
+// the scan's outcome.
+func (s *pendingScan) wait(err error) (*scanner.Result, error) {
+	if s == nil {
+		return nil, nil
+	}
+	s.pipe.CloseWithError(err)
+	outcome := <-s.done
+	return outcome.result, outcome.err
+}
+
+// recordScan sets doc's scan status from a scan of its stored file and moves
+// the file to quarantine when it is infected.
+func (c *controller) recordScan(ctx context.Context, doc *models.Document, result *scanner.Result, err error) error {
+	switch {
+	case errors.Is(err, scanner.ErrTooLarge):
+		log.Printf("document %s is too large to scan: %v", doc.DocumentId, err)
+		doc.ScanStatus = models.ScanTooLarge
+		doc.ScanSignature = ""
+		return nil
+	case err != nil:
+		log.Printf("error scanning document %s: %v", doc.DocumentId, err)
+		doc.ScanStatus = models.ScanFailed
+		doc.ScanSignature = ""
+		return nil
+	case !result.Infected:
+		doc.ScanStatus = models.ScanClean
+		doc.ScanSignature = ""
+		return nil
+	}
+
+	doc.ScanStatus = models.ScanInfected
+	doc.ScanSignature = result.Signature
+	return c.quarantineDocument(ctx, doc)
+}
+
+// quarantineDocument moves doc's stored file, as stored, under the quarantine
+// prefix.
+func (c *controller) quarantineDocument(ctx context.Context, doc *models.Document) error {
+	key := documentStorageKey(doc)
+	quarantineKey := path.Join(quarantinePrefix, key)
+	if key == quarantineKey {
+		return nil
+	}
+
+	body, err := c.documentStorage.Get(ctx, key, 0, -1)
+	if err != nil {
+		return fmt.Errorf("error quarantining document %s: %w", doc.DocumentId, err)
+	}
+	defer body.Close()
+	if _, err := c.documentStorage.Put(ctx, quarantineKey, body); err != nil {
+		return fmt.Errorf("error quarantining document %s: %w", doc.DocumentId, err)
+	}
+	if err := c.documentStorage.Delete(ctx, key); err != nil {
+		return fmt.Errorf("error quarantining document %s: %w", doc.DocumentId, err)
+	}
+	doc.StoragePath = quarantineKey
+	return nil
+}
+
+// DocumentDownloadable reports why doc's file may not be served, if it may
+// not. While scanning is enabled only files that scanned clean are served.
+func (c *controller) DocumentDownloadable(doc *models.Document) error {
+	if c.scanner == nil && doc.ScanStatus != models.ScanInfected {
+		return nil
+	}
+	if doc.ScanStatus != models.ScanClean {
+		return &ScanError{Status: doc.ScanStatus}
+	}
+	return nil
+}
+
+// ScanDocuments scans every document version that has not yet scanned clean
+// or infected, such as files uploaded before scanning was enabled or while
+// the scanner was unavailable. It returns how many files were scanned.
+func (c *controller) ScanDocuments(ctx context.Context) (int, error) {
+	if c.scanner == nil {
+		return 0, nil
+	}
+
+	unscanned := bson.M{"scanstatus": bson.M{"$nin": bson.A{models.ScanClean, models.ScanInfected, models.ScanTooLarge}}}
+	docs := []*models.Document{}
+	if err := c.documentCollection.Find(ctx, unscanned, &docs); err != nil {
+		return 0, err
+	}
+
+	scanned := 0
+	var errs []error
+	for _, doc := range docs {
+		if err := c.scanDocument(ctx, doc); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		err := c.documentCollection.Upsert(ctx, bson.M{"documentid": doc.DocumentId}, scanFields(doc))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if doc.CurrentVersion != 0 {
+			err := c.documentVersionCollection.Upsert(ctx, bson.M{"documentid": doc.DocumentId, "version": doc.CurrentVersion}, scanFields(doc))
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		}
+		scanned++
+	}
+
+	// Earlier versions are no longer on their documents. Versions still
+	// being uploaded have no file yet.
+	unscanned["storagepath"] = bson.M{"$ne": ""}
+	versions := []*models.DocumentVersion{}
+	if err := c.documentVersionCollection.Find(ctx, unscanned, &versions); err != nil {
+		return scanned, errors.Join(append(errs, err)...)
+	}
+	for _, version := range versions {
+		doc, err := c.GetDocumentVersion(ctx, version.DocumentId, version.Version)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := c.scanDocument(ctx, doc); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		err = c.documentVersionCollection.Upsert(ctx, bson.M{"documentid": version.DocumentId, "version": version.Version}, scanFields(doc))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		scanned++
+	}
+	return scanned, errors.Join(errs...)
+}
+
+// scanFields are the fields a scan can change on a document or version
+// record.
+func scanFields(doc *models.Document) bson.M {
+	return bson.M{
+		"storagepath":   doc.StoragePath,
+		"scanstatus":    doc.ScanStatus,
+		"scansignature": doc.ScanSignature,
+	}
+}
+
+// scanDocument scans doc's stored file and records the outcome on doc.
+func (c *controller) scanDocument(ctx context.Context, doc *models.Document) error {
+	body, err := c.OpenDocument(ctx, doc, 0, -1)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	result, err := c.scanner.Scan(ctx, body)
+	return c.recordScan(ctx, doc, result, err)
+}
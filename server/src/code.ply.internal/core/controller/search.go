@@ -0,0 +1,281 @@
+package controller
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+
+	"code.ply.internal/core/config"
+	"code.ply.internal/core/encryption"
+	"code.ply.internal/core/models"
+	"code.ply.internal/core/search"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// snippetWidth is roughly how many bytes of text a search result quotes
+	snippetWidth = 200
+
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+
+	// searchTextKeyLabel derives the key the text of an encrypted document
+	// is sealed with from the document's data key
+	searchTextKeyLabel = "search"
+)
+
+var ErrEmptySearch = errors.New("search has no terms")
+
+func loadSearchTermKey(cfg config.SearchConfig) ([]byte, error) {
+	if cfg.TermKeyFile == "" && cfg.TermKeyEnv == "" {
+		return nil, nil
+	}
+	key, err := encryption.LoadKey(encryption.KeyParams{File: cfg.TermKeyFile, Env: cfg.TermKeyEnv})
+	if err != nil {
+		return nil, fmt.Errorf("error loading search term key: %w", err)
+	}
+	return key, nil
+}
+
+// SearchDocuments returns a practice's documents whose text contains every
+// word of query, those with the most occurrences first, up to limit of them.
+func (c *controller) SearchDocuments(ctx context.Context, practiceId string, query string, limit int) ([]*models.DocumentSearchResult, error) {
+	terms := search.Terms(query)
+	if len(terms) == 0 {
+		return nil, ErrEmptySearch
+	}
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	// Encrypted documents are indexed by keyed hashes of their words
+	filter := bson.M{"practiceid": practiceId, "terms": bson.M{"$all": terms}}
+	if c.searchTermKey != nil {
+		filter = bson.M{"practiceid": practiceId, "$or": bson.A{
+			bson.M{"terms": bson.M{"$all": terms}},
+			bson.M{"terms": bson.M{"$all": c.hashSearchTerms(terms)}},
+		}}
+	}
+	texts := []*models.DocumentText{}
+	err := c.documentTextCollection.Find(ctx, filter, &texts)
+	if err != nil {
+		return nil, err
+	}
+	if len(texts) == 0 {
+		return []*models.DocumentSearchResult{}, nil
+	}
+
+	ids := bson.A{}
+	for _, text := range texts {
+		ids = append(ids, text.DocumentId)
+	}
+	docs := []*models.Document{}
+	if err := c.documentCollection.Find(ctx, bson.M{"documentid": bson.M{"$in": ids}}, &docs); err != nil {
+		return nil, err
+	}
+	byId := map[string]*models.Document{}
+	for _, doc := range docs {
+		byId[doc.DocumentId] = doc
+	}
+
+	results := []*models.DocumentSearchResult{}
+	for _, text := range texts {
+		// Skip text left over from a version that is no longer current
+		doc, ok := byId[text.DocumentId]
+		if !ok || text.Version != doc.CurrentVersion {
+			continue
+		}
+		content, err := c.documentTextContent(doc, text)
+		if err != nil {
+			return nil, err
+		}
+		doc.PreviewAvailable = previewAvailable(doc)
+		results = append(results, &models.DocumentSearchResult{
+			Document: doc,
+			Snippet:  search.Snippet(content, terms, snippetWidth),
+			Matches:  search.Count(content, terms),
+		})
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Matches > results[j].Matches
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+// IndexDocuments indexes the text of the current version of every document
+// whose text is not yet indexed, such as documents uploaded before search
+// existed, and returns how many it indexed. Encrypted documents whose text
+// was indexed in plaintext, or not at all for want of a term key that is now
+// configured, are indexed again. Documents that may not be downloaded yet are
+// left for a later run.
+func (c *controller) IndexDocuments(ctx context.Context) (int, error) {
+	reindexed := bson.A{models.IndexReady}
+	if c.searchTermKey != nil {
+		reindexed = append(reindexed, models.IndexUnkeyed)
+	}
+	docs := []*models.Document{}
+	err := c.documentCollection.Find(ctx, bson.M{"$or": bson.A{
+		bson.M{"$expr": bson.M{"$ne": bson.A{
+			bson.M{"$ifNull": bson.A{"$indexedversion", -1}},
+			bson.M{"$ifNull": bson.A{"$currentversion", 0}},
+		}}},
+		bson.M{"keyid": bson.M{"$nin": bson.A{nil, ""}}, "indexstatus": bson.M{"$in": reindexed}},
+	}}, &docs)
+	if err != nil {
+		return 0, err
+	}
+
+	indexed := 0
+	var errs []error
+	for _, doc := range docs {
+		if c.DocumentDownloadable(doc) != nil {
+			continue
+		}
+		if err := c.indexDocument(ctx, doc); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if doc.IndexStatus == models.IndexReady || doc.IndexStatus == models.IndexSealed {
+			indexed++
+		}
+	}
+	return indexed, errors.Join(errs...)
+}
+
+// indexUploadedDocument indexes the text of a document whose current file has
+// just changed. The change is already saved by then, so failures are logged
+// and the document is left for IndexDocuments.
+func (c *controller) indexUploadedDocument(ctx context.Context, doc *models.Document) {
+	if c.DocumentDownloadable(doc) != nil {
+		return
+	}
+	if err := c.indexDocument(ctx, doc); err != nil {
+		log.Printf("error indexing document %s: %v", doc.DocumentId, err)
+	}
+}
+
+// indexDocument extracts the text of doc's current file into the search
+// index, replacing that of any earlier version, and records how that went on
+// doc and its record. It only returns errors saving the outcome.
+func (c *controller) indexDocument(ctx context.Context, doc *models.Document) error {
+	status := models.IndexReady
+	if doc.WrappedKey != nil {
+		status = models.IndexSealed
+	}
+	switch {
+	case !c.textExtractor.Supports(doc.ContentType):
+		status = models.IndexUnsupported
+	case status == models.IndexSealed && c.searchTermKey == nil:
+		status = models.IndexUnkeyed
+	default:
+		if err := c.saveDocumentText(ctx, doc); err != nil {
+			log.Printf("error extracting text of document %s: %v", doc.DocumentId, err)
+			status = models.IndexFailed
+		}
+	}
+
+	if status != models.IndexReady && status != models.IndexSealed {
+		err := c.documentTextCollection.DeleteOne(ctx, bson.M{"documentid": doc.DocumentId})
+		if err != nil {
+			return err
+		}
+	}
+
+	doc.IndexedVersion = doc.CurrentVersion
+	doc.IndexStatus = status
+	saved, err := c.documentCollection.Update(ctx, bson.M{"documentid": doc.DocumentId}, bson.M{
+		"indexedversion": doc.IndexedVersion,
+		"indexstatus":    doc.IndexStatus,
+	})
+	if err != nil {
+		return err
+	}
+	// A document deleted while it was indexed leaves nothing to index
+	if !saved {
+		return c.documentTextCollection.DeleteOne(ctx, bson.M{"documentid": doc.DocumentId})
+	}
+	return nil
+}
+
+func (c *controller) saveDocumentText(ctx context.Context, doc *models.Document) error {
+	content, err := c.OpenDocument(ctx, doc, 0, -1)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	text, err := c.textExtractor.Extract(ctx, doc.ContentType, content)
+	if err != nil {
+		return err
+	}
+	documentText := &models.DocumentText{
+		DocumentId: doc.DocumentId,
+		PracticeId: doc.PracticeId,
+		Version:    doc.CurrentVersion,
+		Text:       text,
+		Terms:      search.Terms(text),
+	}
+
+	// The text of encrypted documents is no less sensitive than their files
+	if doc.WrappedKey != nil {
+		key, err := c.searchTextKey(doc)
+		if err != nil {
+			return err
+		}
+		documentText.SealedText, err = encryption.Seal(key, []byte(text), doc.DocumentId)
+		if err != nil {
+			return err
+		}
+		documentText.Text = ""
+		documentText.Terms = c.hashSearchTerms(documentText.Terms)
+	}
+	return c.documentTextCollection.Upsert(ctx, bson.M{"documentid": doc.DocumentId}, documentText)
+}
+
+// documentTextContent returns the indexed text of doc, opening it when it is
+// sealed.
+func (c *controller) documentTextContent(doc *models.Document, text *models.DocumentText) (string, error) {
+	if text.SealedText == nil {
+		return text.Text, nil
+	}
+	key, err := c.searchTextKey(doc)
+	if err != nil {
+		return "", err
+	}
+	content, err := encryption.Open(key, text.SealedText, doc.DocumentId)
+	if err != nil {
+		return "", fmt.Errorf("error opening text of document %s: %w", doc.DocumentId, err)
+	}
+	return string(content), nil
+}
+
+func (c *controller) searchTextKey(doc *models.Document) ([]byte, error) {
+	dataKey, err := c.documentKeys.Unwrap(doc.KeyId, doc.DocumentId, doc.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	return encryption.DeriveKey(dataKey, searchTextKeyLabel), nil
+}
+
+// hashSearchTerms returns the keyed hashes the terms of encrypted documents
+// are indexed by.
+func (c *controller) hashSearchTerms(terms []string) []string {
+	hashed := make([]string, len(terms))
+	for i, term := range terms {
+		mac := hmac.New(sha256.New, c.searchTermKey)
+		mac.Write([]byte(term))
+		hashed[i] = hex.EncodeToString(mac.Sum(nil))
+	}
+	return hashed
+}
@@ -0,0 +1,229 @@
+package controller
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.ply.internal/core/config"
+	"code.ply.internal/core/encryption"
+	"code.ply.internal/core/models"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	defaultLinkExpiry    = 72 * time.Hour
+	defaultMaxLinkExpiry = 30 * 24 * time.Hour
+)
+
+var (
+	ErrSharingDisabled      = errors.New("document sharing is not configured")
+	ErrInvalidLinkSignature = errors.New("link signature is invalid")
+)
+
+// loadLinkSigningKey reads the key shared links are signed with. It returns
+// nil when none is configured.
+func loadLinkSigningKey(cfg config.SharingConfig) ([]byte, error) {
+	if cfg.SigningKeyFile == "" && cfg.SigningKeyEnv == "" {
+		return nil, nil
+	}
+	key, err := encryption.LoadKey(encryption.KeyParams{File: cfg.SigningKeyFile, Env: cfg.SigningKeyEnv})
+	if err != nil {
+		return nil, fmt.Errorf("error loading link signing key: %w", err)
+	}
+	return key, nil
+}
+
+// CreateSharedLink creates a signed link to the current version of a
+// document. Later versions are not served through it.
+func (c *controller) CreateSharedLink(ctx context.Context, documentId string, request *models.SharedLinkRequest) (*models.SharedLink, error) {
+	if c.linkSigningKey == nil {
+		return nil, ErrSharingDisabled
+	}
+
+	expiry := c.sharing.DefaultExpiry
+	if expiry <= 0 {
+		expiry = defaultLinkExpiry
+	}
+	maxExpiry := c.sharing.MaxExpiry
+	if maxExpiry <= 0 {
+		maxExpiry = defaultMaxLinkExpiry
+	}
+	if request.ExpiresIn < 0 {
+		return nil, &ValidationError{Message: "expiresIn must not be negative"}
+	}
+	if request.ExpiresIn > 0 {
+		expiry = time.Duration(request.ExpiresIn) * time.Second
+	}
+	if expiry > maxExpiry {
+		return nil, &ValidationError{Message: fmt.Sprintf("links may last at most %d seconds", int(maxExpiry.Seconds()))}
+	}
+
+	doc, err := c.GetDocument(ctx, documentId)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.DocumentDownloadable(doc); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	link := &models.SharedLink{
+		LinkId:     uuid.New().String(),
+		DocumentId: doc.DocumentId,
+		PracticeId: doc.PracticeId,
+		Version:    doc.CurrentVersion,
+		SingleUse:  request.SingleUse,
+		ExpiresAt:  now.Add(expiry).Format(time.RFC3339),
+		CreatedAt:  now.Format(time.RFC3339),
+	}
+	err = c.sharedLinkCollection.Upsert(ctx, bson.M{"linkid": link.LinkId}, link)
+	if err != nil {
+		return nil, err
+	}
+
+	link.Url, err = c.signedLinkUrl(link)
+	if err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+// ListSharedLinks returns the links created for a document, including ones
+// that expired or were revoked.
+func (c *controller) ListSharedLinks(ctx context.Context, documentId string) ([]*models.SharedLink, error) {
+	links := []*models.SharedLink{}
+	err := c.sharedLinkCollection.Find(ctx, bson.M{"documentid": documentId}, &links)
+	if err != nil {
+		return nil, err
+	}
+	if c.linkSigningKey == nil {
+		return links, nil
+	}
+	for _, link := range links {
+		if sharedLinkUsable(link, time.Now()) != nil {
+			continue
+		}
+		link.Url, err = c.signedLinkUrl(link)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return links, nil
+}
+
+// RevokeSharedLink stops a document's link from being used.
+func (c *controller) RevokeSharedLink(ctx context.Context, documentId string, linkId string) error {
+	matched, err := c.sharedLinkCollection.Update(ctx, bson.M{"linkid": linkId, "documentid": documentId}, bson.M{
+		"revokedat": time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+	if !matched {
+		return mongodriver.ErrNoDocuments
+	}
+	return nil
+}
+
+// OpenSharedLink checks a link's signature and that it may still be used,
+// and returns the document version it shares along with the link. Single use
+// links are not used up until ClaimSharedLink is called, once their download
+// has been opened.
+func (c *controller) OpenSharedLink(ctx context.Context, linkId string, expires int64, signature string) (*models.Document, *models.SharedLink, error) {
+	if c.linkSigningKey == nil {
+		return nil, nil, ErrSharingDisabled
+	}
+	expected := c.linkSignature(linkId, expires)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return nil, nil, ErrInvalidLinkSignature
+	}
+
+	link := &models.SharedLink{}
+	err := c.sharedLinkCollection.FindOne(ctx, bson.M{"linkid": linkId}, link)
+	if err == mongodriver.ErrNoDocuments {
+		return nil, nil, &SharedLinkError{Reason: "link no longer exists"}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := sharedLinkUsable(link, time.Now()); err != nil {
+		return nil, nil, err
+	}
+
+	doc, err := c.GetDocumentVersion(ctx, link.DocumentId, link.Version)
+	if err == mongodriver.ErrNoDocuments {
+		return nil, nil, &SharedLinkError{Reason: "document no longer exists"}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := c.DocumentDownloadable(doc); err != nil {
+		return nil, nil, err
+	}
+	return doc, link, nil
+}
+
+// ClaimSharedLink marks a single use link used, unless someone else has
+// already, so it serves a single download. Other links are left as they are.
+func (c *controller) ClaimSharedLink(ctx context.Context, link *models.SharedLink) error {
+	if !link.SingleUse {
+		return nil
+	}
+	claimed, err := c.sharedLinkCollection.Update(ctx, bson.M{"linkid": link.LinkId, "usedat": ""}, bson.M{
+		"usedat": time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return &SharedLinkError{Reason: "link has already been used"}
+	}
+	return nil
+}
+
+// sharedLinkUsable returns a *SharedLinkError when link may no longer be
+// used at now.
+func sharedLinkUsable(link *models.SharedLink, now time.Time) error {
+	if link.RevokedAt != "" {
+		return &SharedLinkError{Reason: "link has been revoked"}
+	}
+	if link.SingleUse && link.UsedAt != "" {
+		return &SharedLinkError{Reason: "link has already been used"}
+	}
+	expiresAt, err := time.Parse(time.RFC3339, link.ExpiresAt)
+	if err != nil || !now.Before(expiresAt) {
+		return &SharedLinkError{Reason: "link has expired"}
+	}
+	return nil
+}
+
+// signedLinkUrl returns the URL link is downloaded from. The expiry is
+// signed along with the link id, so it cannot be extended.
+func (c *controller) signedLinkUrl(link *models.SharedLink) (string, error) {
+	expiresAt, err := time.Parse(time.RFC3339, link.ExpiresAt)
+	if err != nil {
+		return "", err
+	}
+	expires := expiresAt.Unix()
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", c.linkSignature(link.LinkId, expires))
+	return strings.TrimSuffix(c.sharing.BaseUrl, "/") + "/v1/ply/shared/" + link.LinkId + "?" + query.Encode(), nil
+}
+
+func (c *controller) linkSignature(linkId string, expires int64) string {
+	mac := hmac.New(sha256.New, c.linkSigningKey)
+	mac.Write([]byte(linkId + "\n" + strconv.FormatInt(expires, 10)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"context"
+	"log"
+
+	"code.ply.internal/core/models"
+	"go.mongodb.org/mongo-driver/bson"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+// unclassifiedUsage is the type usage of documents without a type is
+// counted under.
+const unclassifiedUsage = "unclassified"
+
+// GetStorageUsage returns how much a practice stores, in total and by
+// document type, along with its quota.
+func (c *controller) GetStorageUsage(ctx context.Context, practiceId string) (*models.StorageUsage, error) {
+	usage, err := c.storageUsage(ctx, practiceId)
+	if err != nil {
+		return nil, err
+	}
+	usage.QuotaBytes = c.quotaBytes(practiceId)
+	return usage, nil
+}
+
+// storageUsage reads a practice's tracked usage. The first time, usage is
+// counted from the practice's document records, so it must be read before a
+// change to those records is charged.
+func (c *controller) storageUsage(ctx context.Context, practiceId string) (*models.StorageUsage, error) {
+	usage := &models.StorageUsage{}
+	err := c.storageUsageCollection.FindOne(ctx, bson.M{"practiceid": practiceId}, usage)
+	if err == nil {
+		return usage, nil
+	}
+	if err != mongodriver.ErrNoDocuments {
+		return nil, err
+	}
+
+	docs, err := c.ListDocuments(ctx, practiceId, nil)
+	if err != nil {
+		return nil, err
+	}
+	usage = &models.StorageUsage{
+		PracticeId: practiceId,
+		Types:      map[string]*models.TypeUsage{},
+	}
+	for _, doc := range docs {
+		size, err := c.documentBytes(ctx, doc)
+		if err != nil {
+			return nil, err
+		}
+
+		usageType := documentUsageType(doc.DocumentType)
+		if usage.Types[usageType] == nil {
+			usage.Types[usageType] = &models.TypeUsage{}
+		}
+		usage.Types[usageType].Bytes += size
+		usage.Types[usageType].Documents++
+		usage.Bytes += size
+		usage.Documents++
+	}
+
+	err = c.storageUsageCollection.Upsert(ctx, bson.M{"practiceid": practiceId}, usage)
+	if err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+// reserveQuota charges size bytes to a practice's usage before they are
+// stored, and returns a *QuotaExceededError instead when that would take the
+// practice past its quota. Checking and charging are one update, so
+// concurrent uploads cannot all fit in the same space. Reserved bytes that
+// end up not stored are released by charging them back.
+func (c *controller) reserveQuota(ctx context.Context, practiceId string, documentType string, size int64) error {
+	// Usage is counted from the document records if it isn't tracked yet
+	usage, err := c.storageUsage(ctx, practiceId)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{"practiceid": practiceId}
+	quota := c.quotaBytes(practiceId)
+	if quota > 0 {
+		if size > quota {
+			return &QuotaExceededError{Quota: quota, Used: usage.Bytes, Size: size}
+		}
+		filter["bytes"] = bson.M{"$lte": quota - size}
+	}
+
+	reserved, err := c.storageUsageCollection.IncrementExisting(ctx, filter, usageIncrements(documentType, size, 0))
+	if err != nil {
+		return err
+	}
+	if !reserved {
+		if usage, err = c.storageUsage(ctx, practiceId); err != nil {
+			return err
+		}
+		return &QuotaExceededError{Quota: quota, Used: usage.Bytes, Size: size}
+	}
+	return nil
+}
+
+// settleQuota corrects a reservation of reserved bytes to the size actually
+// stored, reserving the difference when more was stored than declared. When
+// that fails the whole reservation is released.
+func (c *controller) settleQuota(ctx context.Context, practiceId string, documentType string, reserved int64, size int64) error {
+	if size > reserved {
+		if err := c.reserveQuota(ctx, practiceId, documentType, size-reserved); err != nil {
+			c.chargeUsage(ctx, practiceId, documentType, -reserved, 0)
+			return err
+		}
+		return nil
+	}
+	if size < reserved {
+		c.chargeUsage(ctx, practiceId, documentType, size-reserved, 0)
+	}
+	return nil
+}
+
+// chargeUsage adds bytes and documents, either of which may be negative, to
+// a practice's usage. The change has already been made by then, so failures
+// are logged rather than returned.
+func (c *controller) chargeUsage(ctx context.Context, practiceId string, documentType string, bytes int64, documents int) {
+	err := c.storageUsageCollection.Increment(ctx, bson.M{"practiceid": practiceId}, usageIncrements(documentType, bytes, documents))
+	if err != nil {
+		log.Printf("error updating storage usage of practice %s: %v", practiceId, err)
+	}
+}
+
+func usageIncrements(documentType string, bytes int64, documents int) bson.M {
+	usageType := documentUsageType(documentType)
+	return bson.M{
+		"bytes":                             bytes,
+		"documents":                         documents,
+		"types." + usageType + ".bytes":     bytes,
+		"types." + usageType + ".documents": documents,
+	}
+}
+
+// documentBytes returns the size of every stored version of doc.
+func (c *controller) documentBytes(ctx context.Context, doc *models.Document) (int64, error) {
+	versions := []*models.DocumentVersion{}
+	err := c.documentVersionCollection.Find(ctx, bson.M{"documentid": doc.DocumentId}, &versions)
+	if err != nil {
+		return 0, err
+	}
+	if len(versions) == 0 {
+		return doc.Size, nil
+	}
+
+	var size int64
+	for _, version := range versions {
+		size += version.Size
+	}
+	return size, nil
+}
+
+func (c *controller) quotaBytes(practiceId string) int64 {
+	if quota, ok := c.quotas.Practices[practiceId]; ok {
+		return quota
+	}
+	return c.quotas.DefaultBytes
+}
+
+func documentUsageType(documentType string) string {
+	if documentType == "" {
+		return unclassifiedUsage
+	}
+	return documentType
+}
@@ -0,0 +1,588 @@
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"code.ply.internal/core/auth"
+	"code.ply.internal/core/config"
+	"code.ply.internal/core/gateway/mailer"
+	"code.ply.internal/core/models"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	passwordHashCost = 12
+
+	// maxPasswordBytes is as much of a password as bcrypt hashes
+	maxPasswordBytes = 72
+
+	defaultMinPasswordLength   = 12
+	defaultInvitationExpiry    = 7 * 24 * time.Hour
+	defaultPasswordResetExpiry = time.Hour
+	defaultMaxFailedLogins     = 5
+	defaultLockoutDuration     = 15 * time.Minute
+
+	// unknownUserHash is compared against when logging in to an email with
+	// no usable account, so it takes as long as a wrong password does
+	unknownUserHash = "$2a$12$efB1emSTncfWtGIgYYOx1OO1jhdSOiNTGNJ/gUHw2tLFVYuXGrNZC"
+)
+
+var (
+	ErrAccountsDisabled = errors.New("user accounts are not configured")
+	ErrMailDisabled     = errors.New("email is not configured")
+	ErrInvalidLogin     = errors.New("email or password is incorrect")
+	ErrInvalidUserToken = errors.New("token is invalid or has expired")
+	ErrNotAuthenticated = errors.New("not authenticated")
+)
+
+// SessionParams configures session tokens from the accounts config, for
+// issuing them here and verifying them when requests are authenticated.
+func SessionParams(cfg config.AccountsConfig) auth.SessionParams {
+	return auth.SessionParams{
+		Issuer:         cfg.Issuer,
+		SigningKeyFile: cfg.SigningKeyFile,
+		SigningKeyEnv:  cfg.SigningKeyEnv,
+		Expiry:         cfg.TokenExpiry,
+	}
+}
+
+// InviteUser grants an invitation's role to the user with its email,
+// creating the user when there is none, and emails users yet to accept an
+// invitation a link to set their password. Earlier invitations of the user
+// stop working.
+func (c *controller) InviteUser(ctx context.Context, invitation *models.Invitation) (*models.User, error) {
+	if c.sessions == nil {
+		return nil, ErrAccountsDisabled
+	}
+	if c.mailer == nil {
+		return nil, ErrMailDisabled
+	}
+
+	email, err := normalizeEmail(invitation.Email)
+	if err != nil {
+		return nil, err
+	}
+	grant := &models.RoleGrant{
+		Role:           invitation.Role,
+		PracticeId:     invitation.PracticeId,
+		OrganizationId: invitation.OrganizationId,
+	}
+	if err := c.validateRoleGrant(ctx, grant); err != nil {
+		return nil, err
+	}
+
+	user := &models.User{}
+	err = c.userCollection.FindOne(ctx, bson.M{"email": email}, user)
+	switch {
+	case errors.Is(err, mongodriver.ErrNoDocuments):
+		user = &models.User{
+			UserId:    uuid.New().String(),
+			Email:     email,
+			Name:      strings.TrimSpace(invitation.Name),
+			Status:    models.UserInvited,
+			CreatedAt: time.Now().UTC().Format(time.RFC3339),
+		}
+		if err := c.userCollection.Upsert(ctx, bson.M{"userid": user.UserId}, user); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case user.Status == models.UserDisabled:
+		return nil, &ConflictError{ExistingId: user.UserId, Message: "user " + email + " is disabled"}
+	}
+
+	existing := &models.RoleGrant{}
+	err = c.roleGrantCollection.FindOne(ctx, bson.M{
+		"subject":        user.UserId,
+		"subjectkind":    models.SubjectAccount,
+		"role":           grant.Role,
+		"practiceid":     grant.PracticeId,
+		"organizationid": grant.OrganizationId,
+	}, existing)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		grant.Subject = user.UserId
+		grant.SubjectKind = models.SubjectAccount
+		_, err = c.CreateRoleGrant(ctx, grant)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Status != models.UserInvited {
+		return user, nil
+	}
+	expiry := c.accounts.InvitationExpiry
+	if expiry <= 0 {
+		expiry = defaultInvitationExpiry
+	}
+	token, expiresAt, err := c.issueUserToken(ctx, user, models.TokenInvitation, expiry)
+	if err != nil {
+		return nil, err
+	}
+	err = c.mailer.Send(ctx, &mailer.Message{
+		To:      user.Email,
+		Subject: "You're invited to Ply",
+		Body: fmt.Sprintf("You have been invited to Ply. Set a password to activate your account:\n\n%s\n\n"+
+			"This link can be used once and expires at %s.",
+			tokenLink(c.accounts.InvitationUrl, token), expiresAt.UTC().Format(time.RFC1123)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error sending invitation: %w", err)
+	}
+	return user, nil
+}
+
+// AcceptInvitation sets the password of the user an invitation token was
+// sent to, activating their account, and logs them in.
+func (c *controller) AcceptInvitation(ctx context.Context, token string, password string, name string) (*models.Session, error) {
+	if c.sessions == nil {
+		return nil, ErrAccountsDisabled
+	}
+	// Checked before the token is used up, so a weak password can be retried
+	if err := c.validatePassword(password); err != nil {
+		return nil, err
+	}
+	user, err := c.consumeUserToken(ctx, token, models.TokenInvitation)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := passwordFields(password)
+	if err != nil {
+		return nil, err
+	}
+	fields["status"] = models.UserActive
+	if name = strings.TrimSpace(name); name != "" {
+		fields["name"] = name
+		user.Name = name
+	}
+	if _, err := c.userCollection.Update(ctx, bson.M{"userid": user.UserId}, fields); err != nil {
+		return nil, err
+	}
+	user.Status = models.UserActive
+	return c.startSession(ctx, user, false)
+}
+
+// Login checks a user's email and password and returns a session for them.
+// Accounts are locked for a while after too many wrong passwords in a row,
+// and unknown, inactive and locked accounts are all refused with
+// ErrInvalidLogin after a password hash comparison. Users with multi-factor authentication instead get a token to complete
+// their login with CompleteMfaLogin.
+func (c *controller) Login(ctx context.Context, email string, password string) (*models.Session, error) {
+	if c.sessions == nil {
+		return nil, ErrAccountsDisabled
+	}
+
+	user := &models.User{}
+	err := c.userCollection.FindOne(ctx, bson.M{"email": strings.ToLower(strings.TrimSpace(email))}, user)
+	if err != nil && !errors.Is(err, mongodriver.ErrNoDocuments) {
+		return nil, err
+	}
+	if err != nil || user.Status != models.UserActive || user.PasswordHash == "" {
+		bcrypt.CompareHashAndPassword([]byte(unknownUserHash), []byte(password))
+		return nil, ErrInvalidLogin
+	}
+
+	// A locked account is refused like an unknown email, after as long,
+	// so logging in can't tell which emails have accounts
+	now := time.Now()
+	wrongPassword := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)) != nil
+	if user.LockedUntil != "" {
+		lockedUntil, err := time.Parse(time.RFC3339, user.LockedUntil)
+		if err == nil && now.Before(lockedUntil) {
+			return nil, ErrInvalidLogin
+		}
+	}
+
+	if wrongPassword {
+		if err := c.recordFailedLogin(ctx, user, now); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidLogin
+	}
+
+	// Failures are only reset once the second factor checks out too, so
+	// codes can not be guessed without counting towards the lockout
+	if user.MfaEnabled {
+		token, expiresAt, err := c.sessions.IssueChallenge(user.UserId)
+		if err != nil {
+			return nil, err
+		}
+		return &models.Session{
+			ExpiresAt:   expiresAt.UTC().Format(time.RFC3339),
+			MfaRequired: true,
+			MfaToken:    token,
+		}, nil
+	}
+
+	if user.FailedLogins != 0 || user.LockedUntil != "" {
+		_, err := c.userCollection.Update(ctx, bson.M{"userid": user.UserId}, bson.M{"failedlogins": 0, "lockeduntil": ""})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c.startSession(ctx, user, false)
+}
+
+// recordFailedLogin counts a wrong password against user, locking the
+// account once there have been too many in a row.
+func (c *controller) recordFailedLogin(ctx context.Context, user *models.User, now time.Time) error {
+	maxFailed := c.accounts.MaxFailedLogins
+	if maxFailed <= 0 {
+		maxFailed = defaultMaxFailedLogins
+	}
+	lockout := c.accounts.LockoutDuration
+	if lockout <= 0 {
+		lockout = defaultLockoutDuration
+	}
+
+	fields := bson.M{"failedlogins": user.FailedLogins + 1}
+	if user.FailedLogins+1 >= maxFailed {
+		fields = bson.M{
+			"failedlogins": 0,
+			"lockeduntil":  now.Add(lockout).UTC().Format(time.RFC3339),
+		}
+	}
+	_, err := c.userCollection.Update(ctx, bson.M{"userid": user.UserId}, fields)
+	return err
+}
+
+// RequestPasswordReset emails the active user with email a link to reset
+// their password. Whether there is one is not revealed.
+func (c *controller) RequestPasswordReset(ctx context.Context, email string) error {
+	if c.sessions == nil {
+		return ErrAccountsDisabled
+	}
+	if c.mailer == nil {
+		return ErrMailDisabled
+	}
+
+	user := &models.User{}
+	err := c.userCollection.FindOne(ctx, bson.M{"email": strings.ToLower(strings.TrimSpace(email))}, user)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if user.Status != models.UserActive {
+		return nil
+	}
+
+	expiry := c.accounts.PasswordResetExpiry
+	if expiry <= 0 {
+		expiry = defaultPasswordResetExpiry
+	}
+	token, expiresAt, err := c.issueUserToken(ctx, user, models.TokenPasswordReset, expiry)
+	if err != nil {
+		return err
+	}
+	err = c.mailer.Send(ctx, &mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your Ply password",
+		Body: fmt.Sprintf("A password reset was requested for your Ply account. Choose a new password here:\n\n%s\n\n"+
+			"This link can be used once and expires at %s. If you did not ask to reset your password, ignore this email.",
+			tokenLink(c.accounts.PasswordResetUrl, token), expiresAt.UTC().Format(time.RFC1123)),
+	})
+	if err != nil {
+		return fmt.Errorf("error sending password reset: %w", err)
+	}
+	return nil
+}
+
+// ResetPassword sets the password of the user a reset token was sent to.
+// Their existing sessions stop working.
+func (c *controller) ResetPassword(ctx context.Context, token string, password string) error {
+	if c.sessions == nil {
+		return ErrAccountsDisabled
+	}
+	if err := c.validatePassword(password); err != nil {
+		return err
+	}
+	user, err := c.consumeUserToken(ctx, token, models.TokenPasswordReset)
+	if err != nil {
+		return err
+	}
+
+	fields, err := passwordFields(password)
+	if err != nil {
+		return err
+	}
+	_, err = c.userCollection.Update(ctx, bson.M{"userid": user.UserId}, fields)
+	return err
+}
+
+// ChangePassword changes the password of the user logged in on ctx, ending
+// their other sessions, and returns a new session for them.
+func (c *controller) ChangePassword(ctx context.Context, currentPassword string, newPassword string) (*models.Session, error) {
+	user, err := c.sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(currentPassword)) != nil {
+		return nil, &ValidationError{Message: "current password is incorrect"}
+	}
+	if err := c.validatePassword(newPassword); err != nil {
+		return nil, err
+	}
+
+	fields, err := passwordFields(newPassword)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.userCollection.Update(ctx, bson.M{"userid": user.UserId}, fields); err != nil {
+		return nil, err
+	}
+	principal, _ := auth.PrincipalFromContext(ctx)
+	return c.startSession(ctx, user, principal.Mfa)
+}
+
+// ReadCurrentUser returns who the caller is authenticated as and the roles
+// they hold.
+func (c *controller) ReadCurrentUser(ctx context.Context) (*models.Me, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, ErrNotAuthenticated
+	}
+
+	me := &models.Me{
+		Subject: principal.Subject,
+		Kind:    principal.Kind,
+		Email:   principal.Email,
+		Name:    principal.Name,
+	}
+	if principal.Session {
+		user := &models.User{}
+		if err := c.userCollection.FindOne(ctx, bson.M{"userid": principal.Subject}, user); err != nil {
+			return nil, err
+		}
+		me.User = user
+		me.Email = user.Email
+		me.Name = user.Name
+	}
+
+	grants, err := c.ListRoleGrants(ctx, principalSubjectKind(principal), principal.Subject)
+	if err != nil {
+		return nil, err
+	}
+	me.Grants = grants
+	return me, nil
+}
+
+func (c *controller) ListUsers(ctx context.Context) ([]*models.User, error) {
+	users := []*models.User{}
+	if err := c.userCollection.Find(ctx, bson.M{}, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// DisableUser stops a user from logging in and ends their sessions. Their
+// role grants are kept.
+func (c *controller) DisableUser(ctx context.Context, userId string) error {
+	matched, err := c.userCollection.Update(ctx, bson.M{"userid": userId}, bson.M{
+		"status":           models.UserDisabled,
+		"tokensvalidafter": time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+	if !matched {
+		return mongodriver.ErrNoDocuments
+	}
+	return c.userTokenCollection.DeleteMany(ctx, bson.M{"userid": userId})
+}
+
+// CheckSession refuses session tokens of users who were since disabled, or
+// issued before the user's password last changed.
+func (c *controller) CheckSession(ctx context.Context, principal *auth.Principal) error {
+	user := &models.User{}
+	err := c.userCollection.FindOne(ctx, bson.M{"userid": principal.Subject}, user)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return fmt.Errorf("%w: account does not exist", auth.ErrInvalidCredentials)
+	}
+	if err != nil {
+		return err
+	}
+	if user.Status != models.UserActive {
+		return fmt.Errorf("%w: account is disabled", auth.ErrInvalidCredentials)
+	}
+	if user.TokensValidAfter != "" {
+		validAfter, err := time.Parse(time.RFC3339, user.TokensValidAfter)
+		if err != nil {
+			return err
+		}
+		if principal.IssuedAt.Before(validAfter) {
+			return fmt.Errorf("%w: token has been revoked", auth.ErrInvalidCredentials)
+		}
+	}
+	return nil
+}
+
+// sessionUser returns the account of the user logged in on ctx.
+func (c *controller) sessionUser(ctx context.Context) (*models.User, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || !principal.Session {
+		return nil, ErrNotAuthenticated
+	}
+	user := &models.User{}
+	if err := c.userCollection.FindOne(ctx, bson.M{"userid": principal.Subject}, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// startSession issues a token for user and records the login. mfa records
+// that they logged in with a second factor.
+func (c *controller) startSession(ctx context.Context, user *models.User, mfa bool) (*models.Session, error) {
+	token, expiresAt, err := c.sessions.Issue(user.UserId, user.Email, user.Name, mfa)
+	if err != nil {
+		return nil, err
+	}
+	user.LastLoginAt = time.Now().UTC().Format(time.RFC3339)
+	if _, err := c.userCollection.Update(ctx, bson.M{"userid": user.UserId}, bson.M{"lastloginat": user.LastLoginAt}); err != nil {
+		return nil, err
+	}
+	return &models.Session{
+		Token:     token,
+		ExpiresAt: expiresAt.UTC().Format(time.RFC3339),
+		User:      user,
+	}, nil
+}
+
+// issueUserToken creates a one-time token for user, replacing any earlier
+// one for the same purpose.
+func (c *controller) issueUserToken(ctx context.Context, user *models.User, purpose string, expiry time.Duration) (string, time.Time, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", time.Time{}, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(secret)
+
+	if err := c.userTokenCollection.DeleteMany(ctx, bson.M{"userid": user.UserId, "purpose": purpose}); err != nil {
+		return "", time.Time{}, err
+	}
+	now := time.Now().UTC()
+	record := &models.UserToken{
+		TokenHash: hashUserToken(token),
+		UserId:    user.UserId,
+		Purpose:   purpose,
+		ExpiresAt: now.Add(expiry).Format(time.RFC3339),
+		CreatedAt: now.Format(time.RFC3339),
+	}
+	if err := c.userTokenCollection.Upsert(ctx, bson.M{"tokenhash": record.TokenHash}, record); err != nil {
+		return "", time.Time{}, err
+	}
+	return token, now.Add(expiry), nil
+}
+
+// consumeUserToken uses up a one-time token and returns the user it was
+// issued to. It returns ErrInvalidUserToken for tokens that are unknown,
+// expired, already used or of users since disabled.
+func (c *controller) consumeUserToken(ctx context.Context, token string, purpose string) (*models.User, error) {
+	record := &models.UserToken{}
+	err := c.userTokenCollection.FindOne(ctx, bson.M{"tokenhash": hashUserToken(token), "purpose": purpose}, record)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return nil, ErrInvalidUserToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, err := time.Parse(time.RFC3339, record.ExpiresAt)
+	if err != nil || !time.Now().Before(expiresAt) {
+		return nil, ErrInvalidUserToken
+	}
+
+	// Claimed only while unused, so a token raced for is used once
+	claimed, err := c.userTokenCollection.Update(ctx, bson.M{"tokenhash": record.TokenHash, "usedat": ""}, bson.M{
+		"usedat": time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, ErrInvalidUserToken
+	}
+
+	user := &models.User{}
+	err = c.userCollection.FindOne(ctx, bson.M{"userid": record.UserId}, user)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return nil, ErrInvalidUserToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	if user.Status == models.UserDisabled {
+		return nil, ErrInvalidUserToken
+	}
+	return user, nil
+}
+
+func (c *controller) validatePassword(password string) error {
+	minLength := c.accounts.MinPasswordLength
+	if minLength <= 0 {
+		minLength = defaultMinPasswordLength
+	}
+	if utf8.RuneCountInString(password) < minLength {
+		return &ValidationError{Message: fmt.Sprintf("password must be at least %d characters", minLength)}
+	}
+	if len(password) > maxPasswordBytes {
+		return &ValidationError{Message: fmt.Sprintf("password must be at most %d bytes", maxPasswordBytes)}
+	}
+	return nil
+}
+
+// passwordFields returns the user fields that set a new password, ending
+// sessions issued before it and lifting any lockout.
+func passwordFields(password string) (bson.M, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), passwordHashCost)
+	if err != nil {
+		return nil, err
+	}
+	return bson.M{
+		"passwordhash":     string(hash),
+		"tokensvalidafter": time.Now().UTC().Format(time.RFC3339),
+		"failedlogins":     0,
+		"lockeduntil":      "",
+	}, nil
+}
+
+func normalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return "", &ValidationError{Message: "a valid email is required"}
+	}
+	return email, nil
+}
+
+func hashUserToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// tokenLink adds token to base as its token query parameter.
+func tokenLink(base string, token string) string {
+	link, err := url.Parse(base)
+	if err != nil {
+		return base + "?token=" + url.QueryEscape(token)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String()
+}
@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"code.ply.internal/core/models"
+	"go.mongodb.org/mongo-driver/bson"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+var (
+	ssnPattern = regexp.MustCompile(`^\d{3}-?\d{2}-?\d{4}$`)
+	einPattern = regexp.MustCompile(`^\d{2}-?\d{7}$`)
+)
+
+// validateProvider checks the fields of a provider being created or updated.
+func validateProvider(provider *models.Provider) error {
+	if provider.Ssn != "" && !ssnPattern.MatchString(provider.Ssn) {
+		return &ValidationError{Message: "ssn must be nine digits, as 123-45-6789"}
+	}
+	return nil
+}
+
+// validatePractice checks the fields of a practice being created or updated.
+func validatePractice(practice *models.Practice) error {
+	if practice.Ein != "" && !einPattern.MatchString(practice.Ein) {
+		return &ValidationError{Message: "ein must be nine digits, as 12-3456789"}
+	}
+	return nil
+}
+
+// validateEnrollment checks the fields of an enrollment being created or
+// updated. An enrollment without a kind is an individual one, as those from
+// before kinds were recorded are.
+func validateEnrollment(enrollment *models.Enrollment) error {
+	switch enrollment.Kind {
+	case "":
+		enrollment.Kind = models.EnrollmentKindIndividual
+	case models.EnrollmentKindGroup, models.EnrollmentKindIndividual:
+	default:
+		return &ValidationError{Message: fmt.Sprintf("kind must be %q or %q", models.EnrollmentKindGroup, models.EnrollmentKindIndividual)}
+	}
+	return nil
+}
+
+// checkDuplicateSsn returns a *ConflictError naming the provider, other than
+// providerId, that already has ssn. A provider is a single identity shared by
+// every practice they work at, so no two may have the same SSN.
+func (c *controller) checkDuplicateSsn(ctx context.Context, providerId string, ssn string) error {
+	if ssn == "" {
+		return nil
+	}
+	existing := &models.Provider{}
+	err := c.providerCollection.FindOne(ctx, bson.M{"ssn": ssn, "providerid": bson.M{"$ne": providerId}}, existing)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return &ConflictError{
+		ExistingId: existing.ProviderId,
+		Message:    fmt.Sprintf("provider %s already has this ssn", existing.ProviderId),
+	}
+}
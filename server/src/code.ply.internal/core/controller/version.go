@@ -0,0 +1,266 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"time"
+
+	"code.ply.internal/core/models"
+	"go.mongodb.org/mongo-driver/bson"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+// UploadDocumentVersion stores file as a new version of a document and makes
+// it current. It returns the new version number. size is the declared size
+// of file, or 0 when it isn't known, and is reserved against the practice's
+// quota before the file is stored.
+func (c *controller) UploadDocumentVersion(ctx context.Context, documentId string, fileName string, size int64, file io.Reader) (int, error) {
+	doc, err := c.GetDocument(ctx, documentId)
+	if err != nil {
+		return 0, err
+	}
+
+	versions, err := c.ListDocumentVersions(ctx, documentId)
+	if err != nil {
+		return 0, err
+	}
+
+	// Record the original file of documents uploaded before versioning
+	if doc.CurrentVersion == 0 {
+		if err := c.saveDocumentVersion(ctx, versions[0]); err != nil {
+			return 0, err
+		}
+	}
+
+	reserved := size
+	if err := c.reserveQuota(ctx, doc.PracticeId, doc.DocumentType, reserved); err != nil {
+		return 0, err
+	}
+	next, err := c.reserveDocumentVersion(ctx, documentId, versions[len(versions)-1].Version+1)
+	if err != nil {
+		c.chargeUsage(ctx, doc.PracticeId, doc.DocumentType, -reserved, 0)
+		return 0, err
+	}
+	release := func() {
+		c.documentVersionCollection.DeleteOne(ctx, bson.M{"documentid": documentId, "version": next})
+		c.chargeUsage(ctx, doc.PracticeId, doc.DocumentType, -reserved, 0)
+	}
+
+	upload := &models.Document{
+		DocumentId: doc.DocumentId,
+		PracticeId: doc.PracticeId,
+		FileName:   fileName,
+	}
+	storageKey := path.Join(doc.PracticeId, fmt.Sprintf("%s_v%d_%s", doc.DocumentId, next, sanitizeFileName(fileName)))
+	if err := c.storeDocumentFile(ctx, upload, storageKey, file); err != nil {
+		release()
+		return 0, err
+	}
+	if err := c.settleQuota(ctx, doc.PracticeId, doc.DocumentType, reserved, upload.Size); err != nil {
+		c.documentStorage.Delete(ctx, upload.StoragePath)
+		c.documentVersionCollection.DeleteOne(ctx, bson.M{"documentid": documentId, "version": next})
+		return 0, err
+	}
+	reserved = upload.Size
+
+	version := documentVersion(upload, next)
+	if err := c.saveDocumentVersion(ctx, version); err != nil {
+		c.documentStorage.Delete(ctx, upload.StoragePath) // Clean up on error
+		release()
+		return 0, err
+	}
+
+	// A concurrent upload may already have made a later version current, in
+	// which case this one is kept as an earlier version
+	applyDocumentVersion(doc, version)
+	if err := c.queueExtraction(ctx, doc); err != nil {
+		c.documentStorage.Delete(ctx, upload.StoragePath)
+		release()
+		return 0, err
+	}
+	current, err := c.documentCollection.Update(ctx, bson.M{
+		"documentid": doc.DocumentId,
+		"$or": bson.A{
+			bson.M{"currentversion": bson.M{"$lt": next}},
+			bson.M{"currentversion": bson.M{"$exists": false}},
+		},
+	}, currentVersionFields(doc))
+	if err != nil {
+		c.documentStorage.Delete(ctx, upload.StoragePath)
+		release()
+		return 0, err
+	}
+
+	if current {
+		c.indexUploadedDocument(ctx, doc)
+	}
+	return next, nil
+}
+
+// maxVersionAttempts bounds how many numbers reserveDocumentVersion tries
+// when concurrent uploads take the ones it picks.
+const maxVersionAttempts = 10
+
+// reserveDocumentVersion claims the first free version number of a document
+// from next on by inserting a placeholder version, which the unique index on
+// document and version keeps any other upload from claiming too. The
+// placeholder has no storage path until the version's file is saved over it,
+// and is left out of listings and reconciliation until then.
+func (c *controller) reserveDocumentVersion(ctx context.Context, documentId string, next int) (int, error) {
+	for attempt := 0; attempt < maxVersionAttempts; attempt++ {
+		err := c.documentVersionCollection.Insert(ctx, &models.DocumentVersion{
+			DocumentId: documentId,
+			Version:    next,
+		})
+		if !mongodriver.IsDuplicateKeyError(err) {
+			return next, err
+		}
+		next++
+	}
+	return 0, &ConflictError{
+		ExistingId: documentId,
+		Message:    "too many concurrent uploads of this document; try again",
+	}
+}
+
+// ListDocumentVersions returns a document's versions, oldest first.
+func (c *controller) ListDocumentVersions(ctx context.Context, documentId string) ([]*models.DocumentVersion, error) {
+	doc, err := c.GetDocument(ctx, documentId)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []*models.DocumentVersion{}
+	err = c.documentVersionCollection.Find(ctx, bson.M{"documentid": documentId, "storagepath": bson.M{"$ne": ""}}, &versions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Documents uploaded before versioning have only their original file,
+	// and when it was uploaded is not known
+	if len(versions) == 0 {
+		original := documentVersion(doc, 1)
+		original.UploadedAt = ""
+		return []*models.DocumentVersion{original}, nil
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version < versions[j].Version
+	})
+	return versions, nil
+}
+
+// GetDocumentVersion returns the document as it was with the given version
+// current, so the version's file can be opened like any document's.
+func (c *controller) GetDocumentVersion(ctx context.Context, documentId string, version int) (*models.Document, error) {
+	doc, err := c.GetDocument(ctx, documentId)
+	if err != nil {
+		return nil, err
+	}
+	if version == doc.CurrentVersion || (doc.CurrentVersion == 0 && version == 1) {
+		return doc, nil
+	}
+
+	stored, err := c.readDocumentVersion(ctx, documentId, version)
+	if err != nil {
+		return nil, err
+	}
+	applyDocumentVersion(doc, stored)
+	return doc, nil
+}
+
+// SetCurrentDocumentVersion makes an existing version the one the document
+// serves, for example to roll back a bad upload.
+func (c *controller) SetCurrentDocumentVersion(ctx context.Context, documentId string, version int) error {
+	doc, err := c.GetDocument(ctx, documentId)
+	if err != nil {
+		return err
+	}
+	if version == doc.CurrentVersion || (doc.CurrentVersion == 0 && version == 1) {
+		return nil
+	}
+
+	stored, err := c.readDocumentVersion(ctx, documentId, version)
+	if err != nil {
+		return err
+	}
+	applyDocumentVersion(doc, stored)
+	if err := c.queueExtraction(ctx, doc); err != nil {
+		return err
+	}
+	if _, err := c.documentCollection.Update(ctx, bson.M{"documentid": documentId}, currentVersionFields(doc)); err != nil {
+		return err
+	}
+	c.indexUploadedDocument(ctx, doc)
+	return nil
+}
+
+func (c *controller) readDocumentVersion(ctx context.Context, documentId string, version int) (*models.DocumentVersion, error) {
+	stored := &models.DocumentVersion{}
+	err := c.documentVersionCollection.FindOne(ctx, bson.M{"documentid": documentId, "version": version, "storagepath": bson.M{"$ne": ""}}, stored)
+	if err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+func (c *controller) saveDocumentVersion(ctx context.Context, version *models.DocumentVersion) error {
+	return c.documentVersionCollection.Upsert(ctx, bson.M{"documentid": version.DocumentId, "version": version.Version}, version)
+}
+
+// documentVersion records doc's current file fields as the given version.
+func documentVersion(doc *models.Document, version int) *models.DocumentVersion {
+	return &models.DocumentVersion{
+		DocumentId:  doc.DocumentId,
+		Version:     version,
+		FileName:    doc.FileName,
+		StoragePath: doc.StoragePath,
+		ContentType: doc.ContentType,
+		Size:        doc.Size,
+		Sha256:      doc.Sha256,
+		UploadedAt:  time.Now().UTC().Format(time.RFC3339),
+
+		ScanStatus:    doc.ScanStatus,
+		ScanSignature: doc.ScanSignature,
+
+		KeyId:      doc.KeyId,
+		WrappedKey: doc.WrappedKey,
+	}
+}
+
+// currentVersionFields are the fields of doc that making a version current
+// changes, so that is all writing it back sets.
+func currentVersionFields(doc *models.Document) bson.M {
+	return bson.M{
+		"filename":         doc.FileName,
+		"storagepath":      doc.StoragePath,
+		"contenttype":      doc.ContentType,
+		"size":             doc.Size,
+		"sha256":           doc.Sha256,
+		"scanstatus":       doc.ScanStatus,
+		"scansignature":    doc.ScanSignature,
+		"keyid":            doc.KeyId,
+		"wrappedkey":       doc.WrappedKey,
+		"currentversion":   doc.CurrentVersion,
+		"extractionstatus": doc.ExtractionStatus,
+		"extractedfields":  doc.ExtractedFields,
+	}
+}
+
+// applyDocumentVersion copies version's file fields onto doc and makes it
+// doc's current version.
+func applyDocumentVersion(doc *models.Document, version *models.DocumentVersion) {
+	doc.FileName = version.FileName
+	doc.StoragePath = version.StoragePath
+	doc.ContentType = version.ContentType
+	doc.Size = version.Size
+	doc.Sha256 = version.Sha256
+	doc.ScanStatus = version.ScanStatus
+	doc.ScanSignature = version.ScanSignature
+	doc.KeyId = version.KeyId
+	doc.WrappedKey = version.WrappedKey
+	doc.CurrentVersion = version.Version
+}
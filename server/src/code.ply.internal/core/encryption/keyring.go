@@ -0,0 +1,206 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// DataKeySize is the length of the per-document AES-256 data keys.
+const DataKeySize = 32
+
+var ErrUnknownKey = errors.New("unknown master key")
+
+type (
+	// Keyring holds the master keys that wrap per-document data keys. New
+	// data keys are wrapped with the active key; the others are kept so
+	// documents wrapped before a rotation can still be read.
+	Keyring struct {
+		activeKeyId string
+		keys        map[string]cipher.AEAD
+	}
+
+	Params struct {
+		ActiveKeyId string
+		Keys        []KeyParams
+	}
+
+	// KeyParams names a base64 encoded 32 byte master key, read from File
+	// or, when File is empty, from the environment variable Env.
+	KeyParams struct {
+		Id   string
+		File string
+		Env  string
+	}
+)
+
+func New(p Params) (*Keyring, error) {
+	keyring := &Keyring{
+		activeKeyId: p.ActiveKeyId,
+		keys:        map[string]cipher.AEAD{},
+	}
+
+	for _, key := range p.Keys {
+		material, err := LoadKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("error loading master key %q: %w", key.Id, err)
+		}
+		aead, err := newAEAD(material)
+		if err != nil {
+			return nil, fmt.Errorf("error loading master key %q: %w", key.Id, err)
+		}
+		keyring.keys[key.Id] = aead
+	}
+
+	if p.ActiveKeyId != "" {
+		if _, ok := keyring.keys[p.ActiveKeyId]; !ok {
+			return nil, fmt.Errorf("active master key %q is not configured", p.ActiveKeyId)
+		}
+	}
+	return keyring, nil
+}
+
+// Enabled reports whether new documents should be encrypted.
+func (k *Keyring) Enabled() bool {
+	return k.activeKeyId != ""
+}
+
+func (k *Keyring) ActiveKeyId() string {
+	return k.activeKeyId
+}
+
+// NewDataKey generates a data key for a document and returns it with its
+// wrapped form. The document id is bound to the wrapped key so it cannot be
+// moved to another document's record.
+func (k *Keyring) NewDataKey(documentId string) (dataKey []byte, wrappedKey []byte, err error) {
+	dataKey = make([]byte, DataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, nil, err
+	}
+
+	wrappedKey, err = k.wrap(k.activeKeyId, documentId, dataKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return dataKey, wrappedKey, nil
+}
+
+// Unwrap recovers the data key of a document wrapped with master key keyId.
+func (k *Keyring) Unwrap(keyId string, documentId string, wrappedKey []byte) ([]byte, error) {
+	aead, ok := k.keys[keyId]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKey, keyId)
+	}
+
+	nonceSize := aead.NonceSize()
+	if len(wrappedKey) < nonceSize {
+		return nil, errors.New("wrapped data key is truncated")
+	}
+	dataKey, err := aead.Open(nil, wrappedKey[:nonceSize], wrappedKey[nonceSize:], []byte(documentId))
+	if err != nil {
+		return nil, fmt.Errorf("error unwrapping data key: %w", err)
+	}
+	return dataKey, nil
+}
+
+// Rewrap unwraps a document's data key and wraps it again with the active
+// master key. The data key itself, and so the stored file, is unchanged.
+func (k *Keyring) Rewrap(keyId string, documentId string, wrappedKey []byte) ([]byte, error) {
+	dataKey, err := k.Unwrap(keyId, documentId, wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	return k.wrap(k.activeKeyId, documentId, dataKey)
+}
+
+func (k *Keyring) wrap(keyId string, documentId string, dataKey []byte) ([]byte, error) {
+	aead, ok := k.keys[keyId]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKey, keyId)
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, dataKey, []byte(documentId)), nil
+}
+
+// LoadKey reads the base64 encoded 32 byte key p names. p.Id is not used.
+func LoadKey(p KeyParams) ([]byte, error) {
+	var encoded string
+	switch {
+	case p.File != "":
+		content, err := os.ReadFile(p.File)
+		if err != nil {
+			return nil, err
+		}
+		encoded = string(content)
+	case p.Env != "":
+		encoded = os.Getenv(p.Env)
+		if encoded == "" {
+			return nil, fmt.Errorf("environment variable %s is not set", p.Env)
+		}
+	default:
+		return nil, errors.New("no file or environment variable given")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("key is not valid base64: %w", err)
+	}
+	if len(key) != DataKeySize {
+		return nil, fmt.Errorf("key is %d bytes, want %d", len(key), DataKeySize)
+	}
+	return key, nil
+}
+
+// Seal encrypts a small secret with key, bound to additionalData, which
+// must be given again to Open it.
+func Seal(key []byte, plaintext []byte, additionalData string) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, []byte(additionalData)), nil
+}
+
+// Open decrypts a secret encrypted with Seal.
+func Open(key []byte, sealed []byte, additionalData string) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonceSize := aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return nil, errors.New("sealed secret is truncated")
+	}
+	return aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], []byte(additionalData))
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// DeriveKey derives an independent key from key for the given label, so one
+// data key can protect several separately encrypted files.
+func DeriveKey(key []byte, label string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(label))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,214 @@
+package encryption
+
+import (
+	"bufio"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Files are encrypted in independent segments of SegmentSize plaintext
+// bytes so a byte range can be decrypted without reading the whole file.
+// Each segment is sealed with a nonce made of its index and a flag marking
+// the final segment, which stops segments being reordered or the file
+// being truncated unnoticed.
+const (
+	SegmentSize     = 64 << 10
+	segmentOverhead = 16
+	encryptedSize   = SegmentSize + segmentOverhead
+)
+
+// NewEncryptReader returns a reader of the encrypted form of plaintext.
+func NewEncryptReader(dataKey []byte, plaintext io.Reader) (io.Reader, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptReader{
+		aead:   aead,
+		source: bufio.NewReader(plaintext),
+		buffer: make([]byte, SegmentSize),
+	}, nil
+}
+
+// NewDecryptReader returns a reader of length plaintext bytes starting at
+// offset of a file of size plaintext bytes. ciphertext must start at the
+// offset returned by CiphertextRange for the same arguments. A negative
+// length reads to the end of the file.
+func NewDecryptReader(dataKey []byte, ciphertext io.Reader, offset int64, length int64, size int64) (io.Reader, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	if length < 0 || offset+length > size {
+		length = size - offset
+	}
+	return &decryptReader{
+		aead:      aead,
+		source:    ciphertext,
+		segment:   uint64(offset / SegmentSize),
+		last:      uint64(segmentCount(size) - 1),
+		size:      size,
+		skip:      offset % SegmentSize,
+		remaining: length,
+		buffer:    make([]byte, encryptedSize),
+	}, nil
+}
+
+// CiphertextRange returns the stored byte range holding the plaintext range
+// of length bytes at offset in a file of size plaintext bytes. A negative
+// length reads to the end of the file.
+func CiphertextRange(offset int64, length int64, size int64) (int64, int64) {
+	end := size
+	if length >= 0 && offset+length < size {
+		end = offset + length
+	}
+
+	first := offset / SegmentSize
+	last := first
+	if end > offset {
+		last = (end - 1) / SegmentSize
+	}
+
+	start := first * encryptedSize
+	stop := (last + 1) * encryptedSize
+	if total := EncryptedSize(size); stop > total {
+		stop = total
+	}
+	return start, stop - start
+}
+
+// EncryptedSize returns the stored size of a file of size plaintext bytes.
+func EncryptedSize(size int64) int64 {
+	return size + segmentCount(size)*segmentOverhead
+}
+
+// PlaintextSize returns the plaintext size of a stored file of size bytes.
+func PlaintextSize(size int64) int64 {
+	segments := (size + encryptedSize - 1) / encryptedSize
+	return size - segments*segmentOverhead
+}
+
+func segmentCount(size int64) int64 {
+	if size == 0 {
+		return 1
+	}
+	return (size + SegmentSize - 1) / SegmentSize
+}
+
+func segmentNonce(aead cipher.AEAD, segment uint64, final bool) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	if final {
+		nonce[0] = 1
+	}
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], segment)
+	return nonce
+}
+
+type encryptReader struct {
+	aead    cipher.AEAD
+	source  *bufio.Reader
+	segment uint64
+	buffer  []byte
+	pending []byte
+	done    bool
+}
+
+func (r *encryptReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.seal(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+func (r *encryptReader) seal() error {
+	n, err := io.ReadFull(r.source, r.buffer)
+	switch {
+	case err == io.EOF || err == io.ErrUnexpectedEOF:
+		r.done = true
+	case err != nil:
+		return err
+	default:
+		// A full segment is the last one only if nothing follows it
+		if _, err := r.source.Peek(1); err == io.EOF {
+			r.done = true
+		} else if err != nil {
+			return err
+		}
+	}
+
+	nonce := segmentNonce(r.aead, r.segment, r.done)
+	r.pending = r.aead.Seal(r.pending[:0], nonce, r.buffer[:n], nil)
+	r.segment++
+	return nil
+}
+
+type decryptReader struct {
+	aead      cipher.AEAD
+	source    io.Reader
+	segment   uint64
+	last      uint64
+	size      int64
+	skip      int64
+	remaining int64
+	buffer    []byte
+	pending   []byte
+}
+
+func (r *decryptReader) Read(p []byte) (int, error) {
+	if r.remaining <= 0 {
+		return 0, io.EOF
+	}
+	for len(r.pending) == 0 {
+		if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	if int64(len(p)) > r.remaining {
+		p = p[:r.remaining]
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	r.remaining -= int64(n)
+	return n, nil
+}
+
+func (r *decryptReader) open() error {
+	if r.segment > r.last {
+		return io.ErrUnexpectedEOF
+	}
+
+	final := r.segment == r.last
+	length := int64(encryptedSize)
+	if final {
+		length = r.size - int64(r.segment)*SegmentSize + segmentOverhead
+	}
+	if _, err := io.ReadFull(r.source, r.buffer[:length]); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+
+	nonce := segmentNonce(r.aead, r.segment, final)
+	plaintext, err := r.aead.Open(r.buffer[:0], nonce, r.buffer[:length], nil)
+	if err != nil {
+		return fmt.Errorf("error decrypting segment %d: %w", r.segment, err)
+	}
+
+	r.pending = plaintext[r.skip:]
+	r.skip = 0
+	r.segment++
+	return nil
+}
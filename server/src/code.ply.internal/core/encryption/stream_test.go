@@ -0,0 +1,173 @@
+package encryption
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+var testDataKey = bytes.Repeat([]byte{7}, 32)
+
+func testPlaintext(size int64) []byte {
+	plaintext := make([]byte, size)
+	for i := range plaintext {
+		plaintext[i] = byte(i % 251)
+	}
+	return plaintext
+}
+
+func encrypt(t *testing.T, plaintext []byte) []byte {
+	t.Helper()
+	reader, err := NewEncryptReader(testDataKey, bytes.NewReader(plaintext))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ciphertext
+}
+
+func decrypt(ciphertext []byte, offset int64, length int64, size int64) ([]byte, error) {
+	reader, err := NewDecryptReader(testDataKey, bytes.NewReader(ciphertext), offset, length, size)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(reader)
+}
+
+func TestSegmentNonce(t *testing.T) {
+	aead, err := newAEAD(testDataKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		segment uint64
+		final   bool
+		want    []byte
+	}{
+		{"first", 0, false, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{"first and final", 0, true, []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{"later", 258, false, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2}},
+		{"later and final", 258, true, []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := segmentNonce(aead, test.segment, test.final); !bytes.Equal(got, test.want) {
+				t.Errorf("segmentNonce(%d, %v) = %v, want %v", test.segment, test.final, got, test.want)
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	sizes := []int64{0, 1, SegmentSize - 1, SegmentSize, SegmentSize + 1, 2 * SegmentSize}
+	for _, size := range sizes {
+		plaintext := testPlaintext(size)
+		ciphertext := encrypt(t, plaintext)
+
+		if got := int64(len(ciphertext)); got != EncryptedSize(size) {
+			t.Errorf("size %d: encrypted to %d bytes, EncryptedSize says %d", size, got, EncryptedSize(size))
+		}
+		if got := PlaintextSize(int64(len(ciphertext))); got != size {
+			t.Errorf("size %d: PlaintextSize(%d) = %d", size, len(ciphertext), got)
+		}
+		got, err := decrypt(ciphertext, 0, -1, size)
+		if err != nil {
+			t.Errorf("size %d: %v", size, err)
+		} else if !bytes.Equal(got, plaintext) {
+			t.Errorf("size %d: decrypted content differs", size)
+		}
+	}
+}
+
+func TestFinalSegment(t *testing.T) {
+	plaintext := testPlaintext(2 * SegmentSize)
+	ciphertext := encrypt(t, plaintext)
+	first := ciphertext[:encryptedSize]
+	second := ciphertext[encryptedSize:]
+
+	tests := []struct {
+		name       string
+		ciphertext []byte
+		size       int64
+	}{
+		// The first segment was not sealed as the final one
+		{"truncated", first, SegmentSize},
+		{"reordered", append(append([]byte{}, second...), first...), 2 * SegmentSize},
+		{"short", ciphertext[:len(ciphertext)-1], 2 * SegmentSize},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := decrypt(test.ciphertext, 0, -1, test.size); err == nil {
+				t.Error("decrypted without an error")
+			}
+		})
+	}
+}
+
+func TestCiphertextRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		offset     int64
+		length     int64
+		size       int64
+		wantStart  int64
+		wantLength int64
+	}{
+		{"empty file", 0, -1, 0, 0, segmentOverhead},
+		{"one byte file", 0, -1, 1, 0, 1 + segmentOverhead},
+		{"whole segment", 0, SegmentSize, SegmentSize, 0, encryptedSize},
+		{"empty range", 5, 0, 100, 0, 100 + segmentOverhead},
+		{"across a boundary", SegmentSize - 1, 2, 2 * SegmentSize, 0, 2 * encryptedSize},
+		{"second segment", SegmentSize, 1, 2 * SegmentSize, encryptedSize, encryptedSize},
+		{"short last segment", SegmentSize, -1, SegmentSize + 1, encryptedSize, 1 + segmentOverhead},
+		{"length past the end", 10, 1000, 100, 0, 100 + segmentOverhead},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			start, length := CiphertextRange(test.offset, test.length, test.size)
+			if start != test.wantStart || length != test.wantLength {
+				t.Fatalf("CiphertextRange(%d, %d, %d) = %d, %d, want %d, %d",
+					test.offset, test.length, test.size, start, length, test.wantStart, test.wantLength)
+			}
+
+			plaintext := testPlaintext(test.size)
+			ciphertext := encrypt(t, plaintext)
+			got, err := decrypt(ciphertext[start:start+length], test.offset, test.length, test.size)
+			if err != nil {
+				t.Fatal(err)
+			}
+			end := test.size
+			if test.length >= 0 && test.offset+test.length < end {
+				end = test.offset + test.length
+			}
+			if want := plaintext[test.offset:end]; !bytes.Equal(got, want) {
+				t.Errorf("decrypted %d bytes, want %d", len(got), len(want))
+			}
+		})
+	}
+}
+
+func TestPlaintextSize(t *testing.T) {
+	tests := []struct {
+		stored int64
+		want   int64
+	}{
+		{0, 0},
+		{segmentOverhead, 0},
+		{segmentOverhead + 1, 1},
+		{encryptedSize, SegmentSize},
+		{encryptedSize + segmentOverhead, SegmentSize},
+		{encryptedSize + segmentOverhead + 1, SegmentSize + 1},
+		{2 * encryptedSize, 2 * SegmentSize},
+	}
+	for _, test := range tests {
+		if got := PlaintextSize(test.stored); got != test.want {
+			t.Errorf("PlaintextSize(%d) = %d, want %d", test.stored, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package extractor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+)
+
+type (
+	// Gateway hands a document's content to a field extractor and returns
+	// the key/value pairs it found.
+	Gateway interface {
+		Extract(ctx context.Context, document Document, content io.Reader) ([]*Field, error)
+	}
+
+	Document struct {
+		DocumentId  string
+		FileName    string
+		ContentType string
+	}
+
+	Field struct {
+		Key        string  `json:"key"`
+		Value      string  `json:"value"`
+		Confidence float64 `json:"confidence"`
+		Page       int     `json:"page"`
+	}
+
+	Params struct {
+		Backend string
+		Http    HttpParams
+	}
+)
+
+// New returns the extractor for p.Backend, or nil when Backend is empty and
+// extraction is disabled.
+func New(ctx context.Context, p Params) (Gateway, error) {
+	switch p.Backend {
+	case "":
+		return nil, nil
+	case "http":
+		return newHttp(ctx, p.Http)
+	case "stub":
+		return &stub{}, nil
+	}
+	return nil, fmt.Errorf("unknown extraction backend %q", p.Backend)
+}
+
+const defaultTimeout = 5 * time.Minute
@@ -0,0 +1,76 @@
+package extractor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// The HTTP extractor protocol: the server POSTs the raw file content to Url
+// with the file's media type as Content-Type and the X-Document-Id and
+// X-File-Name headers set. The extractor answers 200 with
+//
+//	{"fields": [{"key": "...", "value": "...", "confidence": 0.9, "page": 1}]}
+//
+// Any other status fails the job, which is retried later.
+type (
+	httpExtractor struct {
+		Url    string
+		client *http.Client
+	}
+
+	HttpParams struct {
+		Url     string
+		Timeout time.Duration
+	}
+
+	httpResponse struct {
+		Fields []*Field `json:"fields"`
+	}
+)
+
+func newHttp(ctx context.Context, p HttpParams) (Gateway, error) {
+	if p.Url == "" {
+		return nil, errors.New("extraction url is required")
+	}
+
+	timeout := p.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	return &httpExtractor{
+		Url:    p.Url,
+		client: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (e *httpExtractor) Extract(ctx context.Context, document Document, content io.Reader) ([]*Field, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.Url, content)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", document.ContentType)
+	req.Header.Set("X-Document-Id", document.DocumentId)
+	req.Header.Set("X-File-Name", document.FileName)
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("extractor returned %s: %s", resp.Status, message)
+	}
+
+	result := &httpResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return nil, fmt.Errorf("error decoding extractor response: %w", err)
+	}
+	return result.Fields, nil
+}
@@ -0,0 +1,35 @@
+package extractor
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strings"
+)
+
+// stub stands in for a real extractor in development. It reads "Key: Value"
+// lines from plain text files and finds nothing in anything else.
+type stub struct{}
+
+func (s *stub) Extract(ctx context.Context, document Document, content io.Reader) ([]*Field, error) {
+	fields := []*Field{}
+	if !strings.HasPrefix(document.ContentType, "text/plain") {
+		return fields, nil
+	}
+
+	scanner := bufio.NewScanner(content)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), ":")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if !found || key == "" || value == "" {
+			continue
+		}
+		fields = append(fields, &Field{
+			Key:        key,
+			Value:      value,
+			Confidence: 1,
+			Page:       1,
+		})
+	}
+	return fields, scanner.Err()
+}
@@ -0,0 +1,17 @@
+package mailer
+
+import (
+	"context"
+	"log"
+)
+
+// logMailer stands in for a mail server in development. It writes messages,
+// links and tokens included, to the log.
+type logMailer struct {
+	From string
+}
+
+func (m *logMailer) Send(ctx context.Context, message *Message) error {
+	log.Printf("mail from %s to %s: %s\n%s", m.From, message.To, message.Subject, message.Body)
+	return nil
+}
@@ -0,0 +1,43 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type (
+	// Gateway sends email.
+	Gateway interface {
+		Send(ctx context.Context, message *Message) error
+	}
+
+	// Message is a plain text email to one recipient.
+	Message struct {
+		To      string
+		Subject string
+		Body    string
+	}
+
+	Params struct {
+		Backend string
+		From    string
+		Smtp    SmtpParams
+	}
+)
+
+// New returns the mailer for p.Backend, or nil when Backend is empty and no
+// mail is sent.
+func New(ctx context.Context, p Params) (Gateway, error) {
+	switch p.Backend {
+	case "":
+		return nil, nil
+	case "smtp":
+		return newSmtp(ctx, p.From, p.Smtp)
+	case "log":
+		return &logMailer{From: p.From}, nil
+	}
+	return nil, fmt.Errorf("unknown mail backend %q", p.Backend)
+}
+
+const defaultTimeout = 30 * time.Second
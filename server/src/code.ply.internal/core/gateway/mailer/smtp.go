@@ -0,0 +1,137 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// smtpMailer submits mail to an SMTP server, upgrading to TLS when the
+// server offers STARTTLS.
+type (
+	smtpMailer struct {
+		From     string
+		Address  string
+		Username string
+		Password string
+		Timeout  time.Duration
+	}
+
+	SmtpParams struct {
+		Address  string
+		Username string
+		Password string
+		Timeout  time.Duration
+	}
+)
+
+func newSmtp(ctx context.Context, from string, p SmtpParams) (Gateway, error) {
+	if p.Address == "" {
+		return nil, errors.New("smtp address is required")
+	}
+	if _, err := mail.ParseAddress(from); err != nil {
+		return nil, fmt.Errorf("invalid from address: %w", err)
+	}
+
+	timeout := p.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	return &smtpMailer{
+		From:     from,
+		Address:  p.Address,
+		Username: p.Username,
+		Password: p.Password,
+		Timeout:  timeout,
+	}, nil
+}
+
+func (m *smtpMailer) Send(ctx context.Context, message *Message) error {
+	from, err := mail.ParseAddress(m.From)
+	if err != nil {
+		return err
+	}
+	to, err := mail.ParseAddress(message.To)
+	if err != nil {
+		return err
+	}
+
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", m.Address)
+	if err != nil {
+		return err
+	}
+	deadline := time.Now().Add(m.Timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	conn.SetDeadline(deadline)
+
+	host, _, _ := net.SplitHostPort(m.Address)
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	// smtp.PlainAuth refuses to send credentials without TLS, except to
+	// localhost
+	if m.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", m.Username, m.Password, host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(from.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to.Address); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(formatMessage(from, to, message)); err != nil {
+		w.Close()
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// formatMessage renders message with its headers, ending lines with CRLF.
+func formatMessage(from *mail.Address, to *mail.Address, message *Message) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", to.String())
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+
+	body := strings.ReplaceAll(message.Body, "\r\n", "\n")
+	for _, line := range strings.Split(body, "\n") {
+		buf.WriteString(line)
+		buf.WriteString("\r\n")
+	}
+	return buf.Bytes()
+}
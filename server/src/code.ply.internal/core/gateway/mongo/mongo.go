@@ -13,8 +13,14 @@ type (
 	Gateway interface {
 		FindOne(context.Context, interface{}, interface{}) error
 		Find(context.Context, interface{}, interface{}) error
+		Insert(context.Context, interface{}) error
 		Upsert(context.Context, interface{}, interface{}) error
+		Increment(context.Context, interface{}, interface{}) error
+		IncrementExisting(context.Context, interface{}, interface{}) (bool, error)
+		Update(context.Context, interface{}, interface{}) (bool, error)
 		DeleteOne(context.Context, interface{}) error
+		DeleteMany(context.Context, interface{}) error
+		EnsureIndex(context.Context, bool, interface{}, ...string) error
 	}
 	gateway struct {
 		Url        string
@@ -70,6 +76,24 @@ func (g *gateway) Find(ctx context.Context, filter interface{}, result interface
 	return cursor.All(ctx, result)
 }
 
+// Insert adds document to the collection. It fails with a duplicate key error
+// when a unique index already holds its key.
+func (g *gateway) Insert(ctx context.Context, document interface{}) error {
+	// Connect to MongoDB
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(g.Url))
+	if err != nil {
+		log.Fatalf("Failed to connect to MongoDB: %v", err)
+	}
+	defer client.Disconnect(ctx)
+
+	_, err = client.
+		Database(g.Database).
+		Collection(g.Collection).
+		InsertOne(ctx, document)
+
+	return err
+}
+
 func (g *gateway) Upsert(ctx context.Context, filter interface{}, update interface{}) error {
 	// Connect to MongoDB
 	client, err := mongo.Connect(ctx, options.Client().ApplyURI(g.Url))
@@ -90,6 +114,78 @@ func (g *gateway) Upsert(ctx context.Context, filter interface{}, update interfa
 	return err
 }
 
+// Increment adds each value of increments to the matching field of the
+// document matching filter, creating the document when there is none.
+func (g *gateway) Increment(ctx context.Context, filter interface{}, increments interface{}) error {
+	// Connect to MongoDB
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(g.Url))
+	if err != nil {
+		log.Fatalf("Failed to connect to MongoDB: %v", err)
+	}
+	defer client.Disconnect(ctx)
+
+	updateDocument := bson.M{
+		"$inc": increments,
+	}
+
+	_, err = client.
+		Database(g.Database).
+		Collection(g.Collection).
+		UpdateOne(ctx, filter, updateDocument, options.Update().SetUpsert(true))
+
+	return err
+}
+
+// IncrementExisting adds each value of increments to the matching field of
+// the document matching filter, without creating one, and reports whether a
+// document matched. A filter on the fields being incremented makes it a
+// conditional increment.
+func (g *gateway) IncrementExisting(ctx context.Context, filter interface{}, increments interface{}) (bool, error) {
+	// Connect to MongoDB
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(g.Url))
+	if err != nil {
+		log.Fatalf("Failed to connect to MongoDB: %v", err)
+	}
+	defer client.Disconnect(ctx)
+
+	updateDocument := bson.M{
+		"$inc": increments,
+	}
+
+	result, err := client.
+		Database(g.Database).
+		Collection(g.Collection).
+		UpdateOne(ctx, filter, updateDocument)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+// Update sets the fields of update on the document matching filter, without
+// creating one, and reports whether a document matched.
+func (g *gateway) Update(ctx context.Context, filter interface{}, update interface{}) (bool, error) {
+	// Connect to MongoDB
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(g.Url))
+	if err != nil {
+		log.Fatalf("Failed to connect to MongoDB: %v", err)
+	}
+	defer client.Disconnect(ctx)
+
+	updateDocument := bson.M{
+		"$set": update,
+	}
+
+	result, err := client.
+		Database(g.Database).
+		Collection(g.Collection).
+		UpdateOne(ctx, filter, updateDocument)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
 func (g *gateway) DeleteOne(ctx context.Context, filter interface{}) error {
 	// Connect to MongoDB
 	client, err := mongo.Connect(ctx, options.Client().ApplyURI(g.Url))
@@ -105,3 +201,49 @@ func (g *gateway) DeleteOne(ctx context.Context, filter interface{}) error {
 
 	return err
 }
+
+func (g *gateway) DeleteMany(ctx context.Context, filter interface{}) error {
+	// Connect to MongoDB
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(g.Url))
+	if err != nil {
+		log.Fatalf("Failed to connect to MongoDB: %v", err)
+	}
+	defer client.Disconnect(ctx)
+
+	_, err = client.
+		Database(g.Database).
+		Collection(g.Collection).
+		DeleteMany(ctx, filter)
+
+	return err
+}
+
+// EnsureIndex creates an ascending index on keys, in order, unless it exists.
+// unique rejects documents repeating another's values of keys. A non-nil
+// partial is a filter limiting the index to the documents it matches.
+func (g *gateway) EnsureIndex(ctx context.Context, unique bool, partial interface{}, keys ...string) error {
+	// Connect to MongoDB
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(g.Url))
+	if err != nil {
+		log.Fatalf("Failed to connect to MongoDB: %v", err)
+	}
+	defer client.Disconnect(ctx)
+
+	indexKeys := bson.D{}
+	for _, key := range keys {
+		indexKeys = append(indexKeys, bson.E{Key: key, Value: 1})
+	}
+
+	indexOptions := options.Index().SetUnique(unique)
+	if partial != nil {
+		indexOptions.SetPartialFilterExpression(partial)
+	}
+
+	_, err = client.
+		Database(g.Database).
+		Collection(g.Collection).
+		Indexes().
+		CreateOne(ctx, mongo.IndexModel{Keys: indexKeys, Options: indexOptions})
+
+	return err
+}
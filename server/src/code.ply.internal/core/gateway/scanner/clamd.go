@@ -0,0 +1,120 @@
+package scanner
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// clamdChunkSize is the most content sent in one INSTREAM chunk.
+const clamdChunkSize = 64 << 10
+
+// clamd scans over the clamd TCP protocol's INSTREAM command: the content is
+// sent as length-prefixed chunks ending with an empty one, and clamd answers
+// "stream: OK", "stream: <signature> FOUND" or "<message> ERROR". clamd
+// refuses streams longer than its StreamMaxLength setting, 25M by default,
+// which is reported as ErrTooLarge; it must be at least the largest upload
+// allowed for every file to be scanned.
+type (
+	clamd struct {
+		Address string
+		Timeout time.Duration
+	}
+
+	ClamdParams struct {
+		Address string
+		Timeout time.Duration
+	}
+)
+
+func newClamd(ctx context.Context, p ClamdParams) (Gateway, error) {
+	if p.Address == "" {
+		return nil, errors.New("clamd address is required")
+	}
+
+	timeout := p.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	return &clamd{
+		Address: p.Address,
+		Timeout: timeout,
+	}, nil
+}
+
+func (s *clamd) Scan(ctx context.Context, content io.Reader) (*Result, error) {
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", s.Address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(s.Timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	conn.SetDeadline(deadline)
+
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return nil, err
+	}
+
+	chunk := make([]byte, 4+clamdChunkSize)
+	for {
+		n, readErr := io.ReadFull(content, chunk[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(chunk[:4], uint32(n))
+			if _, err := conn.Write(chunk[:4+n]); err != nil {
+				// clamd closes the connection when the stream is over its
+				// size limit; its reply says so
+				return readClamdReply(conn, err)
+			}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			return nil, readErr
+		}
+	}
+
+	if _, err := conn.Write([]byte{0, 0, 0, 0}); err != nil {
+		return readClamdReply(conn, err)
+	}
+	return readClamdReply(conn, nil)
+}
+
+// readClamdReply parses clamd's answer. writeErr is returned when there is no
+// answer to explain why sending failed.
+func readClamdReply(conn net.Conn, writeErr error) (*Result, error) {
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && (err != io.EOF || reply == "") {
+		if writeErr != nil {
+			return nil, writeErr
+		}
+		return nil, fmt.Errorf("error reading clamd reply: %w", err)
+	}
+	reply = strings.TrimSpace(strings.TrimRight(reply, "\x00"))
+	reply = strings.TrimPrefix(reply, "stream: ")
+
+	switch {
+	case reply == "OK":
+		return &Result{}, nil
+	case strings.HasSuffix(reply, " FOUND"):
+		return &Result{
+			Infected:  true,
+			Signature: strings.TrimSuffix(reply, " FOUND"),
+		}, nil
+	}
+	if strings.Contains(reply, "size limit exceeded") {
+		return nil, fmt.Errorf("clamd: %s: %w", reply, ErrTooLarge)
+	}
+	return nil, fmt.Errorf("clamd: %s", reply)
+}
@@ -0,0 +1,47 @@
+package scanner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ErrTooLarge is returned when content is larger than the scanner will scan.
+// Scanning it again fails the same way.
+var ErrTooLarge = errors.New("content is larger than the scanner accepts")
+
+type (
+	// Gateway checks content for malware.
+	Gateway interface {
+		Scan(ctx context.Context, content io.Reader) (*Result, error)
+	}
+
+	// Result of a scan. Signature names what was found in infected content.
+	Result struct {
+		Infected  bool
+		Signature string
+	}
+
+	Params struct {
+		Backend string
+		Clamd   ClamdParams
+	}
+)
+
+// New returns the scanner for p.Backend, or nil when Backend is empty and
+// scanning is disabled.
+func New(ctx context.Context, p Params) (Gateway, error) {
+	switch p.Backend {
+	case "":
+		return nil, nil
+	case "clamd":
+		return newClamd(ctx, p.Clamd)
+	case "stub":
+		return &stub{}, nil
+	}
+	return nil, fmt.Errorf("unknown scanner backend %q", p.Backend)
+}
+
+const defaultTimeout = 5 * time.Minute
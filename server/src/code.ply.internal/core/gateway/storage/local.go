@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type (
+	local struct {
+		Directory string
+	}
+
+	LocalParams struct {
+		Directory string
+	}
+)
+
+func newLocal(ctx context.Context, p LocalParams) (Gateway, error) {
+	if err := os.MkdirAll(p.Directory, 0755); err != nil {
+		return nil, err
+	}
+	return &local{
+		Directory: p.Directory,
+	}, nil
+}
+
+func (l *local) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return 0, err
+	}
+
+	// Write to a temporary file and rename it into place so a failed upload
+	// never leaves a partial object behind
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return 0, fmt.Errorf("error creating file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+		return 0, fmt.Errorf("error saving file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return 0, fmt.Errorf("error saving file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return 0, fmt.Errorf("error saving file: %w", err)
+	}
+	return written, nil
+}
+
+func (l *local) Get(ctx context.Context, key string, offset int64, length int64) (io.ReadCloser, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	if length < 0 {
+		return file, nil
+	}
+	return &readCloser{Reader: io.LimitReader(file, length), Closer: file}, nil
+}
+
+func (l *local) Stat(ctx context.Context, key string) (int64, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return 0, ErrNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+func (l *local) Delete(ctx context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if os.IsNotExist(err) {
+		return ErrNotFound
+	}
+	return err
+}
+
+func (l *local) List(ctx context.Context, prefix string) ([]*Object, error) {
+	objects := []*Object{}
+	err := filepath.WalkDir(l.Directory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		// Skip directories and uploads still being written
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".upload-") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(l.Directory, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		objects = append(objects, &Object{Key: key, Size: info.Size(), Modified: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing files: %w", err)
+	}
+	return objects, nil
+}
+
+// path maps a key onto the filesystem, refusing keys that would escape the
+// storage directory.
+func (l *local) path(key string) (string, error) {
+	path := filepath.Join(l.Directory, filepath.FromSlash(key))
+	rel, err := filepath.Rel(l.Directory, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid storage key %q", key)
+	}
+	return path, nil
+}
+
+// readCloser pairs a wrapped reader with the closer of its underlying source.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,423 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// s3PartSize is how much of an upload is buffered before it is sent as
+	// one part of a multipart upload; S3 requires at least 5MiB per part
+	s3PartSize = 8 << 20
+
+	s3EmptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+type (
+	s3 struct {
+		Endpoint     *url.URL
+		Region       string
+		Bucket       string
+		AccessKey    string
+		SecretKey    string
+		UsePathStyle bool
+		client       *http.Client
+	}
+
+	// S3Params configures any S3-compatible object store. AccessKey and
+	// SecretKey fall back to AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY.
+	S3Params struct {
+		Endpoint     string
+		Region       string
+		Bucket       string
+		AccessKey    string
+		SecretKey    string
+		UsePathStyle bool
+	}
+)
+
+func newS3(ctx context.Context, p S3Params) (Gateway, error) {
+	if p.Bucket == "" {
+		return nil, fmt.Errorf("s3 storage requires a bucket")
+	}
+	if p.Region == "" {
+		p.Region = "us-east-1"
+	}
+	if p.Endpoint == "" {
+		p.Endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", p.Region)
+	}
+	if p.AccessKey == "" {
+		p.AccessKey = os.Getenv("AWS_ACCESS_KEY_ID")
+	}
+	if p.SecretKey == "" {
+		p.SecretKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+	}
+
+	endpoint, err := url.Parse(p.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid s3 endpoint: %w", err)
+	}
+
+	return &s3{
+		Endpoint:     endpoint,
+		Region:       p.Region,
+		Bucket:       p.Bucket,
+		AccessKey:    p.AccessKey,
+		SecretKey:    p.SecretKey,
+		UsePathStyle: p.UsePathStyle,
+		client:       &http.Client{},
+	}, nil
+}
+
+func (s *s3) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	buf := make([]byte, s3PartSize)
+	n, err := io.ReadFull(r, buf)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		// small enough for a single request
+		resp, err := s.do(ctx, http.MethodPut, key, nil, nil, buf[:n])
+		if err != nil {
+			return 0, err
+		}
+		resp.Body.Close()
+		return int64(n), nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	uploadId, err := s.createMultipartUpload(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+
+	var written int64
+	parts := []s3CompletedPart{}
+	for last := false; ; {
+		etag, err := s.uploadPart(ctx, key, uploadId, len(parts)+1, buf[:n])
+		if err != nil {
+			s.abortMultipartUpload(ctx, key, uploadId)
+			return 0, err
+		}
+		parts = append(parts, s3CompletedPart{PartNumber: len(parts) + 1, ETag: etag})
+		written += int64(n)
+
+		if last {
+			break
+		}
+		n, err = io.ReadFull(r, buf)
+		if err == io.EOF {
+			break
+		}
+		last = err == io.ErrUnexpectedEOF
+		if err != nil && !last {
+			s.abortMultipartUpload(ctx, key, uploadId)
+			return 0, err
+		}
+	}
+
+	if err := s.completeMultipartUpload(ctx, key, uploadId, parts); err != nil {
+		s.abortMultipartUpload(ctx, key, uploadId)
+		return 0, err
+	}
+	return written, nil
+}
+
+func (s *s3) Get(ctx context.Context, key string, offset int64, length int64) (io.ReadCloser, error) {
+	// A range can't be empty, so only check that the object exists
+	if length == 0 {
+		if _, err := s.Stat(ctx, key); err != nil {
+			return nil, err
+		}
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	}
+
+	header := http.Header{}
+	if length >= 0 {
+		header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	} else if offset > 0 {
+		header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := s.do(ctx, http.MethodGet, key, nil, header, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (s *s3) Stat(ctx context.Context, key string) (int64, error) {
+	resp, err := s.do(ctx, http.MethodHead, key, nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.ContentLength, nil
+}
+
+// Delete removes the object at key. S3 reports success for keys that don't
+// exist, so the object is looked up first to return ErrNotFound for them as
+// the local backend does.
+func (s *s3) Delete(ctx context.Context, key string) error {
+	if _, err := s.Stat(ctx, key); err != nil {
+		return err
+	}
+
+	resp, err := s.do(ctx, http.MethodDelete, key, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (s *s3) List(ctx context.Context, prefix string) ([]*Object, error) {
+	objects := []*Object{}
+	query := url.Values{"list-type": {"2"}}
+	if prefix != "" {
+		query.Set("prefix", prefix)
+	}
+
+	for {
+		resp, err := s.do(ctx, http.MethodGet, "", query, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		result := struct {
+			Contents []struct {
+				Key          string    `xml:"Key"`
+				Size         int64     `xml:"Size"`
+				LastModified time.Time `xml:"LastModified"`
+			} `xml:"Contents"`
+			IsTruncated           bool   `xml:"IsTruncated"`
+			NextContinuationToken string `xml:"NextContinuationToken"`
+		}{}
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error listing s3 objects: %w", err)
+		}
+
+		for _, content := range result.Contents {
+			objects = append(objects, &Object{Key: content.Key, Size: content.Size, Modified: content.LastModified})
+		}
+		if !result.IsTruncated {
+			return objects, nil
+		}
+		query.Set("continuation-token", result.NextContinuationToken)
+	}
+}
+
+type s3CompletedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+func (s *s3) createMultipartUpload(ctx context.Context, key string) (string, error) {
+	resp, err := s.do(ctx, http.MethodPost, key, url.Values{"uploads": {""}}, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	result := struct {
+		UploadId string `xml:"UploadId"`
+	}{}
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("error starting s3 upload: %w", err)
+	}
+	return result.UploadId, nil
+}
+
+func (s *s3) uploadPart(ctx context.Context, key string, uploadId string, partNumber int, data []byte) (string, error) {
+	query := url.Values{
+		"partNumber": {strconv.Itoa(partNumber)},
+		"uploadId":   {uploadId},
+	}
+	resp, err := s.do(ctx, http.MethodPut, key, query, nil, data)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	return resp.Header.Get("ETag"), nil
+}
+
+func (s *s3) completeMultipartUpload(ctx context.Context, key string, uploadId string, parts []s3CompletedPart) error {
+	body, err := xml.Marshal(struct {
+		XMLName xml.Name          `xml:"CompleteMultipartUpload"`
+		Parts   []s3CompletedPart `xml:"Part"`
+	}{
+		Parts: parts,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(ctx, http.MethodPost, key, url.Values{"uploadId": {uploadId}}, nil, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// completion can fail after the 200 status has been sent
+	result, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if bytes.Contains(result, []byte("<Error>")) {
+		return fmt.Errorf("error completing s3 upload: %s", result)
+	}
+	return nil
+}
+
+func (s *s3) abortMultipartUpload(ctx context.Context, key string, uploadId string) {
+	resp, err := s.do(ctx, http.MethodDelete, key, url.Values{"uploadId": {uploadId}}, nil, nil)
+	if err == nil {
+		resp.Body.Close()
+	}
+}
+
+// do sends a signed request for key, or for the bucket itself when key is
+// empty, and turns error statuses into errors.
+func (s *s3) do(ctx context.Context, method string, key string, query url.Values, header http.Header, body []byte) (*http.Response, error) {
+	escapedPath := "/" + s3Escape(key, false)
+	host := s.Endpoint.Host
+	if s.UsePathStyle {
+		escapedPath = "/" + s3Escape(s.Bucket, true)
+		if key != "" {
+			escapedPath += "/" + s3Escape(key, false)
+		}
+	} else {
+		host = s.Bucket + "." + host
+	}
+	escapedPath = strings.TrimSuffix(s.Endpoint.EscapedPath(), "/") + escapedPath
+
+	target := s.Endpoint.Scheme + "://" + host + escapedPath
+	if len(query) > 0 {
+		target += "?" + s3CanonicalQuery(query)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.ContentLength = int64(len(body))
+
+	payloadHash := s3EmptyPayloadHash
+	if len(body) > 0 {
+		sum := sha256.Sum256(body)
+		payloadHash = hex.EncodeToString(sum[:])
+	}
+	s.sign(req, escapedPath, payloadHash, time.Now().UTC())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("s3 %s %s: %s: %s", method, key, resp.Status, message)
+	}
+	return resp, nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to req.
+func (s *s3) sign(req *http.Request, escapedPath string, payloadHash string, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	day := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		escapedPath,
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := day + "/" + s.Region + "/s3/aws4_request"
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalHash[:])
+
+	key := s3HMAC([]byte("AWS4"+s.SecretKey), day)
+	key = s3HMAC(key, s.Region)
+	key = s3HMAC(key, "s3")
+	key = s3HMAC(key, "aws4_request")
+	signature := hex.EncodeToString(s3HMAC(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.AccessKey, scope, signedHeaders, signature,
+	))
+}
+
+func s3HMAC(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// s3CanonicalQuery encodes query sorted by key, as SigV4 requires.
+func s3CanonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := []string{}
+	for _, key := range keys {
+		for _, value := range query[key] {
+			pairs = append(pairs, s3Escape(key, true)+"="+s3Escape(value, true))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// s3Escape percent-encodes everything but RFC 3986 unreserved characters,
+// leaving slashes alone unless encodeSlash is set.
+func s3Escape(value string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
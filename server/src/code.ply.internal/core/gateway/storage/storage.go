@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+var ErrNotFound = errors.New("object not found")
+
+type (
+	// Gateway stores document blobs under slash-separated keys.
+	Gateway interface {
+		// Put streams r into the object at key and returns the bytes written
+		Put(context.Context, string, io.Reader) (int64, error)
+		// Get streams length bytes of the object from offset; a negative
+		// length reads to the end
+		Get(context.Context, string, int64, int64) (io.ReadCloser, error)
+		Stat(context.Context, string) (int64, error)
+		Delete(context.Context, string) error
+		// List returns every object whose key starts with prefix
+		List(context.Context, string) ([]*Object, error)
+	}
+
+	Object struct {
+		Key      string
+		Size     int64
+		Modified time.Time
+	}
+
+	Params struct {
+		Backend string
+		Local   LocalParams
+		S3      S3Params
+	}
+)
+
+func New(ctx context.Context, p Params) (Gateway, error) {
+	switch p.Backend {
+	case "", "local":
+		return newLocal(ctx, p.Local)
+	case "s3":
+		return newS3(ctx, p.S3)
+	}
+	return nil, fmt.Errorf("unknown storage backend %q", p.Backend)
+}
@@ -0,0 +1,277 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"code.ply.internal/core/controller"
+	"code.ply.internal/core/models"
+	serverapi "code.ply.internal/gen"
+)
+
+// operationAccess is the permission each operation needs and the kind of
+// resource it acts on. Operations with no permission are left to others to
+// check: the shared link by its signature, the practice and organization
+// lists by the controller, which filters them to the caller's grants, and
+// the account operations, which act only on the caller's own account or one
+// proven by a password or emailed token.
+type operationAccess struct {
+	permission string
+	kind       string
+}
+
+var operationsAccess = map[string]operationAccess{
+	// Admin
+	"PostV1PlyAdminDocumentVerify":     {models.PermissionAdmin, ""},
+	"PostV1PlyAdminStorageReconcile":   {models.PermissionAdmin, ""},
+	"GetV1PlyAdminGrant":               {models.PermissionAdmin, ""},
+	"PostV1PlyAdminGrant":              {models.PermissionAdmin, ""},
+	"DeleteV1PlyAdminGrantGrantId":     {models.PermissionAdmin, ""},
+	"GetV1PlyAdminUser":                {models.PermissionAdmin, ""},
+	"PostV1PlyAdminUserUserIdDisable":  {models.PermissionAdmin, ""},
+	"PostV1PlyAdminUserUserIdMfaReset": {models.PermissionAdmin, ""},
+	"GetV1PlyAdminMfaPolicy":           {models.PermissionAdmin, ""},
+	"PostV1PlyAdminMfaPolicy":          {models.PermissionAdmin, ""},
+	"GetV1PlyReportRevalidation":       {models.PermissionRead, models.ResourcePractice},
+
+	// User
+	"PostV1PlyLogin":                {"", ""},
+	"PostV1PlyLoginMfa":             {"", ""},
+	"GetV1PlyMe":                    {"", ""},
+	"PostV1PlyMePassword":           {"", ""},
+	"PostV1PlyMeMfa":                {"", ""},
+	"PostV1PlyMeMfaConfirm":         {"", ""},
+	"PostV1PlyMeMfaDisable":         {"", ""},
+	"PostV1PlyMeMfaRecovery":        {"", ""},
+	"PostV1PlyUserInvitation":       {models.PermissionManage, models.ResourcePractice},
+	"PostV1PlyUserInvitationAccept": {"", ""},
+	"PostV1PlyPasswordReset":        {"", ""},
+	"PostV1PlyPasswordResetConfirm": {"", ""},
+
+	// Organization
+	"PostV1PlyOrganization":                        {models.PermissionAdmin, ""},
+	"GetV1PlyOrganizationList":                     {"", ""},
+	"GetV1PlyOrganizationOrganizationId":           {models.PermissionRead, models.ResourceOrganization},
+	"PostV1PlyOrganizationOrganizationId":          {models.PermissionManage, models.ResourceOrganization},
+	"GetV1PlyOrganizationOrganizationIdEnrollment": {models.PermissionRead, models.ResourceOrganization},
+	"GetV1PlyOrganizationOrganizationIdPractice":   {models.PermissionRead, models.ResourceOrganization},
+	"GetV1PlyOrganizationOrganizationIdProvider":   {models.PermissionRead, models.ResourceOrganization},
+	"GetV1PlyOrganizationOrganizationIdTask":       {models.PermissionRead, models.ResourceOrganization},
+
+	// Practice
+	"PostV1PlyPractice":                              {models.PermissionManage, models.ResourceOrganization},
+	"GetV1PlyPracticeList":                           {"", ""},
+	"GetV1PlyPracticePracticeId":                     {models.PermissionRead, models.ResourcePractice},
+	"PostV1PlyPracticePracticeId":                    {models.PermissionManage, models.ResourcePractice},
+	"GetV1PlyPracticePracticeIdDocument":             {models.PermissionRead, models.ResourcePractice},
+	"GetV1PlyPracticePracticeIdDocumentArchive":      {models.PermissionRead, models.ResourcePractice},
+	"GetV1PlyPracticePracticeIdDocumentSearch":       {models.PermissionRead, models.ResourcePractice},
+	"GetV1PlyPracticePracticeIdEnrollment":           {models.PermissionRead, models.ResourcePractice},
+	"GetV1PlyPracticePracticeIdEnrollmentDuplicates": {models.PermissionRead, models.ResourcePractice},
+	"GetV1PlyPracticePracticeIdLocation":             {models.PermissionRead, models.ResourcePractice},
+	"GetV1PlyPracticePracticeIdProvider":             {models.PermissionRead, models.ResourcePractice},
+	"GetV1PlyPracticePracticeIdTask":                 {models.PermissionRead, models.ResourcePractice},
+	"GetV1PlyPracticePracticeIdUsage":                {models.PermissionRead, models.ResourcePractice},
+	"PostV1PlyPracticePracticeIdUpload":              {models.PermissionWrite, models.ResourcePractice},
+	"PostV1PlyPracticePracticeIdUploadResumable":     {models.PermissionWrite, models.ResourcePractice},
+
+	// Document
+	"DeleteV1PlyDocumentDocumentId":                    {models.PermissionWrite, models.ResourceDocument},
+	"GetV1PlyDocumentDocumentId":                       {models.PermissionRead, models.ResourceDocument},
+	"GetV1PlyDocumentDocumentIdExtraction":             {models.PermissionRead, models.ResourceDocument},
+	"PostV1PlyDocumentDocumentIdExtraction":            {models.PermissionWrite, models.ResourceDocument},
+	"PostV1PlyDocumentDocumentIdExtractionApply":       {models.PermissionWrite, models.ResourceDocument},
+	"GetV1PlyDocumentDocumentIdLink":                   {models.PermissionRead, models.ResourceDocument},
+	"PostV1PlyDocumentDocumentIdLink":                  {models.PermissionWrite, models.ResourceDocument},
+	"DeleteV1PlyDocumentDocumentIdLinkLinkId":          {models.PermissionWrite, models.ResourceDocument},
+	"GetV1PlyDocumentDocumentIdMetadata":               {models.PermissionRead, models.ResourceDocument},
+	"PostV1PlyDocumentDocumentIdMetadata":              {models.PermissionWrite, models.ResourceDocument},
+	"GetV1PlyDocumentDocumentIdPreview":                {models.PermissionRead, models.ResourceDocument},
+	"GetV1PlyDocumentDocumentIdVersion":                {models.PermissionRead, models.ResourceDocument},
+	"PostV1PlyDocumentDocumentIdVersion":               {models.PermissionWrite, models.ResourceDocument},
+	"GetV1PlyDocumentDocumentIdVersionVersion":         {models.PermissionRead, models.ResourceDocument},
+	"PostV1PlyDocumentDocumentIdVersionVersionCurrent": {models.PermissionWrite, models.ResourceDocument},
+	"GetV1PlySharedLinkId":                             {"", ""},
+
+	// Enrollment
+	"PostV1PlyEnrollment":                      {models.PermissionWrite, models.ResourcePractice},
+	"DeleteV1PlyEnrollmentEnrollmentId":        {models.PermissionWrite, models.ResourceEnrollment},
+	"GetV1PlyEnrollmentEnrollmentId":           {models.PermissionRead, models.ResourceEnrollment},
+	"PostV1PlyEnrollmentEnrollmentId":          {models.PermissionWrite, models.ResourceEnrollment},
+	"GetV1PlyEnrollmentEnrollmentIdActivity":   {models.PermissionRead, models.ResourceEnrollment},
+	"GetV1PlyEnrollmentEnrollmentIdDependents": {models.PermissionRead, models.ResourceEnrollment},
+
+	// Location
+	"PostV1PlyLocation":             {models.PermissionWrite, models.ResourcePractice},
+	"DeleteV1PlyLocationLocationId": {models.PermissionWrite, models.ResourceLocation},
+	"GetV1PlyLocationLocationId":    {models.PermissionRead, models.ResourceLocation},
+	"PostV1PlyLocationLocationId":   {models.PermissionWrite, models.ResourceLocation},
+
+	// Provider
+	"PostV1PlyProvider":                      {models.PermissionWrite, models.ResourcePractice},
+	"DeleteV1PlyProviderProviderId":          {models.PermissionManage, models.ResourceProvider},
+	"GetV1PlyProviderProviderId":             {models.PermissionRead, models.ResourceProvider},
+	"PostV1PlyProviderProviderId":            {models.PermissionWrite, models.ResourceProvider},
+	"GetV1PlyProviderProviderIdAffiliation":  {models.PermissionRead, models.ResourceProvider},
+	"PostV1PlyProviderProviderIdAffiliation": {models.PermissionWrite, models.ResourceProvider},
+
+	// Affiliation
+	"DeleteV1PlyAffiliationAffiliationId": {models.PermissionWrite, models.ResourceAffiliation},
+	"GetV1PlyAffiliationAffiliationId":    {models.PermissionRead, models.ResourceAffiliation},
+	"PostV1PlyAffiliationAffiliationId":   {models.PermissionWrite, models.ResourceAffiliation},
+
+	// Task
+	"PostV1PlyTaskTaskId": {models.PermissionWrite, models.ResourceTask},
+
+	// Resumable upload
+	"DeleteV1PlyUploadUploadId":       {models.PermissionWrite, models.ResourceUpload},
+	"GetV1PlyUploadUploadId":          {models.PermissionRead, models.ResourceUpload},
+	"PatchV1PlyUploadUploadId":        {models.PermissionWrite, models.ResourceUpload},
+	"PostV1PlyUploadUploadIdFinalize": {models.PermissionWrite, models.ResourceUpload},
+}
+
+// resourceIdFields are the request fields naming each kind of resource
+var resourceIdFields = map[string]string{
+	models.ResourcePractice:     "PracticeId",
+	models.ResourceOrganization: "OrganizationId",
+	models.ResourceDocument:     "DocumentId",
+	models.ResourceEnrollment:   "EnrollmentId",
+	models.ResourceLocation:     "LocationId",
+	models.ResourceProvider:     "ProviderId",
+	models.ResourceAffiliation:  "AffiliationId",
+	models.ResourceTask:         "TaskId",
+	models.ResourceUpload:       "UploadId",
+}
+
+// checkOperationsAccess returns an error naming any operation of the server
+// interface missing from operationsAccess, which authorizeOperations refuses.
+func checkOperationsAccess() error {
+	ssi := reflect.TypeOf((*serverapi.StrictServerInterface)(nil)).Elem()
+	var missing []error
+	for i := 0; i < ssi.NumMethod(); i++ {
+		name := ssi.Method(i).Name
+		if _, ok := operationsAccess[name]; !ok {
+			missing = append(missing, fmt.Errorf("no access rule for operation %s", name))
+		}
+	}
+	return errors.Join(missing...)
+}
+
+// mfaExempt are the operations users the MFA policy covers may call before
+// logging in with a second factor, so they can enroll.
+var mfaExempt = map[string]bool{
+	"GetV1PlyMe":             true,
+	"PostV1PlyMePassword":    true,
+	"PostV1PlyMeMfa":         true,
+	"PostV1PlyMeMfaConfirm":  true,
+	"PostV1PlyMeMfaDisable":  true,
+	"PostV1PlyMeMfaRecovery": true,
+}
+
+// authorizeOperations refuses operations the caller lacks the permission for
+// over the resources the request names: its own kind of resource from the
+// path, query or body, along with any practice or organization the body
+// moves it to, so records cannot be taken out of reach of the caller's
+// grants. Users the MFA policy covers who did not log in with a second
+// factor are refused everything but enrolling.
+func authorizeOperations(authorizer controller.Controller) serverapi.StrictMiddlewareFunc {
+	return func(f serverapi.StrictHandlerFunc, operationID string) serverapi.StrictHandlerFunc {
+		rule, ok := operationsAccess[operationID]
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+			if !ok {
+				return nil, &controller.AccessDeniedError{Permission: models.PermissionAdmin}
+			}
+			if !mfaExempt[operationID] {
+				if err := authorizer.CheckMfa(ctx); err != nil {
+					return nil, err
+				}
+			}
+			if rule.permission != "" {
+				resources := requestResources(request, rule.kind)
+				if err := authorizer.Authorize(ctx, rule.permission, resources); err != nil {
+					return nil, err
+				}
+			}
+			return f(ctx, w, r, request)
+		}
+	}
+}
+
+// requestResources collects the resources of kind, and any practice or
+// organization, named by a request's path parameters, query parameters and
+// JSON body.
+func requestResources(request interface{}, kind string) []*models.Resource {
+	if kind == "" {
+		return nil
+	}
+	kinds := []string{kind, models.ResourcePractice, models.ResourceOrganization}
+
+	resources := []*models.Resource{}
+	seen := map[models.Resource]bool{}
+	collect := func(v reflect.Value) {
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return
+		}
+		for _, k := range kinds {
+			id := stringField(v, resourceIdFields[k])
+			resource := models.Resource{Kind: k, Id: id}
+			if id == "" || seen[resource] {
+				continue
+			}
+			seen[resource] = true
+			resources = append(resources, &resource)
+		}
+	}
+
+	v := reflect.ValueOf(request)
+	collect(v)
+	if v.Kind() == reflect.Struct {
+		for _, name := range []string{"Params", "Body"} {
+			if field := v.FieldByName(name); field.IsValid() {
+				collect(field)
+			}
+		}
+	}
+	return resources
+}
+
+// stringField returns the value of a string or *string field of v, or ""
+// when it has none or it is not set.
+func stringField(v reflect.Value, name string) string {
+	field := v.FieldByName(name)
+	if field.Kind() == reflect.Pointer {
+		if field.IsNil() {
+			return ""
+		}
+		field = field.Elem()
+	}
+	if field.Kind() != reflect.String {
+		return ""
+	}
+	return field.String()
+}
+
+// writeOperationError writes the errors of the generated handlers and their
+// middleware, refusing operations the caller is not allowed.
+func writeOperationError(w http.ResponseWriter, r *http.Request, err error) {
+	var denied *controller.AccessDeniedError
+	if errors.As(err, &denied) {
+		writeError(w, http.StatusForbidden, denied.Error())
+		return
+	}
+	var mfaRequired *controller.MfaRequiredError
+	if errors.As(err, &mfaRequired) {
+		writeError(w, http.StatusForbidden, mfaRequired.Error())
+		return
+	}
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
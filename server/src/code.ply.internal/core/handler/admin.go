@@ -0,0 +1,270 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"code.ply.internal/core/controller"
+	"code.ply.internal/core/models"
+	"code.ply.internal/core/utils"
+	serverapi "code.ply.internal/gen"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+func (h *handler) PostV1PlyAdminDocumentVerify(ctx context.Context, request serverapi.PostV1PlyAdminDocumentVerifyRequestObject) (serverapi.PostV1PlyAdminDocumentVerifyResponseObject, error) {
+	practiceId := ""
+	if request.Params.PracticeId != nil {
+		practiceId = *request.Params.PracticeId
+	}
+
+	report, err := h.mainController.VerifyDocuments(ctx, practiceId)
+	if err != nil {
+		return serverapi.PostV1PlyAdminDocumentVerify500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpReport, err := utils.ConvertRequestBody[serverapi.PostV1PlyAdminDocumentVerify200JSONResponse](report)
+	if err != nil {
+		return serverapi.PostV1PlyAdminDocumentVerify500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpReport, nil
+}
+
+func (h *handler) PostV1PlyAdminStorageReconcile(ctx context.Context, request serverapi.PostV1PlyAdminStorageReconcileRequestObject) (serverapi.PostV1PlyAdminStorageReconcileResponseObject, error) {
+	options := &models.ReconciliationOptions{
+		Repair: request.Params.Repair != nil && *request.Params.Repair,
+		Delete: request.Params.Delete != nil && *request.Params.Delete,
+	}
+
+	report, err := h.mainController.ReconcileStorage(ctx, options)
+	if err != nil {
+		return serverapi.PostV1PlyAdminStorageReconcile500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpReport, err := utils.ConvertRequestBody[serverapi.PostV1PlyAdminStorageReconcile200JSONResponse](report)
+	if err != nil {
+		return serverapi.PostV1PlyAdminStorageReconcile500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpReport, nil
+}
+
+func (h *handler) GetV1PlyAdminGrant(ctx context.Context, request serverapi.GetV1PlyAdminGrantRequestObject) (serverapi.GetV1PlyAdminGrantResponseObject, error) {
+	subjectKind := ""
+	if request.Params.SubjectKind != nil {
+		subjectKind = *request.Params.SubjectKind
+	}
+	subject := ""
+	if request.Params.Subject != nil {
+		subject = *request.Params.Subject
+	}
+
+	grants, err := h.mainController.ListRoleGrants(ctx, subjectKind, subject)
+	if err != nil {
+		return serverapi.GetV1PlyAdminGrant500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	parsedGrants := struct {
+		Grants []*models.RoleGrant `json:"grants"`
+	}{
+		Grants: grants,
+	}
+
+	httpGrants, err := utils.ConvertRequestBody[serverapi.GetV1PlyAdminGrant200JSONResponse](parsedGrants)
+	if err != nil {
+		return serverapi.GetV1PlyAdminGrant500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpGrants, nil
+}
+
+func (h *handler) PostV1PlyAdminGrant(ctx context.Context, request serverapi.PostV1PlyAdminGrantRequestObject) (serverapi.PostV1PlyAdminGrantResponseObject, error) {
+	grant, err := utils.ConvertRequestBody[models.RoleGrant](request.Body)
+	if err != nil {
+		return serverapi.PostV1PlyAdminGrant500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	grant, err = h.mainController.CreateRoleGrant(ctx, grant)
+	var validationErr *controller.ValidationError
+	if errors.As(err, &validationErr) {
+		return serverapi.PostV1PlyAdminGrant400JSONResponse{
+			Code:    int32(400),
+			Message: validationErr.Error(),
+		}, nil
+	}
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return serverapi.PostV1PlyAdminGrant400JSONResponse{
+			Code:    int32(400),
+			Message: "practice or organization not found",
+		}, nil
+	}
+	if err != nil {
+		return serverapi.PostV1PlyAdminGrant500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpGrant, err := utils.ConvertRequestBody[serverapi.PostV1PlyAdminGrant200JSONResponse](grant)
+	if err != nil {
+		return serverapi.PostV1PlyAdminGrant500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpGrant, nil
+}
+
+func (h *handler) DeleteV1PlyAdminGrantGrantId(ctx context.Context, request serverapi.DeleteV1PlyAdminGrantGrantIdRequestObject) (serverapi.DeleteV1PlyAdminGrantGrantIdResponseObject, error) {
+	err := h.mainController.DeleteRoleGrant(ctx, request.GrantId)
+	if err != nil {
+		return serverapi.DeleteV1PlyAdminGrantGrantId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+	return serverapi.DeleteV1PlyAdminGrantGrantId200JSONResponse{
+		Status: utils.StringPtr("Completed"),
+	}, nil
+}
+
+func (h *handler) GetV1PlyAdminUser(ctx context.Context, request serverapi.GetV1PlyAdminUserRequestObject) (serverapi.GetV1PlyAdminUserResponseObject, error) {
+	users, err := h.mainController.ListUsers(ctx)
+	if err != nil {
+		return serverapi.GetV1PlyAdminUser500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	parsedUsers := struct {
+		Users []*models.User `json:"users"`
+	}{
+		Users: users,
+	}
+
+	httpUsers, err := utils.ConvertRequestBody[serverapi.GetV1PlyAdminUser200JSONResponse](parsedUsers)
+	if err != nil {
+		return serverapi.GetV1PlyAdminUser500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpUsers, nil
+}
+
+func (h *handler) PostV1PlyAdminUserUserIdDisable(ctx context.Context, request serverapi.PostV1PlyAdminUserUserIdDisableRequestObject) (serverapi.PostV1PlyAdminUserUserIdDisableResponseObject, error) {
+	err := h.mainController.DisableUser(ctx, request.UserId)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return serverapi.PostV1PlyAdminUserUserIdDisable404JSONResponse{
+			Code:    int32(404),
+			Message: "user not found",
+		}, nil
+	}
+	if err != nil {
+		return serverapi.PostV1PlyAdminUserUserIdDisable500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+	return serverapi.PostV1PlyAdminUserUserIdDisable200JSONResponse{
+		Status: utils.StringPtr("Completed"),
+	}, nil
+}
+
+func (h *handler) PostV1PlyAdminUserUserIdMfaReset(ctx context.Context, request serverapi.PostV1PlyAdminUserUserIdMfaResetRequestObject) (serverapi.PostV1PlyAdminUserUserIdMfaResetResponseObject, error) {
+	err := h.mainController.ResetUserMfa(ctx, request.UserId)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return serverapi.PostV1PlyAdminUserUserIdMfaReset404JSONResponse{
+			Code:    int32(404),
+			Message: "user not found",
+		}, nil
+	}
+	if err != nil {
+		return serverapi.PostV1PlyAdminUserUserIdMfaReset500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+	return serverapi.PostV1PlyAdminUserUserIdMfaReset200JSONResponse{
+		Status: utils.StringPtr("Completed"),
+	}, nil
+}
+
+func (h *handler) GetV1PlyAdminMfaPolicy(ctx context.Context, request serverapi.GetV1PlyAdminMfaPolicyRequestObject) (serverapi.GetV1PlyAdminMfaPolicyResponseObject, error) {
+	policy, err := h.mainController.ReadMfaPolicy(ctx)
+	if err != nil {
+		return serverapi.GetV1PlyAdminMfaPolicy500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpPolicy, err := utils.ConvertRequestBody[serverapi.GetV1PlyAdminMfaPolicy200JSONResponse](policy)
+	if err != nil {
+		return serverapi.GetV1PlyAdminMfaPolicy500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpPolicy, nil
+}
+
+func (h *handler) PostV1PlyAdminMfaPolicy(ctx context.Context, request serverapi.PostV1PlyAdminMfaPolicyRequestObject) (serverapi.PostV1PlyAdminMfaPolicyResponseObject, error) {
+	policy, err := utils.ConvertRequestBody[models.MfaPolicy](request.Body)
+	if err != nil {
+		return serverapi.PostV1PlyAdminMfaPolicy500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	policy, err = h.mainController.UpdateMfaPolicy(ctx, policy)
+	var validationErr *controller.ValidationError
+	if errors.As(err, &validationErr) {
+		return serverapi.PostV1PlyAdminMfaPolicy400JSONResponse{
+			Code:    int32(400),
+			Message: validationErr.Error(),
+		}, nil
+	}
+	if err != nil {
+		return serverapi.PostV1PlyAdminMfaPolicy500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpPolicy, err := utils.ConvertRequestBody[serverapi.PostV1PlyAdminMfaPolicy200JSONResponse](policy)
+	if err != nil {
+		return serverapi.PostV1PlyAdminMfaPolicy500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpPolicy, nil
+}
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"code.ply.internal/core/controller"
+	"code.ply.internal/core/models"
+	"code.ply.internal/core/utils"
+	serverapi "code.ply.internal/gen"
+)
+
+func (h *handler) GetV1PlyDocumentDocumentIdExtraction(ctx context.Context, request serverapi.GetV1PlyDocumentDocumentIdExtractionRequestObject) (serverapi.GetV1PlyDocumentDocumentIdExtractionResponseObject, error) {
+	doc, err := h.mainController.GetDocument(ctx, request.DocumentId)
+	if err != nil {
+		return &serverapi.GetV1PlyDocumentDocumentIdExtraction500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	parsedExtraction := struct {
+		DocumentId string                   `json:"documentId,omitempty"`
+		Status     string                   `json:"status,omitempty"`
+		Fields     []*models.ExtractedField `json:"fields,omitempty"`
+	}{
+		DocumentId: doc.DocumentId,
+		Status:     doc.ExtractionStatus,
+		Fields:     doc.ExtractedFields,
+	}
+
+	httpExtraction, err := utils.ConvertRequestBody[serverapi.GetV1PlyDocumentDocumentIdExtraction200JSONResponse](parsedExtraction)
+	if err != nil {
+		return &serverapi.GetV1PlyDocumentDocumentIdExtraction500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpExtraction, nil
+}
+
+func (h *handler) PostV1PlyDocumentDocumentIdExtraction(ctx context.Context, request serverapi.PostV1PlyDocumentDocumentIdExtractionRequestObject) (serverapi.PostV1PlyDocumentDocumentIdExtractionResponseObject, error) {
+	err := h.mainController.QueueDocumentExtraction(ctx, request.DocumentId)
+	if err != nil {
+		return &serverapi.PostV1PlyDocumentDocumentIdExtraction500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+	return &serverapi.PostV1PlyDocumentDocumentIdExtraction200JSONResponse{
+		Status: utils.StringPtr("Completed"),
+	}, nil
+}
+
+func (h *handler) PostV1PlyDocumentDocumentIdExtractionApply(ctx context.Context, request serverapi.PostV1PlyDocumentDocumentIdExtractionApplyRequestObject) (serverapi.PostV1PlyDocumentDocumentIdExtractionApplyResponseObject, error) {
+	applyRequest, err := utils.ConvertRequestBody[struct {
+		Fields []*models.ExtractedField `json:"fields"`
+	}](request.Body)
+	if err != nil {
+		return &serverapi.PostV1PlyDocumentDocumentIdExtractionApply500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	err = h.mainController.ApplyExtractedFields(ctx, request.DocumentId, applyRequest.Fields)
+	if err != nil {
+		var validationErr *controller.ValidationError
+		if errors.As(err, &validationErr) {
+			return &serverapi.PostV1PlyDocumentDocumentIdExtractionApply400JSONResponse{
+				Code:    int32(400),
+				Message: validationErr.Error(),
+			}, nil
+		}
+		var denied *controller.AccessDeniedError
+		if errors.As(err, &denied) {
+			return &serverapi.PostV1PlyDocumentDocumentIdExtractionApply403JSONResponse{
+				Code:    int32(403),
+				Message: denied.Error(),
+			}, nil
+		}
+		var conflict *controller.ConflictError
+		if errors.As(err, &conflict) {
+			return &serverapi.PostV1PlyDocumentDocumentIdExtractionApply409JSONResponse{
+				Code:       int32(409),
+				Message:    conflict.Message,
+				ExistingId: utils.StringPtr(conflict.ExistingId),
+			}, nil
+		}
+		return &serverapi.PostV1PlyDocumentDocumentIdExtractionApply500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &serverapi.PostV1PlyDocumentDocumentIdExtractionApply200JSONResponse{
+		Status: utils.StringPtr("Completed"),
+	}, nil
+}
@@ -1,21 +1,23 @@
 package handler
 
 import (
+	"bufio"
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
 	"os"
-	"path/filepath"
-	"strings"
 
+	"code.ply.internal/core/auth"
 	cfg "code.ply.internal/core/config"
 	"code.ply.internal/core/controller"
 	"code.ply.internal/core/models"
 	"code.ply.internal/core/utils"
 	serverapi "code.ply.internal/gen"
 	"github.com/go-chi/chi/v5"
-	middleware "github.com/oapi-codegen/nethttp-middleware"
 	"github.com/rs/cors"
 )
 
@@ -26,22 +28,37 @@ type (
 	}
 
 	Params struct {
+		// Controller is shared with the background jobs; one is created
+		// when it is not set
+		Controller controller.Controller
 	}
 )
 
 func New(ctx context.Context, p Params) (serverapi.StrictServerInterface, error) {
 	_ = cfg.GetConfigFromContext(ctx)
 
-	mainController, _ := controller.New(ctx, controller.Params{})
+	mainController := p.Controller
+	if mainController == nil {
+		var err error
+		mainController, err = controller.New(ctx, controller.Params{})
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return &handler{
 		mainController: mainController,
 	}, nil
 }
 
-func StartGatewayService(ctx context.Context, gateway serverapi.StrictServerInterface) {
+func StartGatewayService(ctx context.Context, gateway serverapi.StrictServerInterface, authorizer controller.Controller) {
 	config := cfg.GetConfigFromContext(ctx)
 
+	if err := checkOperationsAccess(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error checking operation access rules\n: %s", err)
+		os.Exit(1)
+	}
+
 	swagger, err := serverapi.GetSwagger()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error loading swagger spec\n: %s", err)
@@ -54,15 +71,36 @@ func StartGatewayService(ctx context.Context, gateway serverapi.StrictServerInte
 
 	corsHandler := cors.New(cors.Options{
 		AllowedOrigins: []string{"*"},
-		AllowedMethods: []string{"GET", "POST"},
+		AllowedMethods: []string{"GET", "POST", "PATCH", "DELETE"},
 		AllowedHeaders: []string{"*"},
 	})
 	router := chi.NewRouter()
 	router.Use(corsHandler.Handler)
-	router.Use(middleware.OapiRequestValidator(swagger))
+	if config.Auth.Enabled {
+		authenticator, err := auth.New(authParams(config, authorizer))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error setting up authentication\n: %s", err)
+			os.Exit(1)
+		}
+		router.Use(authenticate(authenticator))
+	}
+	streamed, err := newStreamedBodies(swagger)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error routing the swagger spec\n: %s", err)
+		os.Exit(1)
+	}
+	router.Use(limitUploadSize(streamed, config.Documents.MaxUploadBytes))
+	router.Use(validateRequests(swagger, streamed, config.Auth.Enabled))
 
 	// Create the server implementation
-	serverStrictHandler := serverapi.NewStrictHandler(gateway, nil)
+	serverStrictHandler := serverapi.NewStrictHandlerWithOptions(gateway,
+		[]serverapi.StrictMiddlewareFunc{authorizeOperations(authorizer)},
+		serverapi.StrictHTTPServerOptions{
+			RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+			},
+			ResponseErrorHandlerFunc: writeOperationError,
+		})
 
 	// Register the server routes
 	serverapi.HandlerFromMux(serverStrictHandler, router)
@@ -71,6 +109,28 @@ func StartGatewayService(ctx context.Context, gateway serverapi.StrictServerInte
 	http.ListenAndServe(fmt.Sprintf(":%d", config.Service.Port), router)
 }
 
+func authParams(config *cfg.Config, authorizer controller.Controller) auth.Params {
+	p := auth.Params{
+		Jwt: auth.JwtParams{
+			Issuer:      config.Auth.Jwt.Issuer,
+			Audience:    config.Auth.Jwt.Audience,
+			JwksUrl:     config.Auth.Jwt.JwksUrl,
+			JwksFile:    config.Auth.Jwt.JwksFile,
+			JwksRefresh: config.Auth.Jwt.JwksRefresh,
+			Leeway:      config.Auth.Jwt.Leeway,
+		},
+		Sessions:     controller.SessionParams(config.Accounts),
+		CheckSession: authorizer.CheckSession,
+	}
+	for _, key := range config.Auth.ApiKeys {
+		p.ApiKeys = append(p.ApiKeys, auth.ApiKeyParams{
+			Name: key.Name,
+			Hash: key.Hash,
+		})
+	}
+	return p
+}
+
 func (h *handler) PostV1PlyEnrollment(ctx context.Context, request serverapi.PostV1PlyEnrollmentRequestObject) (serverapi.PostV1PlyEnrollmentResponseObject, error) {
 	enrollment, err := utils.ConvertRequestBody[models.Enrollment](request.Body)
 	if err != nil {
@@ -81,6 +141,21 @@ func (h *handler) PostV1PlyEnrollment(ctx context.Context, request serverapi.Pos
 	}
 
 	enrollmentId, err := h.mainController.CreateEnrollment(ctx, enrollment)
+	var conflict *controller.ConflictError
+	if errors.As(err, &conflict) {
+		return serverapi.PostV1PlyEnrollment409JSONResponse{
+			Code:       int32(409),
+			Message:    conflict.Message,
+			ExistingId: utils.StringPtr(conflict.ExistingId),
+		}, nil
+	}
+	var validationErr *controller.ValidationError
+	if errors.As(err, &validationErr) {
+		return serverapi.PostV1PlyEnrollment400JSONResponse{
+			Code:    int32(400),
+			Message: validationErr.Message,
+		}, nil
+	}
 	if err != nil {
 		return serverapi.PostV1PlyEnrollment500JSONResponse{
 			Code:    int32(500),
@@ -95,6 +170,14 @@ func (h *handler) PostV1PlyEnrollment(ctx context.Context, request serverapi.Pos
 
 func (h *handler) DeleteV1PlyEnrollmentEnrollmentId(ctx context.Context, request serverapi.DeleteV1PlyEnrollmentEnrollmentIdRequestObject) (serverapi.DeleteV1PlyEnrollmentEnrollmentIdResponseObject, error) {
 	err := h.mainController.DeleteEnrollment(ctx, request.EnrollmentId)
+	var conflict *controller.ConflictError
+	if errors.As(err, &conflict) {
+		return serverapi.DeleteV1PlyEnrollmentEnrollmentId409JSONResponse{
+			Code:       int32(409),
+			Message:    conflict.Message,
+			ExistingId: utils.StringPtr(conflict.ExistingId),
+		}, nil
+	}
 	if err != nil {
 		return serverapi.DeleteV1PlyEnrollmentEnrollmentId500JSONResponse{
 			Code:    int32(500),
@@ -136,6 +219,21 @@ func (h *handler) PostV1PlyEnrollmentEnrollmentId(ctx context.Context, request s
 	}
 
 	err = h.mainController.UpdateEnrollment(ctx, enrollment)
+	var conflict *controller.ConflictError
+	if errors.As(err, &conflict) {
+		return serverapi.PostV1PlyEnrollmentEnrollmentId409JSONResponse{
+			Code:       int32(409),
+			Message:    conflict.Message,
+			ExistingId: utils.StringPtr(conflict.ExistingId),
+		}, nil
+	}
+	var validationErr *controller.ValidationError
+	if errors.As(err, &validationErr) {
+		return serverapi.PostV1PlyEnrollmentEnrollmentId400JSONResponse{
+			Code:    int32(400),
+			Message: validationErr.Message,
+		}, nil
+	}
 	if err != nil {
 		return serverapi.PostV1PlyEnrollmentEnrollmentId500JSONResponse{
 			Code:    int32(500),
@@ -174,6 +272,67 @@ func (h *handler) GetV1PlyEnrollmentEnrollmentIdActivity(ctx context.Context, re
 	return httpActivities, nil
 }
 
+func (h *handler) GetV1PlyEnrollmentEnrollmentIdDependents(ctx context.Context, request serverapi.GetV1PlyEnrollmentEnrollmentIdDependentsRequestObject) (serverapi.GetV1PlyEnrollmentEnrollmentIdDependentsResponseObject, error) {
+	enrollments, err := h.mainController.ListDependentEnrollments(ctx, request.EnrollmentId)
+	if err != nil {
+		return serverapi.GetV1PlyEnrollmentEnrollmentIdDependents500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	parsedEnrollments := struct {
+		Enrollments []*models.Enrollment `json:"enrollments,omitempty"`
+	}{
+		Enrollments: enrollments,
+	}
+
+	httpEnrollments, err := utils.ConvertRequestBody[serverapi.GetV1PlyEnrollmentEnrollmentIdDependents200JSONResponse](parsedEnrollments)
+	if err != nil {
+		return serverapi.GetV1PlyEnrollmentEnrollmentIdDependents500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpEnrollments, nil
+}
+
+func (h *handler) GetV1PlyReportRevalidation(ctx context.Context, request serverapi.GetV1PlyReportRevalidationRequestObject) (serverapi.GetV1PlyReportRevalidationResponseObject, error) {
+	withinDays := 90
+	if request.Params.WithinDays != nil {
+		withinDays = *request.Params.WithinDays
+	}
+	practiceId := ""
+	if request.Params.PracticeId != nil {
+		practiceId = *request.Params.PracticeId
+	}
+
+	enrollments, err := h.mainController.ListRevalidationsDue(ctx, practiceId, withinDays)
+	if err != nil {
+		return serverapi.GetV1PlyReportRevalidation500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	parsedEnrollments := struct {
+		Enrollments []*models.Enrollment `json:"enrollments,omitempty"`
+	}{
+		Enrollments: enrollments,
+	}
+
+	httpEnrollments, err := utils.ConvertRequestBody[serverapi.GetV1PlyReportRevalidation200JSONResponse](parsedEnrollments)
+	if err != nil {
+		return serverapi.GetV1PlyReportRevalidation500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpEnrollments, nil
+}
+
 func (h *handler) PostV1PlyLocation(ctx context.Context, request serverapi.PostV1PlyLocationRequestObject) (serverapi.PostV1PlyLocationResponseObject, error) {
 	location, err := utils.ConvertRequestBody[models.Location](request.Body)
 	if err != nil {
@@ -261,6 +420,13 @@ func (h *handler) PostV1PlyPractice(ctx context.Context, request serverapi.PostV
 	}
 
 	practiceId, err := h.mainController.CreatePractice(ctx, practice)
+	var validationErr *controller.ValidationError
+	if errors.As(err, &validationErr) {
+		return serverapi.PostV1PlyPractice400JSONResponse{
+			Code:    int32(400),
+			Message: validationErr.Error(),
+		}, nil
+	}
 	if err != nil {
 		return serverapi.PostV1PlyPractice500JSONResponse{
 			Code:    int32(500),
@@ -329,6 +495,13 @@ func (h *handler) PostV1PlyPracticePracticeId(ctx context.Context, request serve
 	}
 
 	err = h.mainController.UpdatePractice(ctx, practice)
+	var validationErr *controller.ValidationError
+	if errors.As(err, &validationErr) {
+		return serverapi.PostV1PlyPracticePracticeId400JSONResponse{
+			Code:    int32(400),
+			Message: validationErr.Error(),
+		}, nil
+	}
 	if err != nil {
 		return serverapi.PostV1PlyPracticePracticeId500JSONResponse{
 			Code:    int32(500),
@@ -367,6 +540,32 @@ func (h *handler) GetV1PlyPracticePracticeIdEnrollment(ctx context.Context, requ
 	return httpEnrollments, nil
 }
 
+func (h *handler) GetV1PlyPracticePracticeIdEnrollmentDuplicates(ctx context.Context, request serverapi.GetV1PlyPracticePracticeIdEnrollmentDuplicatesRequestObject) (serverapi.GetV1PlyPracticePracticeIdEnrollmentDuplicatesResponseObject, error) {
+	duplicates, err := h.mainController.ListDuplicateEnrollments(ctx, request.PracticeId)
+	if err != nil {
+		return serverapi.GetV1PlyPracticePracticeIdEnrollmentDuplicates500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	parsedDuplicates := struct {
+		Duplicates []*models.DuplicateEnrollmentGroup `json:"duplicates,omitempty"`
+	}{
+		Duplicates: duplicates,
+	}
+
+	httpDuplicates, err := utils.ConvertRequestBody[serverapi.GetV1PlyPracticePracticeIdEnrollmentDuplicates200JSONResponse](parsedDuplicates)
+	if err != nil {
+		return serverapi.GetV1PlyPracticePracticeIdEnrollmentDuplicates500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpDuplicates, nil
+}
+
 func (h *handler) GetV1PlyPracticePracticeIdLocation(ctx context.Context, request serverapi.GetV1PlyPracticePracticeIdLocationRequestObject) (serverapi.GetV1PlyPracticePracticeIdLocationResponseObject, error) {
 	locations, err := h.mainController.ListLocations(ctx, request.PracticeId)
 	if err != nil {
@@ -429,6 +628,21 @@ func (h *handler) PostV1PlyProvider(ctx context.Context, request serverapi.PostV
 	}
 
 	providerId, err := h.mainController.CreateProvider(ctx, provider)
+	var validationErr *controller.ValidationError
+	if errors.As(err, &validationErr) {
+		return serverapi.PostV1PlyProvider400JSONResponse{
+			Code:    int32(400),
+			Message: validationErr.Error(),
+		}, nil
+	}
+	var conflict *controller.ConflictError
+	if errors.As(err, &conflict) {
+		return serverapi.PostV1PlyProvider409JSONResponse{
+			Code:       int32(409),
+			Message:    conflict.Message,
+			ExistingId: utils.StringPtr(conflict.ExistingId),
+		}, nil
+	}
 	if err != nil {
 		return serverapi.PostV1PlyProvider500JSONResponse{
 			Code:    int32(500),
@@ -483,6 +697,21 @@ func (h *handler) PostV1PlyProviderProviderId(ctx context.Context, request serve
 	}
 
 	err = h.mainController.UpdateProvider(ctx, provider)
+	var validationErr *controller.ValidationError
+	if errors.As(err, &validationErr) {
+		return serverapi.PostV1PlyProviderProviderId400JSONResponse{
+			Code:    int32(400),
+			Message: validationErr.Error(),
+		}, nil
+	}
+	var conflict *controller.ConflictError
+	if errors.As(err, &conflict) {
+		return serverapi.PostV1PlyProviderProviderId409JSONResponse{
+			Code:       int32(409),
+			Message:    conflict.Message,
+			ExistingId: utils.StringPtr(conflict.ExistingId),
+		}, nil
+	}
 	if err != nil {
 		return serverapi.PostV1PlyProviderProviderId500JSONResponse{
 			Code:    int32(500),
@@ -495,6 +724,110 @@ func (h *handler) PostV1PlyProviderProviderId(ctx context.Context, request serve
 	}, nil
 }
 
+func (h *handler) GetV1PlyProviderProviderIdAffiliation(ctx context.Context, request serverapi.GetV1PlyProviderProviderIdAffiliationRequestObject) (serverapi.GetV1PlyProviderProviderIdAffiliationResponseObject, error) {
+	affiliations, err := h.mainController.ListAffiliations(ctx, request.ProviderId)
+	if err != nil {
+		return serverapi.GetV1PlyProviderProviderIdAffiliation500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	parsedAffiliations := struct {
+		Affiliations []*models.Affiliation `json:"affiliations,omitempty"`
+	}{
+		Affiliations: affiliations,
+	}
+
+	httpAffiliations, err := utils.ConvertRequestBody[serverapi.GetV1PlyProviderProviderIdAffiliation200JSONResponse](parsedAffiliations)
+	if err != nil {
+		return serverapi.GetV1PlyProviderProviderIdAffiliation500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpAffiliations, nil
+}
+
+func (h *handler) PostV1PlyProviderProviderIdAffiliation(ctx context.Context, request serverapi.PostV1PlyProviderProviderIdAffiliationRequestObject) (serverapi.PostV1PlyProviderProviderIdAffiliationResponseObject, error) {
+	affiliation, err := utils.ConvertRequestBody[models.Affiliation](request.Body)
+	if err != nil {
+		return serverapi.PostV1PlyProviderProviderIdAffiliation500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+	affiliation.ProviderId = request.ProviderId
+
+	affiliationId, err := h.mainController.CreateAffiliation(ctx, affiliation)
+	if err != nil {
+		return serverapi.PostV1PlyProviderProviderIdAffiliation500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return serverapi.PostV1PlyProviderProviderIdAffiliation200JSONResponse{
+		AffiliationId: utils.StringPtr(affiliationId),
+	}, nil
+}
+
+func (h *handler) DeleteV1PlyAffiliationAffiliationId(ctx context.Context, request serverapi.DeleteV1PlyAffiliationAffiliationIdRequestObject) (serverapi.DeleteV1PlyAffiliationAffiliationIdResponseObject, error) {
+	err := h.mainController.DeleteAffiliation(ctx, request.AffiliationId)
+	if err != nil {
+		return serverapi.DeleteV1PlyAffiliationAffiliationId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+	return serverapi.DeleteV1PlyAffiliationAffiliationId200JSONResponse{
+		Status: utils.StringPtr("Completed"),
+	}, nil
+}
+
+func (h *handler) GetV1PlyAffiliationAffiliationId(ctx context.Context, request serverapi.GetV1PlyAffiliationAffiliationIdRequestObject) (serverapi.GetV1PlyAffiliationAffiliationIdResponseObject, error) {
+	affiliation, err := h.mainController.ReadAffiliation(ctx, request.AffiliationId)
+	if err != nil {
+		return serverapi.GetV1PlyAffiliationAffiliationId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpAffiliation, err := utils.ConvertRequestBody[serverapi.GetV1PlyAffiliationAffiliationId200JSONResponse](affiliation)
+	if err != nil {
+		return serverapi.GetV1PlyAffiliationAffiliationId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+	return httpAffiliation, nil
+}
+
+func (h *handler) PostV1PlyAffiliationAffiliationId(ctx context.Context, request serverapi.PostV1PlyAffiliationAffiliationIdRequestObject) (serverapi.PostV1PlyAffiliationAffiliationIdResponseObject, error) {
+	affiliation, err := utils.ConvertRequestBody[models.Affiliation](request.Body)
+	if err != nil {
+		return serverapi.PostV1PlyAffiliationAffiliationId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+	affiliation.AffiliationId = request.AffiliationId
+
+	err = h.mainController.UpdateAffiliation(ctx, affiliation)
+	if err != nil {
+		return serverapi.PostV1PlyAffiliationAffiliationId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return serverapi.PostV1PlyAffiliationAffiliationId200JSONResponse{
+		Status: utils.StringPtr("Completed"),
+	}, nil
+}
+
 func (h *handler) GetV1PlyPracticePracticeIdTask(ctx context.Context, request serverapi.GetV1PlyPracticePracticeIdTaskRequestObject) (serverapi.GetV1PlyPracticePracticeIdTaskResponseObject, error) {
 	tasks, err := h.mainController.ListTasks(ctx, request.PracticeId)
 	if err != nil {
@@ -544,56 +877,138 @@ func (h *handler) PostV1PlyTaskTaskId(ctx context.Context, request serverapi.Pos
 }
 
 func (h *handler) PostV1PlyPracticePracticeIdUpload(ctx context.Context, request serverapi.PostV1PlyPracticePracticeIdUploadRequestObject) (serverapi.PostV1PlyPracticePracticeIdUploadResponseObject, error) {
-	// Get the file and form fields
-	var fileName string
-	var fileData []byte
+	// The file part is streamed straight to storage, so its name and
+	// metadata have to be known before it arrives: either from fields sent
+	// ahead of it or, for the name, from the file part's own filename.
+	doc := &models.Document{
+		PracticeId: request.PracticeId,
+		Size:       declaredUploadSize(ctx),
+	}
+	var documentId string
 
 	for {
 		part, err := request.Body.NextPart()
-		if err != nil {
+		if err == io.EOF {
 			break
 		}
+		if err != nil {
+			return uploadErrorResponse(err), nil
+		}
 
+		if part.FormName() == "file" {
+			if documentId != "" {
+				continue
+			}
+			if doc.FileName == "" {
+				doc.FileName = part.FileName()
+			}
+			if doc.FileName == "" {
+				return &serverapi.PostV1PlyPracticePracticeIdUpload500JSONResponse{
+					Code:    int32(500),
+					Message: "fileName is required",
+				}, nil
+			}
+
+			file := bufio.NewReader(part)
+			if _, err := file.Peek(1); err != nil {
+				if err != io.EOF {
+					return uploadErrorResponse(err), nil
+				}
+				return &serverapi.PostV1PlyPracticePracticeIdUpload500JSONResponse{
+					Code:    int32(500),
+					Message: "file is required",
+				}, nil
+			}
+
+			documentId, err = h.mainController.UploadDocument(ctx, doc, file)
+			if err != nil {
+				return uploadErrorResponse(err), nil
+			}
+			continue
+		}
+
+		buf := new(bytes.Buffer)
+		if _, err := buf.ReadFrom(part); err != nil {
+			return uploadErrorResponse(err), nil
+		}
 		switch part.FormName() {
-		case "file":
-			buf := new(bytes.Buffer)
-			buf.ReadFrom(part)
-			fileData = buf.Bytes()
 		case "fileName":
-			buf := new(bytes.Buffer)
-			buf.ReadFrom(part)
-			fileName = buf.String()
+			doc.FileName = buf.String()
+		case "documentType":
+			doc.DocumentType = buf.String()
+		case "providerId":
+			doc.ProviderId = buf.String()
+		case "locationId":
+			doc.LocationId = buf.String()
+		case "enrollmentId":
+			doc.EnrollmentId = buf.String()
+		case "expirationDate":
+			doc.ExpirationDate = buf.String()
 		}
 	}
 
-	if len(fileData) == 0 {
+	if documentId == "" {
 		return &serverapi.PostV1PlyPracticePracticeIdUpload500JSONResponse{
 			Code:    int32(500),
 			Message: "file is required",
 		}, nil
 	}
 
-	if fileName == "" {
-		return &serverapi.PostV1PlyPracticePracticeIdUpload500JSONResponse{
-			Code:    int32(500),
-			Message: "fileName is required",
-		}, nil
-	}
-
-	// Upload the document using the file data
-	documentId, err := h.mainController.UploadDocument(ctx, request.PracticeId, fileName, bytes.NewReader(fileData))
-	if err != nil {
-		return &serverapi.PostV1PlyPracticePracticeIdUpload500JSONResponse{
-			Code:    int32(500),
-			Message: err.Error(),
-		}, nil
-	}
-
 	return &serverapi.PostV1PlyPracticePracticeIdUpload200JSONResponse{
 		DocumentId: utils.StringPtr(documentId),
 	}, nil
 }
 
+func uploadErrorResponse(err error) serverapi.PostV1PlyPracticePracticeIdUploadResponseObject {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return &serverapi.PostV1PlyPracticePracticeIdUpload413JSONResponse{
+			Code:    int32(413),
+			Message: fmt.Sprintf("upload exceeds the limit of %d bytes", maxBytesErr.Limit),
+		}
+	}
+	var unsupportedErr *controller.UnsupportedContentTypeError
+	if errors.As(err, &unsupportedErr) {
+		return &serverapi.PostV1PlyPracticePracticeIdUpload415JSONResponse{
+			Code:    int32(415),
+			Message: unsupportedErr.Error(),
+		}
+	}
+	var validationErr *controller.ValidationError
+	if errors.As(err, &validationErr) {
+		return &serverapi.PostV1PlyPracticePracticeIdUpload400JSONResponse{
+			Code:    int32(400),
+			Message: validationErr.Error(),
+		}
+	}
+	var conflict *controller.ConflictError
+	if errors.As(err, &conflict) {
+		return &serverapi.PostV1PlyPracticePracticeIdUpload409JSONResponse{
+			Code:       int32(409),
+			Message:    conflict.Message,
+			ExistingId: utils.StringPtr(conflict.ExistingId),
+		}
+	}
+	var quotaErr *controller.QuotaExceededError
+	if errors.As(err, &quotaErr) {
+		// An upload larger than the whole quota can never fit
+		if quotaErr.Size > quotaErr.Quota {
+			return &serverapi.PostV1PlyPracticePracticeIdUpload413JSONResponse{
+				Code:    int32(413),
+				Message: quotaErr.Error(),
+			}
+		}
+		return &serverapi.PostV1PlyPracticePracticeIdUpload507JSONResponse{
+			Code:    int32(507),
+			Message: quotaErr.Error(),
+		}
+	}
+	return &serverapi.PostV1PlyPracticePracticeIdUpload500JSONResponse{
+		Code:    int32(500),
+		Message: err.Error(),
+	}
+}
+
 func (h *handler) GetV1PlyDocumentDocumentId(ctx context.Context, request serverapi.GetV1PlyDocumentDocumentIdRequestObject) (serverapi.GetV1PlyDocumentDocumentIdResponseObject, error) {
 	doc, err := h.mainController.GetDocument(ctx, request.DocumentId)
 	if err != nil {
@@ -603,49 +1018,173 @@ func (h *handler) GetV1PlyDocumentDocumentId(ctx context.Context, request server
 		}, nil
 	}
 
-	// Read the entire file into memory
-	fileData, err := os.ReadFile(doc.StoragePath)
+	download, err := h.openDocumentDownload(ctx, doc, request.Params.Range)
+	var scanErr *controller.ScanError
+	if errors.As(err, &scanErr) {
+		return &serverapi.GetV1PlyDocumentDocumentId403JSONResponse{
+			Code:    int32(403),
+			Message: scanErr.Error(),
+		}, nil
+	}
+	var rangeErr *rangeError
+	if errors.As(err, &rangeErr) {
+		response := serverapi.GetV1PlyDocumentDocumentId416JSONResponse{
+			Headers: serverapi.GetV1PlyDocumentDocumentId416ResponseHeaders{
+				ContentRange: fmt.Sprintf("bytes */%d", rangeErr.size),
+			},
+		}
+		response.Body.Code = int32(416)
+		response.Body.Message = rangeErr.Error()
+		return response, nil
+	}
 	if err != nil {
 		return &serverapi.GetV1PlyDocumentDocumentId500JSONResponse{
 			Code:    int32(500),
-			Message: fmt.Sprintf("error reading file: %v", err),
-		}, nil
-	}
-
-	// Detect content type based on file extension
-	ext := filepath.Ext(doc.FileName)
-	contentType := "application/octet-stream" // default binary
-	switch strings.ToLower(ext) {
-	case ".pdf":
-		contentType = "application/pdf"
-	case ".jpg", ".jpeg":
-		contentType = "image/jpeg"
-	case ".png":
-		contentType = "image/png"
-	case ".gif":
-		contentType = "image/gif"
-	case ".txt":
-		contentType = "text/plain"
-	case ".html", ".htm":
-		contentType = "text/html"
-	case ".doc", ".docx":
-		contentType = "application/msword"
-	case ".xls", ".xlsx":
-		contentType = "application/vnd.ms-excel"
-	case ".zip":
-		contentType = "application/zip"
-	}
-
-	// Create a response with the file data
+			Message: err.Error(),
+		}, nil
+	}
+
+	if download.partial {
+		return &serverapi.GetV1PlyDocumentDocumentId206AsteriskResponse{
+			Body: download.body,
+			Headers: serverapi.GetV1PlyDocumentDocumentId206ResponseHeaders{
+				AcceptRanges:        "bytes",
+				ContentDisposition:  download.disposition,
+				ContentRange:        download.contentRange(),
+				XContentTypeOptions: "nosniff",
+			},
+			ContentLength: download.length,
+			ContentType:   download.contentType,
+		}, nil
+	}
+
 	return &serverapi.GetV1PlyDocumentDocumentId200AsteriskResponse{
-		Body:          bytes.NewReader(fileData),
-		ContentLength: int64(len(fileData)),
-		ContentType:   contentType,
+		Body: download.body,
+		Headers: serverapi.GetV1PlyDocumentDocumentId200ResponseHeaders{
+			AcceptRanges:        "bytes",
+			ContentDisposition:  download.disposition,
+			XContentTypeOptions: "nosniff",
+		},
+		ContentLength: download.size,
+		ContentType:   download.contentType,
 	}, nil
 }
 
+// documentDownload is a document's file opened for streaming, limited to the
+// byte range the client asked for when partial is set.
+type documentDownload struct {
+	body        io.ReadCloser
+	size        int64
+	offset      int64
+	length      int64
+	partial     bool
+	contentType string
+	disposition string
+}
+
+func (d *documentDownload) contentRange() string {
+	return fmt.Sprintf("bytes %d-%d/%d", d.offset, d.offset+d.length-1, d.size)
+}
+
+// rangeError is returned when a Range header cannot be satisfied for a file
+// of size bytes.
+type rangeError struct {
+	size int64
+	err  error
+}
+
+func (e *rangeError) Error() string {
+	return e.err.Error()
+}
+
+// openDocumentDownload opens doc's file, or the part of it named by
+// rangeHeader. It returns a *controller.ScanError when the file may not be
+// served and a *rangeError when the range is unsatisfiable.
+func (h *handler) openDocumentDownload(ctx context.Context, doc *models.Document, rangeHeader *string) (*documentDownload, error) {
+	if err := h.mainController.DocumentDownloadable(doc); err != nil {
+		return nil, err
+	}
+
+	size, err := h.mainController.StatDocument(ctx, doc)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file: %v", err)
+	}
+
+	offset, length, partial := int64(0), size, false
+	if rangeHeader != nil {
+		offset, length, partial, err = parseRange(*rangeHeader, size)
+		if err != nil {
+			return nil, &rangeError{size: size, err: err}
+		}
+		if !partial {
+			offset, length = 0, size
+		}
+	}
+
+	// Stream the file rather than reading it into memory
+	body, err := h.mainController.OpenDocument(ctx, doc, offset, length)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file: %v", err)
+	}
+
+	contentType, disposition := documentContentHeaders(doc)
+	return &documentDownload{
+		body:        body,
+		size:        size,
+		offset:      offset,
+		length:      length,
+		partial:     partial,
+		contentType: contentType,
+		disposition: disposition,
+	}, nil
+}
+
+// inlineContentTypes are the media types browsers may render in place;
+// everything else is downloaded as an attachment.
+var inlineContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/gif":       true,
+	"image/jpeg":      true,
+	"image/png":       true,
+	"text/plain":      true,
+}
+
+// documentContentHeaders returns the Content-Type and Content-Disposition to
+// serve doc with, based on the type sniffed when it was uploaded.
+func documentContentHeaders(doc *models.Document) (string, string) {
+	contentType := doc.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream" // default binary
+	}
+
+	dispositionType := "attachment"
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil && inlineContentTypes[mediaType] {
+		dispositionType = "inline"
+	}
+
+	disposition := mime.FormatMediaType(dispositionType, map[string]string{"filename": doc.FileName})
+	if disposition == "" {
+		disposition = dispositionType
+	}
+	return contentType, disposition
+}
+
 func (h *handler) GetV1PlyPracticePracticeIdDocument(ctx context.Context, request serverapi.GetV1PlyPracticePracticeIdDocumentRequestObject) (serverapi.GetV1PlyPracticePracticeIdDocumentResponseObject, error) {
-	documents, err := h.mainController.ListDocuments(ctx, request.PracticeId)
+	filter := &models.DocumentFilter{}
+	if request.Params.DocumentType != nil {
+		filter.DocumentType = *request.Params.DocumentType
+	}
+	if request.Params.ProviderId != nil {
+		filter.ProviderId = *request.Params.ProviderId
+	}
+	if request.Params.LocationId != nil {
+		filter.LocationId = *request.Params.LocationId
+	}
+	if request.Params.EnrollmentId != nil {
+		filter.EnrollmentId = *request.Params.EnrollmentId
+	}
+
+	documents, err := h.mainController.ListDocuments(ctx, request.PracticeId, filter)
 	if err != nil {
 		return &serverapi.GetV1PlyPracticePracticeIdDocument500JSONResponse{
 			Code:    int32(500),
@@ -670,6 +1209,44 @@ func (h *handler) GetV1PlyPracticePracticeIdDocument(ctx context.Context, reques
 	return httpDocuments, nil
 }
 
+func (h *handler) GetV1PlyPracticePracticeIdDocumentArchive(ctx context.Context, request serverapi.GetV1PlyPracticePracticeIdDocumentArchiveRequestObject) (serverapi.GetV1PlyPracticePracticeIdDocumentArchiveResponseObject, error) {
+	filter := &models.DocumentFilter{}
+	if request.Params.DocumentType != nil {
+		filter.DocumentType = *request.Params.DocumentType
+	}
+	if request.Params.ProviderId != nil {
+		filter.ProviderId = *request.Params.ProviderId
+	}
+	if request.Params.EnrollmentId != nil {
+		filter.EnrollmentId = *request.Params.EnrollmentId
+	}
+
+	documents, err := h.mainController.ListDocuments(ctx, request.PracticeId, filter)
+	if err != nil {
+		return &serverapi.GetV1PlyPracticePracticeIdDocumentArchive500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	// Build the archive as it is sent rather than in memory. Closing the
+	// reader when the client goes away stops the writer.
+	archive, archiveWriter := io.Pipe()
+	go func() {
+		archiveWriter.CloseWithError(h.mainController.WriteDocumentArchive(ctx, documents, archiveWriter))
+	}()
+
+	disposition := mime.FormatMediaType("attachment", map[string]string{
+		"filename": fmt.Sprintf("practice-%s-documents.zip", request.PracticeId),
+	})
+	return &serverapi.GetV1PlyPracticePracticeIdDocumentArchive200ApplicationzipResponse{
+		Body: archive,
+		Headers: serverapi.GetV1PlyPracticePracticeIdDocumentArchive200ResponseHeaders{
+			ContentDisposition: disposition,
+		},
+	}, nil
+}
+
 func (h *handler) DeleteV1PlyDocumentDocumentId(ctx context.Context, request serverapi.DeleteV1PlyDocumentDocumentIdRequestObject) (serverapi.DeleteV1PlyDocumentDocumentIdResponseObject, error) {
 	err := h.mainController.DeleteDocument(ctx, request.DocumentId)
 	if err != nil {
@@ -682,3 +1259,52 @@ func (h *handler) DeleteV1PlyDocumentDocumentId(ctx context.Context, request ser
 		Status: utils.StringPtr("Completed"),
 	}, nil
 }
+
+func (h *handler) GetV1PlyDocumentDocumentIdMetadata(ctx context.Context, request serverapi.GetV1PlyDocumentDocumentIdMetadataRequestObject) (serverapi.GetV1PlyDocumentDocumentIdMetadataResponseObject, error) {
+	doc, err := h.mainController.GetDocument(ctx, request.DocumentId)
+	if err != nil {
+		return &serverapi.GetV1PlyDocumentDocumentIdMetadata500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpDocument, err := utils.ConvertRequestBody[serverapi.GetV1PlyDocumentDocumentIdMetadata200JSONResponse](doc)
+	if err != nil {
+		return &serverapi.GetV1PlyDocumentDocumentIdMetadata500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpDocument, nil
+}
+
+func (h *handler) PostV1PlyDocumentDocumentIdMetadata(ctx context.Context, request serverapi.PostV1PlyDocumentDocumentIdMetadataRequestObject) (serverapi.PostV1PlyDocumentDocumentIdMetadataResponseObject, error) {
+	metadata, err := utils.ConvertRequestBody[models.Document](request.Body)
+	if err != nil {
+		return &serverapi.PostV1PlyDocumentDocumentIdMetadata500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	err = h.mainController.UpdateDocumentMetadata(ctx, request.DocumentId, metadata)
+	if err != nil {
+		var validationErr *controller.ValidationError
+		if errors.As(err, &validationErr) {
+			return &serverapi.PostV1PlyDocumentDocumentIdMetadata400JSONResponse{
+				Code:    int32(400),
+				Message: validationErr.Error(),
+			}, nil
+		}
+		return &serverapi.PostV1PlyDocumentDocumentIdMetadata500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &serverapi.PostV1PlyDocumentDocumentIdMetadata200JSONResponse{
+		Status: utils.StringPtr("Completed"),
+	}, nil
+}
@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"code.ply.internal/core/controller"
+	"code.ply.internal/core/utils"
+	serverapi "code.ply.internal/gen"
+)
+
+func (h *handler) PostV1PlyLoginMfa(ctx context.Context, request serverapi.PostV1PlyLoginMfaRequestObject) (serverapi.PostV1PlyLoginMfaResponseObject, error) {
+	session, err := h.mainController.CompleteMfaLogin(ctx, request.Body.MfaToken, request.Body.Code)
+	var locked *controller.AccountLockedError
+	if errors.As(err, &locked) {
+		return serverapi.PostV1PlyLoginMfa429JSONResponse{
+			Code:    int32(429),
+			Message: locked.Error(),
+		}, nil
+	}
+	if errors.Is(err, controller.ErrInvalidMfaCode) || errors.Is(err, controller.ErrInvalidMfaChallenge) {
+		return serverapi.PostV1PlyLoginMfa401JSONResponse{
+			Code:    int32(401),
+			Message: err.Error(),
+		}, nil
+	}
+	if errors.Is(err, controller.ErrAccountsDisabled) {
+		return serverapi.PostV1PlyLoginMfa503JSONResponse{
+			Code:    int32(503),
+			Message: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return serverapi.PostV1PlyLoginMfa500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpSession, err := utils.ConvertRequestBody[serverapi.PostV1PlyLoginMfa200JSONResponse](session)
+	if err != nil {
+		return serverapi.PostV1PlyLoginMfa500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpSession, nil
+}
+
+func (h *handler) PostV1PlyMeMfa(ctx context.Context, request serverapi.PostV1PlyMeMfaRequestObject) (serverapi.PostV1PlyMeMfaResponseObject, error) {
+	enrollment, err := h.mainController.StartMfaEnrollment(ctx)
+	if errors.Is(err, controller.ErrNotAuthenticated) {
+		return serverapi.PostV1PlyMeMfa401JSONResponse{
+			Code:    int32(401),
+			Message: "only users who logged in with a password can enroll",
+		}, nil
+	}
+	var conflict *controller.ConflictError
+	if errors.As(err, &conflict) {
+		return serverapi.PostV1PlyMeMfa409JSONResponse{
+			Code:       int32(409),
+			Message:    conflict.Message,
+			ExistingId: utils.StringPtr(conflict.ExistingId),
+		}, nil
+	}
+	if err != nil {
+		return serverapi.PostV1PlyMeMfa500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpEnrollment, err := utils.ConvertRequestBody[serverapi.PostV1PlyMeMfa200JSONResponse](enrollment)
+	if err != nil {
+		return serverapi.PostV1PlyMeMfa500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpEnrollment, nil
+}
+
+func (h *handler) PostV1PlyMeMfaConfirm(ctx context.Context, request serverapi.PostV1PlyMeMfaConfirmRequestObject) (serverapi.PostV1PlyMeMfaConfirmResponseObject, error) {
+	recoveryCodes, err := h.mainController.ConfirmMfaEnrollment(ctx, request.Body.Code)
+	var validationErr *controller.ValidationError
+	if errors.As(err, &validationErr) {
+		return serverapi.PostV1PlyMeMfaConfirm400JSONResponse{
+			Code:    int32(400),
+			Message: validationErr.Error(),
+		}, nil
+	}
+	if errors.Is(err, controller.ErrInvalidMfaCode) {
+		return serverapi.PostV1PlyMeMfaConfirm400JSONResponse{
+			Code:    int32(400),
+			Message: err.Error(),
+		}, nil
+	}
+	if errors.Is(err, controller.ErrNotAuthenticated) {
+		return serverapi.PostV1PlyMeMfaConfirm401JSONResponse{
+			Code:    int32(401),
+			Message: "only users who logged in with a password can enroll",
+		}, nil
+	}
+	if err != nil {
+		return serverapi.PostV1PlyMeMfaConfirm500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpRecoveryCodes, err := utils.ConvertRequestBody[serverapi.PostV1PlyMeMfaConfirm200JSONResponse](recoveryCodes)
+	if err != nil {
+		return serverapi.PostV1PlyMeMfaConfirm500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpRecoveryCodes, nil
+}
+
+func (h *handler) PostV1PlyMeMfaDisable(ctx context.Context, request serverapi.PostV1PlyMeMfaDisableRequestObject) (serverapi.PostV1PlyMeMfaDisableResponseObject, error) {
+	err := h.mainController.DisableMfa(ctx, request.Body.Code)
+	var validationErr *controller.ValidationError
+	if errors.As(err, &validationErr) {
+		return serverapi.PostV1PlyMeMfaDisable400JSONResponse{
+			Code:    int32(400),
+			Message: validationErr.Error(),
+		}, nil
+	}
+	if errors.Is(err, controller.ErrInvalidMfaCode) {
+		return serverapi.PostV1PlyMeMfaDisable400JSONResponse{
+			Code:    int32(400),
+			Message: err.Error(),
+		}, nil
+	}
+	var locked *controller.AccountLockedError
+	if errors.As(err, &locked) {
+		return serverapi.PostV1PlyMeMfaDisable429JSONResponse{
+			Code:    int32(429),
+			Message: locked.Error(),
+		}, nil
+	}
+	if errors.Is(err, controller.ErrNotAuthenticated) {
+		return serverapi.PostV1PlyMeMfaDisable401JSONResponse{
+			Code:    int32(401),
+			Message: err.Error(),
+		}, nil
+	}
+	var conflict *controller.ConflictError
+	if errors.As(err, &conflict) {
+		return serverapi.PostV1PlyMeMfaDisable409JSONResponse{
+			Code:       int32(409),
+			Message:    conflict.Message,
+			ExistingId: utils.StringPtr(conflict.ExistingId),
+		}, nil
+	}
+	if err != nil {
+		return serverapi.PostV1PlyMeMfaDisable500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+	return serverapi.PostV1PlyMeMfaDisable200JSONResponse{
+		Status: utils.StringPtr("Completed"),
+	}, nil
+}
+
+func (h *handler) PostV1PlyMeMfaRecovery(ctx context.Context, request serverapi.PostV1PlyMeMfaRecoveryRequestObject) (serverapi.PostV1PlyMeMfaRecoveryResponseObject, error) {
+	recoveryCodes, err := h.mainController.RegenerateRecoveryCodes(ctx, request.Body.Code)
+	var validationErr *controller.ValidationError
+	if errors.As(err, &validationErr) {
+		return serverapi.PostV1PlyMeMfaRecovery400JSONResponse{
+			Code:    int32(400),
+			Message: validationErr.Error(),
+		}, nil
+	}
+	if errors.Is(err, controller.ErrInvalidMfaCode) {
+		return serverapi.PostV1PlyMeMfaRecovery400JSONResponse{
+			Code:    int32(400),
+			Message: err.Error(),
+		}, nil
+	}
+	var locked *controller.AccountLockedError
+	if errors.As(err, &locked) {
+		return serverapi.PostV1PlyMeMfaRecovery429JSONResponse{
+			Code:    int32(429),
+			Message: locked.Error(),
+		}, nil
+	}
+	if errors.Is(err, controller.ErrNotAuthenticated) {
+		return serverapi.PostV1PlyMeMfaRecovery401JSONResponse{
+			Code:    int32(401),
+			Message: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return serverapi.PostV1PlyMeMfaRecovery500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpRecoveryCodes, err := utils.ConvertRequestBody[serverapi.PostV1PlyMeMfaRecovery200JSONResponse](recoveryCodes)
+	if err != nil {
+		return serverapi.PostV1PlyMeMfaRecovery500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpRecoveryCodes, nil
+}
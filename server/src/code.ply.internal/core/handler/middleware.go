@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+
+	"code.ply.internal/core/auth"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	middleware "github.com/oapi-codegen/nethttp-middleware"
+)
+
+// validateRequests checks every request against the spec. The bodies of
+// operations that stream a multipart or binary upload are left out of
+// validation, which would otherwise read the whole upload into memory before
+// the handler sees it. When requireAuth is set, operations the spec secures
+// are refused unless authenticate found a principal for one of their
+// security schemes.
+func validateRequests(swagger *openapi3.T, streamed *streamedBodies, requireAuth bool) func(http.Handler) http.Handler {
+	options := openapi3filter.Options{AuthenticationFunc: openapi3filter.NoopAuthenticationFunc}
+	if requireAuth {
+		options.AuthenticationFunc = requirePrincipal
+	}
+	optionsWithoutBody := options
+	optionsWithoutBody.ExcludeRequestBody = true
+
+	validate := middleware.OapiRequestValidatorWithOptions(swagger, &middleware.Options{Options: options})
+	validateWithoutBody := middleware.OapiRequestValidatorWithOptions(swagger, &middleware.Options{Options: optionsWithoutBody})
+
+	return func(next http.Handler) http.Handler {
+		validated := validate(next)
+		validatedWithoutBody := validateWithoutBody(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if streamed.match(r) {
+				validatedWithoutBody.ServeHTTP(w, r)
+				return
+			}
+			validated.ServeHTTP(w, r)
+		})
+	}
+}
+
+// securitySchemePrincipals are the kinds of principal each of the spec's
+// security schemes accepts
+var securitySchemePrincipals = map[string]string{
+	"bearerAuth": auth.PrincipalUser,
+	"apiKeyAuth": auth.PrincipalService,
+}
+
+// requirePrincipal passes a security scheme when the request was
+// authenticated with it.
+func requirePrincipal(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return errors.New("authentication required")
+	}
+	if securitySchemePrincipals[input.SecuritySchemeName] != principal.Kind {
+		return fmt.Errorf("%s credentials are required", input.SecuritySchemeName)
+	}
+	return nil
+}
+
+// authenticate puts the principal a request's credentials identify on its
+// context. Requests without credentials are passed on for validateRequests to
+// refuse where the spec secures the operation; invalid credentials are
+// refused here.
+func authenticate(authenticator *auth.Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, err := authenticator.Authenticate(r.Context(), r)
+			if errors.Is(err, auth.ErrInvalidCredentials) {
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				writeError(w, http.StatusUnauthorized, err.Error())
+				return
+			}
+			if err != nil {
+				log.Printf("error authenticating request: %v", err)
+				writeError(w, http.StatusServiceUnavailable, "authentication is unavailable")
+				return
+			}
+
+			if principal != nil {
+				r = r.WithContext(auth.WithPrincipal(r.Context(), principal))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+type uploadSizeKey struct{}
+
+// limitUploadSize rejects streamed bodies larger than maxBytes, up front when
+// the client declares a Content-Length and otherwise once the limit is read.
+// The declared length is kept on the context for declaredUploadSize.
+func limitUploadSize(streamed *streamedBodies, maxBytes int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !streamed.match(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if r.ContentLength > 0 {
+				r = r.WithContext(context.WithValue(r.Context(), uploadSizeKey{}, r.ContentLength))
+			}
+			if maxBytes <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if r.ContentLength > maxBytes {
+				writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("upload exceeds the limit of %d bytes", maxBytes))
+				return
+			}
+
+			r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// declaredUploadSize returns the Content-Length of a streamed request, or 0
+// when the client didn't declare one. A multipart body is a little larger
+// than the file it carries, so this is an upper bound on the file's size.
+func declaredUploadSize(ctx context.Context) int64 {
+	size, _ := ctx.Value(uploadSizeKey{}).(int64)
+	return size
+}
+
+// streamedBodies finds the operations whose request bodies are streamed to
+// the handler. It goes by the spec rather than the client's Content-Type, so
+// labelling a JSON body as multipart can't get it past validation.
+type streamedBodies struct {
+	router routers.Router
+}
+
+func newStreamedBodies(swagger *openapi3.T) (*streamedBodies, error) {
+	router, err := gorillamux.NewRouter(swagger)
+	if err != nil {
+		return nil, err
+	}
+	return &streamedBodies{router: router}, nil
+}
+
+// match reports whether r is for an operation that declares a multipart or
+// binary body, sent as that media type.
+func (s *streamedBodies) match(r *http.Request) bool {
+	route, _, err := s.router.FindRoute(r)
+	if err != nil || route.Operation == nil || route.Operation.RequestBody == nil || route.Operation.RequestBody.Value == nil {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || !isStreamedMediaType(mediaType) {
+		return false
+	}
+	return route.Operation.RequestBody.Value.Content.Get(mediaType) != nil
+}
+
+func isStreamedMediaType(mediaType string) bool {
+	return strings.HasPrefix(mediaType, "multipart/") || mediaType == "application/octet-stream"
+}
+
+// writeError writes an error in the shape of the spec's error schema for
+// responses produced outside the generated handlers.
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(struct {
+		Code    int32  `json:"code"`
+		Message string `json:"message"`
+	}{
+		Code:    int32(status),
+		Message: message,
+	})
+}
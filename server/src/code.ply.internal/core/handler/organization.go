@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"context"
+
+	"code.ply.internal/core/models"
+	"code.ply.internal/core/utils"
+	serverapi "code.ply.internal/gen"
+)
+
+func (h *handler) PostV1PlyOrganization(ctx context.Context, request serverapi.PostV1PlyOrganizationRequestObject) (serverapi.PostV1PlyOrganizationResponseObject, error) {
+	organization, err := utils.ConvertRequestBody[models.Organization](request.Body)
+	if err != nil {
+		return serverapi.PostV1PlyOrganization500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	organizationId, err := h.mainController.CreateOrganization(ctx, organization)
+	if err != nil {
+		return serverapi.PostV1PlyOrganization500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return serverapi.PostV1PlyOrganization200JSONResponse{
+		OrganizationId: utils.StringPtr(organizationId),
+	}, nil
+}
+
+func (h *handler) GetV1PlyOrganizationList(ctx context.Context, request serverapi.GetV1PlyOrganizationListRequestObject) (serverapi.GetV1PlyOrganizationListResponseObject, error) {
+	organizations, err := h.mainController.ListOrganizations(ctx)
+	if err != nil {
+		return serverapi.GetV1PlyOrganizationList500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	parsedOrganizations := struct {
+		Organizations []*models.Organization `json:"organizations,omitempty"`
+	}{
+		Organizations: organizations,
+	}
+
+	httpOrganizations, err := utils.ConvertRequestBody[serverapi.GetV1PlyOrganizationList200JSONResponse](parsedOrganizations)
+	if err != nil {
+		return serverapi.GetV1PlyOrganizationList500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpOrganizations, nil
+}
+
+func (h *handler) GetV1PlyOrganizationOrganizationId(ctx context.Context, request serverapi.GetV1PlyOrganizationOrganizationIdRequestObject) (serverapi.GetV1PlyOrganizationOrganizationIdResponseObject, error) {
+	organization, err := h.mainController.ReadOrganization(ctx, request.OrganizationId)
+	if err != nil {
+		return serverapi.GetV1PlyOrganizationOrganizationId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpOrganization, err := utils.ConvertRequestBody[serverapi.GetV1PlyOrganizationOrganizationId200JSONResponse](organization)
+	if err != nil {
+		return serverapi.GetV1PlyOrganizationOrganizationId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpOrganization, nil
+}
+
+func (h *handler) PostV1PlyOrganizationOrganizationId(ctx context.Context, request serverapi.PostV1PlyOrganizationOrganizationIdRequestObject) (serverapi.PostV1PlyOrganizationOrganizationIdResponseObject, error) {
+	organization, err := utils.ConvertRequestBody[models.Organization](request.Body)
+	if err != nil {
+		return serverapi.PostV1PlyOrganizationOrganizationId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+	organization.OrganizationId = request.OrganizationId
+
+	err = h.mainController.UpdateOrganization(ctx, organization)
+	if err != nil {
+		return serverapi.PostV1PlyOrganizationOrganizationId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return serverapi.PostV1PlyOrganizationOrganizationId200JSONResponse{
+		Status: utils.StringPtr("Completed"),
+	}, nil
+}
+
+func (h *handler) GetV1PlyOrganizationOrganizationIdPractice(ctx context.Context, request serverapi.GetV1PlyOrganizationOrganizationIdPracticeRequestObject) (serverapi.GetV1PlyOrganizationOrganizationIdPracticeResponseObject, error) {
+	practices, err := h.mainController.ListOrganizationPractices(ctx, request.OrganizationId)
+	if err != nil {
+		return serverapi.GetV1PlyOrganizationOrganizationIdPractice500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	parsedPractices := struct {
+		Practices []*models.Practice `json:"practices,omitempty"`
+	}{
+		Practices: practices,
+	}
+
+	httpPractices, err := utils.ConvertRequestBody[serverapi.GetV1PlyOrganizationOrganizationIdPractice200JSONResponse](parsedPractices)
+	if err != nil {
+		return serverapi.GetV1PlyOrganizationOrganizationIdPractice500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpPractices, nil
+}
+
+func (h *handler) GetV1PlyOrganizationOrganizationIdProvider(ctx context.Context, request serverapi.GetV1PlyOrganizationOrganizationIdProviderRequestObject) (serverapi.GetV1PlyOrganizationOrganizationIdProviderResponseObject, error) {
+	providers, err := h.mainController.ListOrganizationProviders(ctx, request.OrganizationId)
+	if err != nil {
+		return serverapi.GetV1PlyOrganizationOrganizationIdProvider500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	parsedProviders := struct {
+		Providers []*models.Provider `json:"providers,omitempty"`
+	}{
+		Providers: providers,
+	}
+
+	httpProviders, err := utils.ConvertRequestBody[serverapi.GetV1PlyOrganizationOrganizationIdProvider200JSONResponse](parsedProviders)
+	if err != nil {
+		return serverapi.GetV1PlyOrganizationOrganizationIdProvider500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpProviders, nil
+}
+
+func (h *handler) GetV1PlyOrganizationOrganizationIdEnrollment(ctx context.Context, request serverapi.GetV1PlyOrganizationOrganizationIdEnrollmentRequestObject) (serverapi.GetV1PlyOrganizationOrganizationIdEnrollmentResponseObject, error) {
+	enrollments, err := h.mainController.ListOrganizationEnrollments(ctx, request.OrganizationId)
+	if err != nil {
+		return serverapi.GetV1PlyOrganizationOrganizationIdEnrollment500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	parsedEnrollments := struct {
+		Enrollments []*models.Enrollment `json:"enrollments,omitempty"`
+	}{
+		Enrollments: enrollments,
+	}
+
+	httpEnrollments, err := utils.ConvertRequestBody[serverapi.GetV1PlyOrganizationOrganizationIdEnrollment200JSONResponse](parsedEnrollments)
+	if err != nil {
+		return serverapi.GetV1PlyOrganizationOrganizationIdEnrollment500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpEnrollments, nil
+}
+
+func (h *handler) GetV1PlyOrganizationOrganizationIdTask(ctx context.Context, request serverapi.GetV1PlyOrganizationOrganizationIdTaskRequestObject) (serverapi.GetV1PlyOrganizationOrganizationIdTaskResponseObject, error) {
+	tasks, err := h.mainController.ListOrganizationOpenTasks(ctx, request.OrganizationId)
+	if err != nil {
+		return serverapi.GetV1PlyOrganizationOrganizationIdTask500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	parsedTasks := struct {
+		Tasks []*models.Task `json:"tasks,omitempty"`
+	}{
+		Tasks: tasks,
+	}
+
+	httpTasks, err := utils.ConvertRequestBody[serverapi.GetV1PlyOrganizationOrganizationIdTask200JSONResponse](parsedTasks)
+	if err != nil {
+		return serverapi.GetV1PlyOrganizationOrganizationIdTask500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpTasks, nil
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"code.ply.internal/core/controller"
+	serverapi "code.ply.internal/gen"
+)
+
+func (h *handler) GetV1PlyDocumentDocumentIdPreview(ctx context.Context, request serverapi.GetV1PlyDocumentDocumentIdPreviewRequestObject) (serverapi.GetV1PlyDocumentDocumentIdPreviewResponseObject, error) {
+	doc, err := h.mainController.GetDocument(ctx, request.DocumentId)
+	if err != nil {
+		return &serverapi.GetV1PlyDocumentDocumentIdPreview500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err := h.mainController.DocumentDownloadable(doc); err != nil {
+		return &serverapi.GetV1PlyDocumentDocumentIdPreview403JSONResponse{
+			Code:    int32(403),
+			Message: err.Error(),
+		}, nil
+	}
+
+	body, size, err := h.mainController.OpenDocumentPreview(ctx, doc)
+	if errors.Is(err, controller.ErrPreviewUnavailable) {
+		return &serverapi.GetV1PlyDocumentDocumentIdPreview404JSONResponse{
+			Code:    int32(404),
+			Message: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return &serverapi.GetV1PlyDocumentDocumentIdPreview500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	// Previews are of patient documents, so only the browser may keep one
+	return &serverapi.GetV1PlyDocumentDocumentIdPreview200ImagepngResponse{
+		Body: body,
+		Headers: serverapi.GetV1PlyDocumentDocumentIdPreview200ResponseHeaders{
+			CacheControl: "private, max-age=300",
+		},
+		ContentLength: size,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var errUnsatisfiableRange = errors.New("requested range not satisfiable")
+
+// parseRange resolves a Range header against a file of the given size and
+// returns the offset and length to serve. ok is false when the whole file
+// should be served instead, which is how multiple ranges and unknown units
+// are answered.
+func parseRange(header string, size int64) (offset int64, length int64, ok bool, err error) {
+	spec, found := strings.CutPrefix(strings.TrimSpace(header), "bytes=")
+	if !found || strings.Contains(spec, ",") {
+		return 0, 0, false, nil
+	}
+
+	startText, endText, found := strings.Cut(spec, "-")
+	if !found || size == 0 {
+		return 0, 0, false, errUnsatisfiableRange
+	}
+	startText = strings.TrimSpace(startText)
+	endText = strings.TrimSpace(endText)
+
+	var start, end int64
+	if startText == "" {
+		// "bytes=-n" asks for the last n bytes
+		suffix, err := strconv.ParseInt(endText, 10, 64)
+		if err != nil || suffix <= 0 {
+			return 0, 0, false, errUnsatisfiableRange
+		}
+		if suffix > size {
+			suffix = size
+		}
+		start, end = size-suffix, size-1
+	} else {
+		start, err = strconv.ParseInt(startText, 10, 64)
+		if err != nil || start < 0 || start >= size {
+			return 0, 0, false, errUnsatisfiableRange
+		}
+		end = size - 1
+		if endText != "" {
+			last, err := strconv.ParseInt(endText, 10, 64)
+			if err != nil || last < start {
+				return 0, 0, false, errUnsatisfiableRange
+			}
+			if last < end {
+				end = last
+			}
+		}
+	}
+
+	return start, end - start + 1, true, nil
+}
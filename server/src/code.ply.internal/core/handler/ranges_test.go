@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		header     string
+		size       int64
+		wantOffset int64
+		wantLength int64
+		wantOk     bool
+		wantErr    error
+	}{
+		{"no header", "", 100, 0, 0, false, nil},
+		{"first bytes", "bytes=0-9", 100, 0, 10, true, nil},
+		{"one byte", "bytes=0-0", 100, 0, 1, true, nil},
+		{"last byte", "bytes=99-99", 100, 99, 1, true, nil},
+		{"open ended", "bytes=10-", 100, 10, 90, true, nil},
+		{"end past the file", "bytes=90-200", 100, 90, 10, true, nil},
+		{"suffix", "bytes=-10", 100, 90, 10, true, nil},
+		{"suffix longer than the file", "bytes=-200", 100, 0, 100, true, nil},
+		{"spaces", " bytes= 1 - 2", 100, 1, 2, true, nil},
+		{"multiple ranges", "bytes=0-1,5-6", 100, 0, 0, false, nil},
+		{"unknown unit", "items=0-1", 100, 0, 0, false, nil},
+		{"start past the file", "bytes=100-", 100, 0, 0, false, errUnsatisfiableRange},
+		{"end before start", "bytes=5-4", 100, 0, 0, false, errUnsatisfiableRange},
+		{"empty suffix", "bytes=-0", 100, 0, 0, false, errUnsatisfiableRange},
+		{"no dash", "bytes=5", 100, 0, 0, false, errUnsatisfiableRange},
+		{"not a number", "bytes=a-b", 100, 0, 0, false, errUnsatisfiableRange},
+		{"negative start", "bytes=--5", 100, 0, 0, false, errUnsatisfiableRange},
+		{"empty file", "bytes=0-", 0, 0, 0, false, errUnsatisfiableRange},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			offset, length, ok, err := parseRange(test.header, test.size)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("parseRange(%q, %d) error = %v, want %v", test.header, test.size, err, test.wantErr)
+			}
+			if offset != test.wantOffset || length != test.wantLength || ok != test.wantOk {
+				t.Errorf("parseRange(%q, %d) = %d, %d, %v, want %d, %d, %v",
+					test.header, test.size, offset, length, ok, test.wantOffset, test.wantLength, test.wantOk)
+			}
+		})
+	}
+}
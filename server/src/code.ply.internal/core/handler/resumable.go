@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"code.ply.internal/core/controller"
+	"code.ply.internal/core/models"
+	"code.ply.internal/core/utils"
+	serverapi "code.ply.internal/gen"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+func (h *handler) PostV1PlyPracticePracticeIdUploadResumable(ctx context.Context, request serverapi.PostV1PlyPracticePracticeIdUploadResumableRequestObject) (serverapi.PostV1PlyPracticePracticeIdUploadResumableResponseObject, error) {
+	upload, err := utils.ConvertRequestBody[models.ResumableUpload](request.Body)
+	if err != nil {
+		return &serverapi.PostV1PlyPracticePracticeIdUploadResumable500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+	upload.PracticeId = request.PracticeId
+
+	upload, err = h.mainController.CreateResumableUpload(ctx, upload)
+	if err != nil {
+		var validationErr *controller.ValidationError
+		if errors.As(err, &validationErr) {
+			return &serverapi.PostV1PlyPracticePracticeIdUploadResumable400JSONResponse{
+				Code:    int32(400),
+				Message: validationErr.Error(),
+			}, nil
+		}
+		var quotaErr *controller.QuotaExceededError
+		if errors.As(err, &quotaErr) {
+			// An upload larger than the whole quota can never fit
+			if quotaErr.Size > quotaErr.Quota {
+				return &serverapi.PostV1PlyPracticePracticeIdUploadResumable413JSONResponse{
+					Code:    int32(413),
+					Message: quotaErr.Error(),
+				}, nil
+			}
+			return &serverapi.PostV1PlyPracticePracticeIdUploadResumable507JSONResponse{
+				Code:    int32(507),
+				Message: quotaErr.Error(),
+			}, nil
+		}
+		return &serverapi.PostV1PlyPracticePracticeIdUploadResumable500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpUpload, err := utils.ConvertRequestBody[serverapi.PostV1PlyPracticePracticeIdUploadResumable200JSONResponse](upload)
+	if err != nil {
+		return &serverapi.PostV1PlyPracticePracticeIdUploadResumable500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpUpload, nil
+}
+
+func (h *handler) GetV1PlyUploadUploadId(ctx context.Context, request serverapi.GetV1PlyUploadUploadIdRequestObject) (serverapi.GetV1PlyUploadUploadIdResponseObject, error) {
+	upload, err := h.mainController.ReadResumableUpload(ctx, request.UploadId)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return &serverapi.GetV1PlyUploadUploadId404JSONResponse{
+			Code:    int32(404),
+			Message: fmt.Sprintf("upload %s not found", request.UploadId),
+		}, nil
+	}
+	if err != nil {
+		return &serverapi.GetV1PlyUploadUploadId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpUpload, err := utils.ConvertRequestBody[serverapi.GetV1PlyUploadUploadId200JSONResponse](upload)
+	if err != nil {
+		return &serverapi.GetV1PlyUploadUploadId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpUpload, nil
+}
+
+func (h *handler) PatchV1PlyUploadUploadId(ctx context.Context, request serverapi.PatchV1PlyUploadUploadIdRequestObject) (serverapi.PatchV1PlyUploadUploadIdResponseObject, error) {
+	upload, err := h.mainController.AppendResumableUpload(ctx, request.UploadId, request.Params.UploadOffset, request.Body)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return &serverapi.PatchV1PlyUploadUploadId404JSONResponse{
+			Code:    int32(404),
+			Message: fmt.Sprintf("upload %s not found", request.UploadId),
+		}, nil
+	}
+	var conflict *controller.ConflictError
+	if errors.As(err, &conflict) {
+		return &serverapi.PatchV1PlyUploadUploadId409JSONResponse{
+			Code:       int32(409),
+			Message:    conflict.Message,
+			ExistingId: utils.StringPtr(conflict.ExistingId),
+		}, nil
+	}
+	var tooLarge *controller.UploadTooLargeError
+	if errors.As(err, &tooLarge) {
+		return &serverapi.PatchV1PlyUploadUploadId413JSONResponse{
+			Code:    int32(413),
+			Message: tooLarge.Error(),
+		}, nil
+	}
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return &serverapi.PatchV1PlyUploadUploadId413JSONResponse{
+			Code:    int32(413),
+			Message: fmt.Sprintf("chunk exceeds the limit of %d bytes", maxBytesErr.Limit),
+		}, nil
+	}
+	if err != nil {
+		return &serverapi.PatchV1PlyUploadUploadId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpUpload, err := utils.ConvertRequestBody[serverapi.PatchV1PlyUploadUploadId200JSONResponse](upload)
+	if err != nil {
+		return &serverapi.PatchV1PlyUploadUploadId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpUpload, nil
+}
+
+func (h *handler) PostV1PlyUploadUploadIdFinalize(ctx context.Context, request serverapi.PostV1PlyUploadUploadIdFinalizeRequestObject) (serverapi.PostV1PlyUploadUploadIdFinalizeResponseObject, error) {
+	documentId, err := h.mainController.FinalizeResumableUpload(ctx, request.UploadId)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return &serverapi.PostV1PlyUploadUploadIdFinalize404JSONResponse{
+			Code:    int32(404),
+			Message: fmt.Sprintf("upload %s not found", request.UploadId),
+		}, nil
+	}
+	var conflict *controller.ConflictError
+	if errors.As(err, &conflict) {
+		return &serverapi.PostV1PlyUploadUploadIdFinalize409JSONResponse{
+			Code:       int32(409),
+			Message:    conflict.Message,
+			ExistingId: utils.StringPtr(conflict.ExistingId),
+		}, nil
+	}
+	var validationErr *controller.ValidationError
+	if errors.As(err, &validationErr) {
+		return &serverapi.PostV1PlyUploadUploadIdFinalize400JSONResponse{
+			Code:    int32(400),
+			Message: validationErr.Error(),
+		}, nil
+	}
+	var unsupportedErr *controller.UnsupportedContentTypeError
+	if errors.As(err, &unsupportedErr) {
+		return &serverapi.PostV1PlyUploadUploadIdFinalize415JSONResponse{
+			Code:    int32(415),
+			Message: unsupportedErr.Error(),
+		}, nil
+	}
+	var quotaErr *controller.QuotaExceededError
+	if errors.As(err, &quotaErr) {
+		// An upload larger than the whole quota can never fit
+		if quotaErr.Size > quotaErr.Quota {
+			return &serverapi.PostV1PlyUploadUploadIdFinalize413JSONResponse{
+				Code:    int32(413),
+				Message: quotaErr.Error(),
+			}, nil
+		}
+		return &serverapi.PostV1PlyUploadUploadIdFinalize507JSONResponse{
+			Code:    int32(507),
+			Message: quotaErr.Error(),
+		}, nil
+	}
+	if err != nil {
+		return &serverapi.PostV1PlyUploadUploadIdFinalize500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &serverapi.PostV1PlyUploadUploadIdFinalize200JSONResponse{
+		DocumentId: utils.StringPtr(documentId),
+	}, nil
+}
+
+func (h *handler) DeleteV1PlyUploadUploadId(ctx context.Context, request serverapi.DeleteV1PlyUploadUploadIdRequestObject) (serverapi.DeleteV1PlyUploadUploadIdResponseObject, error) {
+	err := h.mainController.DeleteResumableUpload(ctx, request.UploadId)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return &serverapi.DeleteV1PlyUploadUploadId404JSONResponse{
+			Code:    int32(404),
+			Message: fmt.Sprintf("upload %s not found", request.UploadId),
+		}, nil
+	}
+	var conflict *controller.ConflictError
+	if errors.As(err, &conflict) {
+		return &serverapi.DeleteV1PlyUploadUploadId409JSONResponse{
+			Code:       int32(409),
+			Message:    conflict.Message,
+			ExistingId: utils.StringPtr(conflict.ExistingId),
+		}, nil
+	}
+	if err != nil {
+		return &serverapi.DeleteV1PlyUploadUploadId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+	return &serverapi.DeleteV1PlyUploadUploadId200JSONResponse{
+		Status: utils.StringPtr("Completed"),
+	}, nil
+}
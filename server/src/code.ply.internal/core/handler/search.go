@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"code.ply.internal/core/controller"
+	"code.ply.internal/core/models"
+	"code.ply.internal/core/utils"
+	serverapi "code.ply.internal/gen"
+)
+
+func (h *handler) GetV1PlyPracticePracticeIdDocumentSearch(ctx context.Context, request serverapi.GetV1PlyPracticePracticeIdDocumentSearchRequestObject) (serverapi.GetV1PlyPracticePracticeIdDocumentSearchResponseObject, error) {
+	limit := 0
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	results, err := h.mainController.SearchDocuments(ctx, request.PracticeId, request.Params.Q, limit)
+	if errors.Is(err, controller.ErrEmptySearch) {
+		return &serverapi.GetV1PlyPracticePracticeIdDocumentSearch400JSONResponse{
+			Code:    int32(400),
+			Message: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return &serverapi.GetV1PlyPracticePracticeIdDocumentSearch500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	parsedResults := struct {
+		Results []*models.DocumentSearchResult `json:"results"`
+	}{
+		Results: results,
+	}
+
+	httpResults, err := utils.ConvertRequestBody[serverapi.GetV1PlyPracticePracticeIdDocumentSearch200JSONResponse](parsedResults)
+	if err != nil {
+		return &serverapi.GetV1PlyPracticePracticeIdDocumentSearch500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpResults, nil
+}
@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.ply.internal/core/controller"
+	"code.ply.internal/core/models"
+	"code.ply.internal/core/utils"
+	serverapi "code.ply.internal/gen"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+func (h *handler) GetV1PlyDocumentDocumentIdLink(ctx context.Context, request serverapi.GetV1PlyDocumentDocumentIdLinkRequestObject) (serverapi.GetV1PlyDocumentDocumentIdLinkResponseObject, error) {
+	links, err := h.mainController.ListSharedLinks(ctx, request.DocumentId)
+	if err != nil {
+		return &serverapi.GetV1PlyDocumentDocumentIdLink500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	parsedLinks := struct {
+		Links []*models.SharedLink `json:"links"`
+	}{
+		Links: links,
+	}
+
+	httpLinks, err := utils.ConvertRequestBody[serverapi.GetV1PlyDocumentDocumentIdLink200JSONResponse](parsedLinks)
+	if err != nil {
+		return &serverapi.GetV1PlyDocumentDocumentIdLink500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpLinks, nil
+}
+
+func (h *handler) PostV1PlyDocumentDocumentIdLink(ctx context.Context, request serverapi.PostV1PlyDocumentDocumentIdLinkRequestObject) (serverapi.PostV1PlyDocumentDocumentIdLinkResponseObject, error) {
+	linkRequest := &models.SharedLinkRequest{}
+	if request.Body != nil {
+		var err error
+		linkRequest, err = utils.ConvertRequestBody[models.SharedLinkRequest](request.Body)
+		if err != nil {
+			return &serverapi.PostV1PlyDocumentDocumentIdLink500JSONResponse{
+				Code:    int32(500),
+				Message: err.Error(),
+			}, nil
+		}
+	}
+
+	link, err := h.mainController.CreateSharedLink(ctx, request.DocumentId, linkRequest)
+	if err != nil {
+		var validationErr *controller.ValidationError
+		if errors.As(err, &validationErr) {
+			return &serverapi.PostV1PlyDocumentDocumentIdLink400JSONResponse{
+				Code:    int32(400),
+				Message: validationErr.Error(),
+			}, nil
+		}
+		var scanErr *controller.ScanError
+		if errors.As(err, &scanErr) {
+			return &serverapi.PostV1PlyDocumentDocumentIdLink403JSONResponse{
+				Code:    int32(403),
+				Message: scanErr.Error(),
+			}, nil
+		}
+		if errors.Is(err, mongodriver.ErrNoDocuments) {
+			return &serverapi.PostV1PlyDocumentDocumentIdLink404JSONResponse{
+				Code:    int32(404),
+				Message: "document not found",
+			}, nil
+		}
+		if errors.Is(err, controller.ErrSharingDisabled) {
+			return &serverapi.PostV1PlyDocumentDocumentIdLink503JSONResponse{
+				Code:    int32(503),
+				Message: err.Error(),
+			}, nil
+		}
+		return &serverapi.PostV1PlyDocumentDocumentIdLink500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpLink, err := utils.ConvertRequestBody[serverapi.PostV1PlyDocumentDocumentIdLink201JSONResponse](link)
+	if err != nil {
+		return &serverapi.PostV1PlyDocumentDocumentIdLink500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpLink, nil
+}
+
+func (h *handler) DeleteV1PlyDocumentDocumentIdLinkLinkId(ctx context.Context, request serverapi.DeleteV1PlyDocumentDocumentIdLinkLinkIdRequestObject) (serverapi.DeleteV1PlyDocumentDocumentIdLinkLinkIdResponseObject, error) {
+	err := h.mainController.RevokeSharedLink(ctx, request.DocumentId, request.LinkId)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return &serverapi.DeleteV1PlyDocumentDocumentIdLinkLinkId404JSONResponse{
+			Code:    int32(404),
+			Message: "link not found",
+		}, nil
+	}
+	if err != nil {
+		return &serverapi.DeleteV1PlyDocumentDocumentIdLinkLinkId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+	return &serverapi.DeleteV1PlyDocumentDocumentIdLinkLinkId200JSONResponse{
+		Status: utils.StringPtr("Completed"),
+	}, nil
+}
+
+func (h *handler) GetV1PlySharedLinkId(ctx context.Context, request serverapi.GetV1PlySharedLinkIdRequestObject) (serverapi.GetV1PlySharedLinkIdResponseObject, error) {
+	doc, link, err := h.mainController.OpenSharedLink(ctx, request.LinkId, request.Params.Expires, request.Params.Signature)
+	if err != nil {
+		if errors.Is(err, controller.ErrInvalidLinkSignature) {
+			return &serverapi.GetV1PlySharedLinkId403JSONResponse{
+				Code:    int32(403),
+				Message: err.Error(),
+			}, nil
+		}
+		var linkErr *controller.SharedLinkError
+		if errors.As(err, &linkErr) {
+			return &serverapi.GetV1PlySharedLinkId410JSONResponse{
+				Code:    int32(410),
+				Message: linkErr.Error(),
+			}, nil
+		}
+		var scanErr *controller.ScanError
+		if errors.As(err, &scanErr) {
+			return &serverapi.GetV1PlySharedLinkId403JSONResponse{
+				Code:    int32(403),
+				Message: scanErr.Error(),
+			}, nil
+		}
+		if errors.Is(err, controller.ErrSharingDisabled) {
+			return &serverapi.GetV1PlySharedLinkId503JSONResponse{
+				Code:    int32(503),
+				Message: err.Error(),
+			}, nil
+		}
+		return &serverapi.GetV1PlySharedLinkId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	// A single use link serves one whole download, so ranges are ignored
+	requestedRange := request.Params.Range
+	acceptRanges := "bytes"
+	if link.SingleUse {
+		requestedRange = nil
+		acceptRanges = "none"
+	}
+
+	download, err := h.openDocumentDownload(ctx, doc, requestedRange)
+	var scanErr *controller.ScanError
+	if errors.As(err, &scanErr) {
+		return &serverapi.GetV1PlySharedLinkId403JSONResponse{
+			Code:    int32(403),
+			Message: scanErr.Error(),
+		}, nil
+	}
+	var rangeErr *rangeError
+	if errors.As(err, &rangeErr) {
+		response := serverapi.GetV1PlySharedLinkId416JSONResponse{
+			Headers: serverapi.GetV1PlySharedLinkId416ResponseHeaders{
+				ContentRange: fmt.Sprintf("bytes */%d", rangeErr.size),
+			},
+		}
+		response.Body.Code = int32(416)
+		response.Body.Message = rangeErr.Error()
+		return response, nil
+	}
+	if err != nil {
+		return &serverapi.GetV1PlySharedLinkId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	// Only a download that could be opened uses the link up
+	if err := h.mainController.ClaimSharedLink(ctx, link); err != nil {
+		download.body.Close()
+		var linkErr *controller.SharedLinkError
+		if errors.As(err, &linkErr) {
+			return &serverapi.GetV1PlySharedLinkId410JSONResponse{
+				Code:    int32(410),
+				Message: linkErr.Error(),
+			}, nil
+		}
+		return &serverapi.GetV1PlySharedLinkId500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	if download.partial {
+		return &serverapi.GetV1PlySharedLinkId206AsteriskResponse{
+			Body: download.body,
+			Headers: serverapi.GetV1PlySharedLinkId206ResponseHeaders{
+				AcceptRanges:        acceptRanges,
+				ContentDisposition:  download.disposition,
+				ContentRange:        download.contentRange(),
+				XContentTypeOptions: "nosniff",
+			},
+			ContentLength: download.length,
+			ContentType:   download.contentType,
+		}, nil
+	}
+
+	return &serverapi.GetV1PlySharedLinkId200AsteriskResponse{
+		Body: download.body,
+		Headers: serverapi.GetV1PlySharedLinkId200ResponseHeaders{
+			AcceptRanges:        acceptRanges,
+			ContentDisposition:  download.disposition,
+			XContentTypeOptions: "nosniff",
+		},
+		ContentLength: download.size,
+		ContentType:   download.contentType,
+	}, nil
+}
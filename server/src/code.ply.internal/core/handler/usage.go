@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"context"
+
+	"code.ply.internal/core/utils"
+	serverapi "code.ply.internal/gen"
+)
+
+func (h *handler) GetV1PlyPracticePracticeIdUsage(ctx context.Context, request serverapi.GetV1PlyPracticePracticeIdUsageRequestObject) (serverapi.GetV1PlyPracticePracticeIdUsageResponseObject, error) {
+	usage, err := h.mainController.GetStorageUsage(ctx, request.PracticeId)
+	if err != nil {
+		return &serverapi.GetV1PlyPracticePracticeIdUsage500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpUsage, err := utils.ConvertRequestBody[serverapi.GetV1PlyPracticePracticeIdUsage200JSONResponse](usage)
+	if err != nil {
+		return &serverapi.GetV1PlyPracticePracticeIdUsage500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpUsage, nil
+}
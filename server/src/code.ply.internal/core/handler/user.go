@@ -0,0 +1,253 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"code.ply.internal/core/controller"
+	"code.ply.internal/core/models"
+	"code.ply.internal/core/utils"
+	serverapi "code.ply.internal/gen"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+func (h *handler) PostV1PlyLogin(ctx context.Context, request serverapi.PostV1PlyLoginRequestObject) (serverapi.PostV1PlyLoginResponseObject, error) {
+	session, err := h.mainController.Login(ctx, request.Body.Email, request.Body.Password)
+	if errors.Is(err, controller.ErrInvalidLogin) {
+		return serverapi.PostV1PlyLogin401JSONResponse{
+			Code:    int32(401),
+			Message: err.Error(),
+		}, nil
+	}
+	if errors.Is(err, controller.ErrAccountsDisabled) {
+		return serverapi.PostV1PlyLogin503JSONResponse{
+			Code:    int32(503),
+			Message: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return serverapi.PostV1PlyLogin500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpSession, err := utils.ConvertRequestBody[serverapi.PostV1PlyLogin200JSONResponse](session)
+	if err != nil {
+		return serverapi.PostV1PlyLogin500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpSession, nil
+}
+
+func (h *handler) GetV1PlyMe(ctx context.Context, request serverapi.GetV1PlyMeRequestObject) (serverapi.GetV1PlyMeResponseObject, error) {
+	me, err := h.mainController.ReadCurrentUser(ctx)
+	if errors.Is(err, controller.ErrNotAuthenticated) {
+		return serverapi.GetV1PlyMe401JSONResponse{
+			Code:    int32(401),
+			Message: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return serverapi.GetV1PlyMe500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpMe, err := utils.ConvertRequestBody[serverapi.GetV1PlyMe200JSONResponse](me)
+	if err != nil {
+		return serverapi.GetV1PlyMe500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpMe, nil
+}
+
+func (h *handler) PostV1PlyMePassword(ctx context.Context, request serverapi.PostV1PlyMePasswordRequestObject) (serverapi.PostV1PlyMePasswordResponseObject, error) {
+	session, err := h.mainController.ChangePassword(ctx, request.Body.CurrentPassword, request.Body.NewPassword)
+	var validationErr *controller.ValidationError
+	if errors.As(err, &validationErr) {
+		return serverapi.PostV1PlyMePassword400JSONResponse{
+			Code:    int32(400),
+			Message: validationErr.Error(),
+		}, nil
+	}
+	if errors.Is(err, controller.ErrNotAuthenticated) {
+		return serverapi.PostV1PlyMePassword401JSONResponse{
+			Code:    int32(401),
+			Message: "only users who logged in with a password can change it",
+		}, nil
+	}
+	if err != nil {
+		return serverapi.PostV1PlyMePassword500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpSession, err := utils.ConvertRequestBody[serverapi.PostV1PlyMePassword200JSONResponse](session)
+	if err != nil {
+		return serverapi.PostV1PlyMePassword500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpSession, nil
+}
+
+func (h *handler) PostV1PlyUserInvitation(ctx context.Context, request serverapi.PostV1PlyUserInvitationRequestObject) (serverapi.PostV1PlyUserInvitationResponseObject, error) {
+	invitation, err := utils.ConvertRequestBody[models.Invitation](request.Body)
+	if err != nil {
+		return serverapi.PostV1PlyUserInvitation500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	user, err := h.mainController.InviteUser(ctx, invitation)
+	var validationErr *controller.ValidationError
+	if errors.As(err, &validationErr) {
+		return serverapi.PostV1PlyUserInvitation400JSONResponse{
+			Code:    int32(400),
+			Message: validationErr.Error(),
+		}, nil
+	}
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return serverapi.PostV1PlyUserInvitation400JSONResponse{
+			Code:    int32(400),
+			Message: "practice or organization not found",
+		}, nil
+	}
+	var conflict *controller.ConflictError
+	if errors.As(err, &conflict) {
+		return serverapi.PostV1PlyUserInvitation409JSONResponse{
+			Code:       int32(409),
+			Message:    conflict.Message,
+			ExistingId: utils.StringPtr(conflict.ExistingId),
+		}, nil
+	}
+	if errors.Is(err, controller.ErrAccountsDisabled) || errors.Is(err, controller.ErrMailDisabled) {
+		return serverapi.PostV1PlyUserInvitation503JSONResponse{
+			Code:    int32(503),
+			Message: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return serverapi.PostV1PlyUserInvitation500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpUser, err := utils.ConvertRequestBody[serverapi.PostV1PlyUserInvitation200JSONResponse](user)
+	if err != nil {
+		return serverapi.PostV1PlyUserInvitation500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpUser, nil
+}
+
+func (h *handler) PostV1PlyUserInvitationAccept(ctx context.Context, request serverapi.PostV1PlyUserInvitationAcceptRequestObject) (serverapi.PostV1PlyUserInvitationAcceptResponseObject, error) {
+	name := ""
+	if request.Body.Name != nil {
+		name = *request.Body.Name
+	}
+
+	session, err := h.mainController.AcceptInvitation(ctx, request.Body.Token, request.Body.Password, name)
+	var validationErr *controller.ValidationError
+	if errors.As(err, &validationErr) {
+		return serverapi.PostV1PlyUserInvitationAccept400JSONResponse{
+			Code:    int32(400),
+			Message: validationErr.Error(),
+		}, nil
+	}
+	if errors.Is(err, controller.ErrInvalidUserToken) {
+		return serverapi.PostV1PlyUserInvitationAccept400JSONResponse{
+			Code:    int32(400),
+			Message: err.Error(),
+		}, nil
+	}
+	if errors.Is(err, controller.ErrAccountsDisabled) {
+		return serverapi.PostV1PlyUserInvitationAccept503JSONResponse{
+			Code:    int32(503),
+			Message: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return serverapi.PostV1PlyUserInvitationAccept500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	httpSession, err := utils.ConvertRequestBody[serverapi.PostV1PlyUserInvitationAccept200JSONResponse](session)
+	if err != nil {
+		return serverapi.PostV1PlyUserInvitationAccept500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpSession, nil
+}
+
+func (h *handler) PostV1PlyPasswordReset(ctx context.Context, request serverapi.PostV1PlyPasswordResetRequestObject) (serverapi.PostV1PlyPasswordResetResponseObject, error) {
+	err := h.mainController.RequestPasswordReset(ctx, request.Body.Email)
+	if errors.Is(err, controller.ErrAccountsDisabled) || errors.Is(err, controller.ErrMailDisabled) {
+		return serverapi.PostV1PlyPasswordReset503JSONResponse{
+			Code:    int32(503),
+			Message: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return serverapi.PostV1PlyPasswordReset500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+	return serverapi.PostV1PlyPasswordReset200JSONResponse{
+		Status: utils.StringPtr("Completed"),
+	}, nil
+}
+
+func (h *handler) PostV1PlyPasswordResetConfirm(ctx context.Context, request serverapi.PostV1PlyPasswordResetConfirmRequestObject) (serverapi.PostV1PlyPasswordResetConfirmResponseObject, error) {
+	err := h.mainController.ResetPassword(ctx, request.Body.Token, request.Body.Password)
+	var validationErr *controller.ValidationError
+	if errors.As(err, &validationErr) {
+		return serverapi.PostV1PlyPasswordResetConfirm400JSONResponse{
+			Code:    int32(400),
+			Message: validationErr.Error(),
+		}, nil
+	}
+	if errors.Is(err, controller.ErrInvalidUserToken) {
+		return serverapi.PostV1PlyPasswordResetConfirm400JSONResponse{
+			Code:    int32(400),
+			Message: err.Error(),
+		}, nil
+	}
+	if errors.Is(err, controller.ErrAccountsDisabled) {
+		return serverapi.PostV1PlyPasswordResetConfirm503JSONResponse{
+			Code:    int32(503),
+			Message: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return serverapi.PostV1PlyPasswordResetConfirm500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+	return serverapi.PostV1PlyPasswordResetConfirm200JSONResponse{
+		Status: utils.StringPtr("Completed"),
+	}, nil
+}
@@ -0,0 +1,253 @@
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"code.ply.internal/core/controller"
+	"code.ply.internal/core/models"
+	"code.ply.internal/core/utils"
+	serverapi "code.ply.internal/gen"
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+func (h *handler) PostV1PlyDocumentDocumentIdVersion(ctx context.Context, request serverapi.PostV1PlyDocumentDocumentIdVersionRequestObject) (serverapi.PostV1PlyDocumentDocumentIdVersionResponseObject, error) {
+	// As with new documents, the file name has to arrive before the file
+	var fileName string
+	var version int
+
+	for {
+		part, err := request.Body.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return versionUploadErrorResponse(err), nil
+		}
+
+		switch part.FormName() {
+		case "file":
+			if version != 0 {
+				continue
+			}
+			if fileName == "" {
+				fileName = part.FileName()
+			}
+			if fileName == "" {
+				return &serverapi.PostV1PlyDocumentDocumentIdVersion500JSONResponse{
+					Code:    int32(500),
+					Message: "fileName is required",
+				}, nil
+			}
+
+			file := bufio.NewReader(part)
+			if _, err := file.Peek(1); err != nil {
+				if err != io.EOF {
+					return versionUploadErrorResponse(err), nil
+				}
+				return &serverapi.PostV1PlyDocumentDocumentIdVersion500JSONResponse{
+					Code:    int32(500),
+					Message: "file is required",
+				}, nil
+			}
+
+			version, err = h.mainController.UploadDocumentVersion(ctx, request.DocumentId, fileName, declaredUploadSize(ctx), file)
+			if err != nil {
+				return versionUploadErrorResponse(err), nil
+			}
+		case "fileName":
+			buf := new(bytes.Buffer)
+			if _, err := buf.ReadFrom(part); err != nil {
+				return versionUploadErrorResponse(err), nil
+			}
+			fileName = buf.String()
+		}
+	}
+
+	if version == 0 {
+		return &serverapi.PostV1PlyDocumentDocumentIdVersion500JSONResponse{
+			Code:    int32(500),
+			Message: "file is required",
+		}, nil
+	}
+
+	return &serverapi.PostV1PlyDocumentDocumentIdVersion200JSONResponse{
+		DocumentId: utils.StringPtr(request.DocumentId),
+		Version:    &version,
+	}, nil
+}
+
+func versionUploadErrorResponse(err error) serverapi.PostV1PlyDocumentDocumentIdVersionResponseObject {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return &serverapi.PostV1PlyDocumentDocumentIdVersion413JSONResponse{
+			Code:    int32(413),
+			Message: fmt.Sprintf("upload exceeds the limit of %d bytes", maxBytesErr.Limit),
+		}
+	}
+	var unsupportedErr *controller.UnsupportedContentTypeError
+	if errors.As(err, &unsupportedErr) {
+		return &serverapi.PostV1PlyDocumentDocumentIdVersion415JSONResponse{
+			Code:    int32(415),
+			Message: unsupportedErr.Error(),
+		}
+	}
+	var conflict *controller.ConflictError
+	if errors.As(err, &conflict) {
+		return &serverapi.PostV1PlyDocumentDocumentIdVersion409JSONResponse{
+			Code:       int32(409),
+			Message:    conflict.Message,
+			ExistingId: utils.StringPtr(conflict.ExistingId),
+		}
+	}
+	var quotaErr *controller.QuotaExceededError
+	if errors.As(err, &quotaErr) {
+		// An upload larger than the whole quota can never fit
+		if quotaErr.Size > quotaErr.Quota {
+			return &serverapi.PostV1PlyDocumentDocumentIdVersion413JSONResponse{
+				Code:    int32(413),
+				Message: quotaErr.Error(),
+			}
+		}
+		return &serverapi.PostV1PlyDocumentDocumentIdVersion507JSONResponse{
+			Code:    int32(507),
+			Message: quotaErr.Error(),
+		}
+	}
+	return &serverapi.PostV1PlyDocumentDocumentIdVersion500JSONResponse{
+		Code:    int32(500),
+		Message: err.Error(),
+	}
+}
+
+func (h *handler) GetV1PlyDocumentDocumentIdVersion(ctx context.Context, request serverapi.GetV1PlyDocumentDocumentIdVersionRequestObject) (serverapi.GetV1PlyDocumentDocumentIdVersionResponseObject, error) {
+	doc, err := h.mainController.GetDocument(ctx, request.DocumentId)
+	if err != nil {
+		return &serverapi.GetV1PlyDocumentDocumentIdVersion500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	versions, err := h.mainController.ListDocumentVersions(ctx, request.DocumentId)
+	if err != nil {
+		return &serverapi.GetV1PlyDocumentDocumentIdVersion500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	currentVersion := doc.CurrentVersion
+	if currentVersion == 0 {
+		currentVersion = 1
+	}
+
+	parsedVersions := struct {
+		CurrentVersion int                       `json:"currentVersion,omitempty"`
+		Versions       []*models.DocumentVersion `json:"versions,omitempty"`
+	}{
+		CurrentVersion: currentVersion,
+		Versions:       versions,
+	}
+
+	httpVersions, err := utils.ConvertRequestBody[serverapi.GetV1PlyDocumentDocumentIdVersion200JSONResponse](parsedVersions)
+	if err != nil {
+		return &serverapi.GetV1PlyDocumentDocumentIdVersion500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return httpVersions, nil
+}
+
+func (h *handler) GetV1PlyDocumentDocumentIdVersionVersion(ctx context.Context, request serverapi.GetV1PlyDocumentDocumentIdVersionVersionRequestObject) (serverapi.GetV1PlyDocumentDocumentIdVersionVersionResponseObject, error) {
+	doc, err := h.mainController.GetDocumentVersion(ctx, request.DocumentId, request.Version)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return &serverapi.GetV1PlyDocumentDocumentIdVersionVersion404JSONResponse{
+			Code:    int32(404),
+			Message: fmt.Sprintf("version %d of document %s not found", request.Version, request.DocumentId),
+		}, nil
+	}
+	if err != nil {
+		return &serverapi.GetV1PlyDocumentDocumentIdVersionVersion500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	download, err := h.openDocumentDownload(ctx, doc, request.Params.Range)
+	var scanErr *controller.ScanError
+	if errors.As(err, &scanErr) {
+		return &serverapi.GetV1PlyDocumentDocumentIdVersionVersion403JSONResponse{
+			Code:    int32(403),
+			Message: scanErr.Error(),
+		}, nil
+	}
+	var rangeErr *rangeError
+	if errors.As(err, &rangeErr) {
+		response := serverapi.GetV1PlyDocumentDocumentIdVersionVersion416JSONResponse{
+			Headers: serverapi.GetV1PlyDocumentDocumentIdVersionVersion416ResponseHeaders{
+				ContentRange: fmt.Sprintf("bytes */%d", rangeErr.size),
+			},
+		}
+		response.Body.Code = int32(416)
+		response.Body.Message = rangeErr.Error()
+		return response, nil
+	}
+	if err != nil {
+		return &serverapi.GetV1PlyDocumentDocumentIdVersionVersion500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+
+	if download.partial {
+		return &serverapi.GetV1PlyDocumentDocumentIdVersionVersion206AsteriskResponse{
+			Body: download.body,
+			Headers: serverapi.GetV1PlyDocumentDocumentIdVersionVersion206ResponseHeaders{
+				AcceptRanges:        "bytes",
+				ContentDisposition:  download.disposition,
+				ContentRange:        download.contentRange(),
+				XContentTypeOptions: "nosniff",
+			},
+			ContentLength: download.length,
+			ContentType:   download.contentType,
+		}, nil
+	}
+
+	return &serverapi.GetV1PlyDocumentDocumentIdVersionVersion200AsteriskResponse{
+		Body: download.body,
+		Headers: serverapi.GetV1PlyDocumentDocumentIdVersionVersion200ResponseHeaders{
+			AcceptRanges:        "bytes",
+			ContentDisposition:  download.disposition,
+			XContentTypeOptions: "nosniff",
+		},
+		ContentLength: download.size,
+		ContentType:   download.contentType,
+	}, nil
+}
+
+func (h *handler) PostV1PlyDocumentDocumentIdVersionVersionCurrent(ctx context.Context, request serverapi.PostV1PlyDocumentDocumentIdVersionVersionCurrentRequestObject) (serverapi.PostV1PlyDocumentDocumentIdVersionVersionCurrentResponseObject, error) {
+	err := h.mainController.SetCurrentDocumentVersion(ctx, request.DocumentId, request.Version)
+	if errors.Is(err, mongodriver.ErrNoDocuments) {
+		return &serverapi.PostV1PlyDocumentDocumentIdVersionVersionCurrent404JSONResponse{
+			Code:    int32(404),
+			Message: fmt.Sprintf("version %d of document %s not found", request.Version, request.DocumentId),
+		}, nil
+	}
+	if err != nil {
+		return &serverapi.PostV1PlyDocumentDocumentIdVersionVersionCurrent500JSONResponse{
+			Code:    int32(500),
+			Message: err.Error(),
+		}, nil
+	}
+	return &serverapi.PostV1PlyDocumentDocumentIdVersionVersionCurrent200JSONResponse{
+		Status: utils.StringPtr("Completed"),
+	}, nil
+}
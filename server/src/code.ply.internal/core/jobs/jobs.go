@@ -0,0 +1,143 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	cfg "code.ply.internal/core/config"
+	"code.ply.internal/core/controller"
+	"code.ply.internal/core/models"
+)
+
+type (
+	Scheduler interface {
+		Start(context.Context)
+	}
+
+	scheduler struct {
+		mainController controller.Controller
+		jobs           []job
+	}
+
+	job struct {
+		name     string
+		interval time.Duration
+		run      func(context.Context) error
+	}
+
+	Params struct {
+		Controller controller.Controller
+	}
+)
+
+func New(ctx context.Context, p Params) Scheduler {
+	config := cfg.GetConfigFromContext(ctx)
+
+	s := &scheduler{
+		mainController: p.Controller,
+	}
+	s.jobs = []job{
+		{name: "verify documents", interval: config.Jobs.VerifyDocumentsInterval, run: s.verifyDocuments},
+		{name: "extract document fields", interval: config.Jobs.ExtractionInterval, run: s.extractDocuments},
+		{name: "clean up resumable uploads", interval: config.Jobs.ResumableUploadInterval, run: s.cleanupResumableUploads},
+		{name: "scan documents", interval: config.Jobs.ScanInterval, run: s.scanDocuments},
+		{name: "reconcile storage", interval: config.Jobs.ReconcileInterval, run: s.reconcileStorage},
+		{name: "generate document previews", interval: config.Jobs.PreviewInterval, run: s.generatePreviews},
+		{name: "index document text", interval: config.Jobs.IndexInterval, run: s.indexDocuments},
+	}
+	return s
+}
+
+// Start runs each enabled job on its own interval until ctx is cancelled.
+func (s *scheduler) Start(ctx context.Context) {
+	for _, j := range s.jobs {
+		if j.interval <= 0 {
+			continue
+		}
+		go s.loop(ctx, j)
+	}
+}
+
+func (s *scheduler) loop(ctx context.Context, j job) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := j.run(ctx); err != nil {
+				log.Printf("job %s failed: %s", j.name, err.Error())
+			}
+		}
+	}
+}
+
+func (s *scheduler) verifyDocuments(ctx context.Context) error {
+	report, err := s.mainController.VerifyDocuments(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	for _, problem := range report.Problems {
+		log.Printf("document %s (practice %s) is %s: %s", problem.DocumentId, problem.PracticeId, problem.Status, problem.Message)
+	}
+	log.Printf("verified %d documents: %d problems, %d checksums recorded", report.Checked, len(report.Problems), report.Recorded)
+	return nil
+}
+
+func (s *scheduler) extractDocuments(ctx context.Context) error {
+	processed, err := s.mainController.ProcessExtractionJobs(ctx)
+	if processed > 0 {
+		log.Printf("ran %d extraction jobs", processed)
+	}
+	return err
+}
+
+func (s *scheduler) cleanupResumableUploads(ctx context.Context) error {
+	deleted, err := s.mainController.CleanupResumableUploads(ctx)
+	if deleted > 0 {
+		log.Printf("deleted %d expired resumable uploads", deleted)
+	}
+	return err
+}
+
+func (s *scheduler) scanDocuments(ctx context.Context) error {
+	scanned, err := s.mainController.ScanDocuments(ctx)
+	if scanned > 0 {
+		log.Printf("scanned %d document files", scanned)
+	}
+	return err
+}
+
+// reconcileStorage only reports problems; fixing them is left to an admin.
+func (s *scheduler) reconcileStorage(ctx context.Context) error {
+	report, err := s.mainController.ReconcileStorage(ctx, &models.ReconciliationOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, problem := range report.Problems {
+		log.Printf("storage %s: %s (document %s, practice %s)", problem.Kind, problem.Key, problem.DocumentId, problem.PracticeId)
+	}
+	log.Printf("reconciled %d files with %d records: %d problems", report.Files, report.Records, len(report.Problems))
+	return nil
+}
+
+func (s *scheduler) generatePreviews(ctx context.Context) error {
+	generated, err := s.mainController.GeneratePreviews(ctx)
+	if generated > 0 {
+		log.Printf("generated %d document previews", generated)
+	}
+	return err
+}
+
+func (s *scheduler) indexDocuments(ctx context.Context) error {
+	indexed, err := s.mainController.IndexDocuments(ctx)
+	if indexed > 0 {
+		log.Printf("indexed the text of %d documents", indexed)
+	}
+	return err
+}
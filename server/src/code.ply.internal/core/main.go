@@ -2,21 +2,63 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"os"
 
+	"code.ply.internal/core/auth"
 	"code.ply.internal/core/config"
+	"code.ply.internal/core/controller"
 	"code.ply.internal/core/handler"
+	"code.ply.internal/core/jobs"
 )
 
 func main() {
+	// generate-api-key prints a new API key for a calling service and the
+	// hash to configure for it, and exits
+	if len(os.Args) > 1 && os.Args[1] == "generate-api-key" {
+		key, hash, err := auth.GenerateApiKey()
+		if err != nil {
+			log.Fatal(err.Error())
+			return
+		}
+		fmt.Printf("key:  %s\nhash: %s\n", key, hash)
+		return
+	}
+
 	ctx := context.Background()
 
 	ctx, _ = config.LoadConfig(ctx)
 
-	gatewayHandler, err := handler.New(ctx, handler.Params{})
+	mainController, err := controller.New(ctx, controller.Params{})
+	if err != nil {
+		log.Fatal(err.Error())
+		return
+	}
+
+	if err := mainController.EnsureIndexes(ctx); err != nil {
+		log.Fatal(err.Error())
+		return
+	}
+
+	// rotate-keys rewraps document data keys with the active master key
+	// and exits
+	if len(os.Args) > 1 && os.Args[1] == "rotate-keys" {
+		rotated, err := mainController.RotateDocumentKeys(ctx)
+		if err != nil {
+			log.Fatal(err.Error())
+			return
+		}
+		log.Printf("rewrapped %d document keys", rotated)
+		return
+	}
+
+	jobs.New(ctx, jobs.Params{Controller: mainController}).Start(ctx)
+
+	gatewayHandler, err := handler.New(ctx, handler.Params{Controller: mainController})
 	if err != nil {
 		log.Fatal(err.Error())
 		return
 	}
-	handler.StartGatewayService(ctx, gatewayHandler)
+	handler.StartGatewayService(ctx, gatewayHandler, mainController)
 }
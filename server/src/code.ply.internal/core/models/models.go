@@ -1,5 +1,29 @@
 package models
 
+// DateLayout is the layout of every calendar date stored on a model.
+const DateLayout = "2006-01-02"
+
+// Enrollment statuses. Denied, withdrawn and terminated enrollments are no
+// longer active with the payer.
+const (
+	EnrollmentStatusPending    = "pending"
+	EnrollmentStatusSubmitted  = "submitted"
+	EnrollmentStatusApproved   = "approved"
+	EnrollmentStatusDenied     = "denied"
+	EnrollmentStatusWithdrawn  = "withdrawn"
+	EnrollmentStatusTerminated = "terminated"
+)
+
+// Enrollment kinds. An individual enrollment reassigns its benefits to the
+// group enrollment it references.
+const (
+	EnrollmentKindGroup      = "group"
+	EnrollmentKindIndividual = "individual"
+)
+
+// TaskStatusCompleted marks a task that no longer needs attention.
+const TaskStatusCompleted = "Completed"
+
 type Task struct {
 	TaskId     string `json:"taskId,omitempty"`
 	PracticeId string `json:"practiceId,omitempty"`
@@ -14,6 +38,15 @@ type Provider struct {
 	Ssn        string `json:"ssn,omitempty"`
 }
 
+type Affiliation struct {
+	AffiliationId string `json:"affiliationId,omitempty"`
+	ProviderId    string `json:"providerId,omitempty"`
+	PracticeId    string `json:"practiceId,omitempty"`
+	Role          string `json:"role,omitempty"`
+	StartDate     string `json:"startDate,omitempty"`
+	EndDate       string `json:"endDate,omitempty"`
+}
+
 type Location struct {
 	LocationId string `json:"locationId,omitempty"`
 	PracticeId string `json:"practiceId,omitempty"`
@@ -29,13 +62,38 @@ type Enrollment struct {
 	LocationId   string `json:"locationId,omitempty"`
 	Type         string `json:"type,omitempty"`
 	ProviderId   string `json:"providerId,omitempty"`
+
+	SubmittedDate       string `json:"submittedDate,omitempty"`
+	EffectiveDate       string `json:"effectiveDate,omitempty"`
+	ApprovalDate        string `json:"approvalDate,omitempty"`
+	PayerProviderNumber string `json:"payerProviderNumber,omitempty"`
+	Ptan                string `json:"ptan,omitempty"`
+	RevalidationDueDate string `json:"revalidationDueDate,omitempty"`
+
+	Kind              string `json:"kind,omitempty"`
+	GroupEnrollmentId string `json:"groupEnrollmentId,omitempty"`
+
+	// IdentityKey is the normalized identity of an active enrollment, which
+	// no other active enrollment may share. Inactive ones have none.
+	IdentityKey string `json:"-"`
+}
+
+type DuplicateEnrollmentGroup struct {
+	Reason      string        `json:"reason,omitempty"`
+	Enrollments []*Enrollment `json:"enrollments,omitempty"`
 }
 
 type Practice struct {
-	PracticeId string `json:"practiceId,omitempty"`
-	Name       string `json:"name,omitempty"`
-	Ein        string `json:"ein,omitempty"`
-	OwnerName  string `json:"owner_name,omitempty"`
+	PracticeId     string `json:"practiceId,omitempty"`
+	Name           string `json:"name,omitempty"`
+	Ein            string `json:"ein,omitempty"`
+	OwnerName      string `json:"owner_name,omitempty"`
+	OrganizationId string `json:"organizationId,omitempty"`
+}
+
+type Organization struct {
+	OrganizationId string `json:"organizationId,omitempty"`
+	Name           string `json:"name,omitempty"`
 }
 
 type Activity struct {
@@ -49,4 +107,462 @@ type Document struct {
 	PracticeId  string `json:"practiceId,omitempty"`
 	FileName    string `json:"file_name,omitempty"`
 	StoragePath string `json:"storage_path,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Size        int64  `json:"size,omitempty"`
+	Sha256      string `json:"sha256,omitempty"`
+
+	DocumentType   string `json:"documentType,omitempty"`
+	ProviderId     string `json:"providerId,omitempty"`
+	LocationId     string `json:"locationId,omitempty"`
+	EnrollmentId   string `json:"enrollmentId,omitempty"`
+	ExpirationDate string `json:"expirationDate,omitempty"`
+
+	// CurrentVersion is the version whose file fields the document mirrors
+	CurrentVersion int `json:"currentVersion,omitempty"`
+
+	ExtractionStatus string            `json:"extractionStatus,omitempty"`
+	ExtractedFields  []*ExtractedField `json:"extractedFields,omitempty"`
+
+	ScanStatus    string `json:"scanStatus,omitempty"`
+	ScanSignature string `json:"scanSignature,omitempty"`
+
+	// PreviewVersion is the version the preview was last generated for, and
+	// PreviewStatus how that went. PreviewAvailable is worked out on read.
+	// PreviewSalt is new with every encrypted preview, so each is encrypted
+	// with its own key.
+	PreviewAvailable bool   `json:"previewAvailable"`
+	PreviewVersion   int    `json:"-"`
+	PreviewStatus    string `json:"-"`
+	PreviewPath      string `json:"-"`
+	PreviewSize      int64  `json:"-"`
+	PreviewSalt      []byte `json:"-"`
+
+	// IndexedVersion is the version whose text was last indexed for search,
+	// and IndexStatus how that went
+	IndexedVersion int    `json:"-"`
+	IndexStatus    string `json:"-"`
+
+	// KeyId names the master key that wrapped WrappedKey, the data key the
+	// stored file is encrypted with. Both are empty for plaintext files.
+	KeyId      string `json:"-"`
+	WrappedKey []byte `json:"-"`
+}
+
+// DocumentVersion is one uploaded file of a document. The document record
+// carries a copy of the current version's file fields.
+type DocumentVersion struct {
+	DocumentId  string `json:"documentId,omitempty"`
+	Version     int    `json:"version,omitempty"`
+	FileName    string `json:"file_name,omitempty"`
+	StoragePath string `json:"storage_path,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Size        int64  `json:"size,omitempty"`
+	Sha256      string `json:"sha256,omitempty"`
+	UploadedAt  string `json:"uploadedAt,omitempty"`
+
+	ScanStatus    string `json:"scanStatus,omitempty"`
+	ScanSignature string `json:"scanSignature,omitempty"`
+
+	KeyId      string `json:"-"`
+	WrappedKey []byte `json:"-"`
+}
+
+// Scan statuses. A file that has not been scanned has none. Scanning is
+// retried for files that failed to scan, but not for ones too large to scan.
+const (
+	ScanClean    = "clean"
+	ScanInfected = "infected"
+	ScanFailed   = "error"
+	ScanTooLarge = "too_large"
+)
+
+// Preview statuses
+const (
+	PreviewReady       = "ready"
+	PreviewUnsupported = "unsupported"
+	PreviewFailed      = "failed"
+)
+
+// Index statuses. The text of encrypted documents is IndexSealed, or
+// IndexUnkeyed when there is no key to index it with.
+const (
+	IndexReady       = "indexed"
+	IndexSealed      = "sealed"
+	IndexUnkeyed     = "unkeyed"
+	IndexUnsupported = "unsupported"
+	IndexFailed      = "failed"
+)
+
+// Extraction statuses, of both documents and extraction jobs
+const (
+	ExtractionPending   = "pending"
+	ExtractionRunning   = "running"
+	ExtractionCompleted = "completed"
+	ExtractionFailed    = "failed"
+)
+
+// ExtractedField is a key/value pair an extractor found in a document.
+// Target names the record field the value is suggested for.
+type ExtractedField struct {
+	Key        string  `json:"key,omitempty"`
+	Value      string  `json:"value,omitempty"`
+	Confidence float64 `json:"confidence,omitempty"`
+	Page       int     `json:"page,omitempty"`
+	Target     string  `json:"target,omitempty"`
+	Applied    bool    `json:"applied,omitempty"`
+}
+
+type ExtractionJob struct {
+	JobId      string `json:"jobId,omitempty"`
+	DocumentId string `json:"documentId,omitempty"`
+	Status     string `json:"status,omitempty"`
+	Attempts   int    `json:"attempts,omitempty"`
+	Error      string `json:"error,omitempty"`
+	CreatedAt  string `json:"createdAt,omitempty"`
+	UpdatedAt  string `json:"updatedAt,omitempty"`
+}
+
+// Resumable upload states. An upload still receiving chunks has none.
+const (
+	UploadFinalizing = "finalizing"
+	UploadDeleting   = "deleting"
+)
+
+// ResumableUpload is a file being received in chunks. Offset counts the
+// bytes received so far; the metadata fields are applied to the document it
+// is finalized into. Reserved is the practice quota held for it.
+type ResumableUpload struct {
+	UploadId   string `json:"uploadId,omitempty"`
+	PracticeId string `json:"practiceId,omitempty"`
+	FileName   string `json:"fileName,omitempty"`
+	Size       int64  `json:"size,omitempty"`
+	Offset     int64  `json:"offset"`
+	ExpiresAt  string `json:"expiresAt,omitempty"`
+
+	DocumentType   string `json:"documentType,omitempty"`
+	ProviderId     string `json:"providerId,omitempty"`
+	LocationId     string `json:"locationId,omitempty"`
+	EnrollmentId   string `json:"enrollmentId,omitempty"`
+	ExpirationDate string `json:"expirationDate,omitempty"`
+
+	State      string         `json:"-"`
+	Reserved   int64          `json:"-"`
+	Chunks     []*UploadChunk `json:"-"`
+	KeyId      string         `json:"-"`
+	WrappedKey []byte         `json:"-"`
+}
+
+// DocumentText is the indexed text of a document's current version. Terms
+// are the distinct lowercased words of Text, which searches match against.
+// The text of encrypted documents is kept in SealedText instead, encrypted
+// like their files, and their terms are keyed hashes of the words.
+type DocumentText struct {
+	DocumentId string   `json:"documentId,omitempty"`
+	PracticeId string   `json:"practiceId,omitempty"`
+	Version    int      `json:"version,omitempty"`
+	Text       string   `json:"text,omitempty"`
+	SealedText []byte   `json:"-"`
+	Terms      []string `json:"terms,omitempty"`
+}
+
+// DocumentSearchResult is a document matching a search, with an excerpt of
+// its text where the search terms are marked.
+type DocumentSearchResult struct {
+	Document *Document `json:"document,omitempty"`
+	Snippet  string    `json:"snippet,omitempty"`
+	Matches  int       `json:"matches"`
+}
+
+// SharedLink lets someone without access to the API download one version of
+// a document until ExpiresAt, or only once when SingleUse is set. Url is
+// signed when the link is read and is not stored.
+type SharedLink struct {
+	LinkId     string `json:"linkId,omitempty"`
+	DocumentId string `json:"documentId,omitempty"`
+	PracticeId string `json:"practiceId,omitempty"`
+	Version    int    `json:"version,omitempty"`
+	SingleUse  bool   `json:"singleUse"`
+	ExpiresAt  string `json:"expiresAt,omitempty"`
+	CreatedAt  string `json:"createdAt,omitempty"`
+	UsedAt     string `json:"usedAt,omitempty"`
+	RevokedAt  string `json:"revokedAt,omitempty"`
+	Url        string `json:"url,omitempty"`
+}
+
+// SharedLinkRequest asks for a link lasting ExpiresIn seconds; zero uses the
+// configured default.
+type SharedLinkRequest struct {
+	ExpiresIn int  `json:"expiresIn,omitempty"`
+	SingleUse bool `json:"singleUse,omitempty"`
+}
+
+// RoleGrant gives Subject a role over one practice or over every practice of
+// one organization. Admin grants are not scoped. SubjectKind says what
+// Subject names, as a token's subject, an API key's name and a user id could
+// be the same.
+type RoleGrant struct {
+	GrantId        string `json:"grantId,omitempty"`
+	Subject        string `json:"subject,omitempty"`
+	SubjectKind    string `json:"subjectKind,omitempty"`
+	Role           string `json:"role,omitempty"`
+	PracticeId     string `json:"practiceId,omitempty"`
+	OrganizationId string `json:"organizationId,omitempty"`
+	CreatedAt      string `json:"createdAt,omitempty"`
+}
+
+// Kinds of grant subject: the subject of a bearer token from the configured
+// issuer, the name of an API key, or the id of a user account of the service
+// itself.
+const (
+	SubjectToken   = "token"
+	SubjectService = "service"
+	SubjectAccount = "account"
+)
+
+// Resource names a record an operation acts on by its kind and id.
+type Resource struct {
+	Kind string
+	Id   string
+}
+
+// Roles, from least to most access. Viewers read a practice's records,
+// editors also change them, coordinators also manage practices and
+// organizations, and admins may do anything.
+const (
+	RolePracticeViewer = "practice_viewer"
+	RolePracticeEditor = "practice_editor"
+	RoleCoordinator    = "coordinator"
+	RoleAdmin          = "admin"
+)
+
+// Permissions operations require
+const (
+	PermissionRead   = "read"
+	PermissionWrite  = "write"
+	PermissionManage = "manage"
+	PermissionAdmin  = "admin"
+)
+
+// Resource kinds
+const (
+	ResourcePractice     = "practice"
+	ResourceOrganization = "organization"
+	ResourceDocument     = "document"
+	ResourceEnrollment   = "enrollment"
+	ResourceLocation     = "location"
+	ResourceProvider     = "provider"
+	ResourceAffiliation  = "affiliation"
+	ResourceTask         = "task"
+	ResourceUpload       = "upload"
+)
+
+// User is an account that logs in to the service itself. Invited users have
+// no password until they accept their invitation. Sessions issued before
+// TokensValidAfter are refused, so resetting a password logs out everywhere.
+// TOTP secrets are kept encrypted, and recovery codes by their SHA-256 hash;
+// LastTotpStep is the time step of the last code used, which can not be used
+// again.
+type User struct {
+	UserId            string   `json:"userId,omitempty"`
+	Email             string   `json:"email,omitempty"`
+	Name              string   `json:"name,omitempty"`
+	Status            string   `json:"status,omitempty"`
+	MfaEnabled        bool     `json:"mfaEnabled"`
+	CreatedAt         string   `json:"createdAt,omitempty"`
+	LastLoginAt       string   `json:"lastLoginAt,omitempty"`
+	PasswordHash      string   `json:"-"`
+	TokensValidAfter  string   `json:"-"`
+	FailedLogins      int      `json:"-"`
+	LockedUntil       string   `json:"-"`
+	TotpSecret        []byte   `json:"-"`
+	PendingTotpSecret []byte   `json:"-"`
+	LastTotpStep      int64    `json:"-"`
+	RecoveryCodes     []string `json:"-"`
+}
+
+// User statuses
+const (
+	UserInvited  = "invited"
+	UserActive   = "active"
+	UserDisabled = "disabled"
+)
+
+// UserToken is a one-time token emailed to a user, kept by its SHA-256 hash.
+type UserToken struct {
+	TokenHash string
+	UserId    string
+	Purpose   string
+	ExpiresAt string
+	CreatedAt string
+	UsedAt    string
+}
+
+// User token purposes
+const (
+	TokenInvitation    = "invitation"
+	TokenPasswordReset = "password_reset"
+)
+
+// Invitation asks for a user to be invited by email and given Role over a
+// practice or organization, as a role grant would.
+type Invitation struct {
+	Email          string `json:"email,omitempty"`
+	Name           string `json:"name,omitempty"`
+	Role           string `json:"role,omitempty"`
+	PracticeId     string `json:"practiceId,omitempty"`
+	OrganizationId string `json:"organizationId,omitempty"`
+}
+
+// Session is the bearer token a user gets by logging in. Users with
+// multi-factor authentication get MfaToken instead, until they also give a
+// code.
+type Session struct {
+	Token       string `json:"token,omitempty"`
+	ExpiresAt   string `json:"expiresAt,omitempty"`
+	MfaRequired bool   `json:"mfaRequired,omitempty"`
+	MfaToken    string `json:"mfaToken,omitempty"`
+	User        *User  `json:"user,omitempty"`
+}
+
+// MfaEnrollment is a new TOTP secret for a user to add to their
+// authenticator app, by typing Secret or scanning ProvisioningUri as a QR
+// code.
+type MfaEnrollment struct {
+	Secret          string `json:"secret,omitempty"`
+	ProvisioningUri string `json:"provisioningUri,omitempty"`
+}
+
+// MfaRecoveryCodes are one-time codes that stand in for a TOTP code when a
+// user's authenticator is lost. They are shown only when generated.
+type MfaRecoveryCodes struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+	Session       *Session `json:"session,omitempty"`
+}
+
+// MfaPolicy lists the roles whose holders must log in with multi-factor
+// authentication. Holders without it can only enroll until they do. Services
+// cannot use it, so API keys holding those roles are refused unless
+// ExemptServices.
+type MfaPolicy struct {
+	RequiredRoles  []string `json:"requiredRoles"`
+	ExemptServices bool     `json:"exemptServices"`
+	UpdatedAt      string   `json:"updatedAt,omitempty"`
+}
+
+// Me is who the caller is authenticated as, with their account when they
+// logged in to the service itself, and their role grants.
+type Me struct {
+	Subject string       `json:"subject,omitempty"`
+	Kind    string       `json:"kind,omitempty"`
+	Email   string       `json:"email,omitempty"`
+	Name    string       `json:"name,omitempty"`
+	User    *User        `json:"user,omitempty"`
+	Grants  []*RoleGrant `json:"grants"`
+}
+
+// UploadChunk is one stored piece of a resumable upload.
+type UploadChunk struct {
+	ChunkId string
+	Size    int64
+}
+
+// Document types
+const (
+	DocumentTypeW9                 = "w9"
+	DocumentTypeLicense            = "license"
+	DocumentTypeCOI                = "coi"
+	DocumentTypeCV                 = "cv"
+	DocumentTypeBoardCertification = "board_certification"
+	DocumentTypePayerLetter        = "payer_letter"
+	DocumentTypeDEA                = "dea"
+	DocumentTypeOther              = "other"
+)
+
+// DocumentFilter narrows a practice's documents to those matching every
+// non-empty field.
+type DocumentFilter struct {
+	DocumentType string
+	ProviderId   string
+	LocationId   string
+	EnrollmentId string
+}
+
+// Document verification statuses
+const (
+	VerificationMissing = "missing"
+	VerificationCorrupt = "corrupt"
+	VerificationError   = "error"
+)
+
+type DocumentVerification struct {
+	DocumentId     string `json:"documentId,omitempty"`
+	PracticeId     string `json:"practiceId,omitempty"`
+	Status         string `json:"status,omitempty"`
+	ExpectedSha256 string `json:"expectedSha256,omitempty"`
+	ActualSha256   string `json:"actualSha256,omitempty"`
+	Message        string `json:"message,omitempty"`
+}
+
+type VerificationReport struct {
+	Checked  int                     `json:"checked"`
+	Recorded int                     `json:"recorded"`
+	Problems []*DocumentVerification `json:"problems,omitempty"`
+}
+
+// StorageUsage is how much a practice stores, in total and by document
+// type. Bytes count every stored version of each document.
+type StorageUsage struct {
+	PracticeId string                `json:"practiceId,omitempty"`
+	Bytes      int64                 `json:"bytes"`
+	Documents  int                   `json:"documents"`
+	QuotaBytes int64                 `json:"quotaBytes,omitempty"`
+	Types      map[string]*TypeUsage `json:"types,omitempty"`
+}
+
+type TypeUsage struct {
+	Bytes     int64 `json:"bytes"`
+	Documents int   `json:"documents"`
+}
+
+// Storage discrepancy kinds
+const (
+	DiscrepancyOrphanFile   = "orphan_file"
+	DiscrepancyMissingFile  = "missing_file"
+	DiscrepancySizeMismatch = "size_mismatch"
+)
+
+// Actions taken on storage discrepancies
+const (
+	DiscrepancyRepaired = "repaired"
+	DiscrepancyDeleted  = "deleted"
+)
+
+// StorageDiscrepancy is a stored file without a record, a record without a
+// file, or a file whose size differs from its record. Version is set for
+// records of earlier document versions.
+type StorageDiscrepancy struct {
+	Kind         string `json:"kind"`
+	Key          string `json:"key"`
+	DocumentId   string `json:"documentId,omitempty"`
+	PracticeId   string `json:"practiceId,omitempty"`
+	Version      int    `json:"version,omitempty"`
+	ExpectedSize int64  `json:"expectedSize,omitempty"`
+	ActualSize   int64  `json:"actualSize,omitempty"`
+	Action       string `json:"action,omitempty"`
+	Message      string `json:"message,omitempty"`
+}
+
+// ReconciliationOptions choose what storage reconciliation fixes. Repair
+// corrects the recorded size of files whose size differs from their record,
+// when the content still matches the recorded checksum or there is none;
+// Delete removes files without a record and records without a file.
+type ReconciliationOptions struct {
+	Repair bool
+	Delete bool
+}
+
+type ReconciliationReport struct {
+	Files    int                   `json:"files"`
+	Records  int                   `json:"records"`
+	Problems []*StorageDiscrepancy `json:"problems,omitempty"`
 }
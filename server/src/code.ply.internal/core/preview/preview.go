@@ -0,0 +1,192 @@
+package preview
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ContentType is the media type of generated previews.
+	ContentType = "image/png"
+
+	defaultMaxDimension = 320
+	defaultPdftoppm     = "pdftoppm"
+	defaultTimeout      = time.Minute
+
+	// maxSourceBytes and maxSourcePixels bound what is decoded, so a small
+	// file that expands to a huge image cannot exhaust memory
+	maxSourceBytes  = 64 << 20
+	maxSourcePixels = 50_000_000
+)
+
+var ErrUnsupported = errors.New("previews are not supported for this content type")
+
+type (
+	// Generator renders small PNG previews of images and of the first page
+	// of PDFs. PDFs are rendered with poppler's pdftoppm.
+	Generator struct {
+		maxDimension int
+		pdftoppm     string
+		timeout      time.Duration
+	}
+
+	// Params configure a Generator. MaxDimension caps the width and height
+	// of previews; Pdftoppm is the path of the pdftoppm command.
+	Params struct {
+		MaxDimension int
+		Pdftoppm     string
+		Timeout      time.Duration
+	}
+)
+
+func New(p Params) *Generator {
+	if p.MaxDimension <= 0 {
+		p.MaxDimension = defaultMaxDimension
+	}
+	if p.Pdftoppm == "" {
+		p.Pdftoppm = defaultPdftoppm
+	}
+	if p.Timeout <= 0 {
+		p.Timeout = defaultTimeout
+	}
+	return &Generator{
+		maxDimension: p.MaxDimension,
+		pdftoppm:     p.Pdftoppm,
+		timeout:      p.Timeout,
+	}
+}
+
+// Supports reports whether previews can be generated for contentType.
+func (g *Generator) Supports(contentType string) bool {
+	switch contentType {
+	case "application/pdf", "image/png", "image/jpeg", "image/gif":
+		return true
+	}
+	return false
+}
+
+// Generate returns a PNG preview of content, which is of contentType.
+func (g *Generator) Generate(ctx context.Context, contentType string, content io.Reader) ([]byte, error) {
+	if !g.Supports(contentType) {
+		return nil, ErrUnsupported
+	}
+
+	source, err := io.ReadAll(io.LimitReader(content, maxSourceBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(source) > maxSourceBytes {
+		return nil, fmt.Errorf("file is too large to preview")
+	}
+
+	if contentType == "application/pdf" {
+		source, err = g.renderFirstPage(ctx, source)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(source))
+	if err != nil {
+		return nil, fmt.Errorf("error reading image: %w", err)
+	}
+	if config.Width*config.Height > maxSourcePixels {
+		return nil, fmt.Errorf("image of %dx%d pixels is too large to preview", config.Width, config.Height)
+	}
+	img, _, err := image.Decode(bytes.NewReader(source))
+	if err != nil {
+		return nil, fmt.Errorf("error reading image: %w", err)
+	}
+
+	var out bytes.Buffer
+	if err := png.Encode(&out, thumbnail(img, g.maxDimension)); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// renderFirstPage renders the first page of a PDF to PNG with pdftoppm,
+// passing the file through pipes so it is never written to disk.
+func (g *Generator) renderFirstPage(ctx context.Context, pdf []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, g.pdftoppm,
+		"-f", "1", "-l", "1", "-singlefile", "-png",
+		"-scale-to", strconv.Itoa(g.maxDimension),
+		"-",
+	)
+	cmd.Stdin = bytes.NewReader(pdf)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			return nil, fmt.Errorf("error rendering pdf: %w", err)
+		}
+		return nil, fmt.Errorf("error rendering pdf: %w: %s", err, message)
+	}
+	return stdout.Bytes(), nil
+}
+
+// thumbnail scales img down to fit within limit pixels on each side,
+// averaging the source pixels that cover each preview pixel.
+func thumbnail(img image.Image, limit int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= limit && height <= limit {
+		return img
+	}
+
+	previewWidth, previewHeight := limit, limit
+	if width > height {
+		previewHeight = height * limit / width
+	} else {
+		previewWidth = width * limit / height
+	}
+	if previewWidth < 1 {
+		previewWidth = 1
+	}
+	if previewHeight < 1 {
+		previewHeight = 1
+	}
+
+	preview := image.NewRGBA64(image.Rect(0, 0, previewWidth, previewHeight))
+	for y := 0; y < previewHeight; y++ {
+		top := bounds.Min.Y + y*height/previewHeight
+		bottom := bounds.Min.Y + (y+1)*height/previewHeight
+		for x := 0; x < previewWidth; x++ {
+			left := bounds.Min.X + x*width/previewWidth
+			right := bounds.Min.X + (x+1)*width/previewWidth
+
+			var r, g, b, a, n uint64
+			for sy := top; sy < bottom; sy++ {
+				for sx := left; sx < right; sx++ {
+					pr, pg, pb, pa := img.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					n++
+				}
+			}
+			preview.SetRGBA64(x, y, color.RGBA64{
+				R: uint16(r / n),
+				G: uint16(g / n),
+				B: uint16(b / n),
+				A: uint16(a / n),
+			})
+		}
+	}
+	return preview
+}
@@ -0,0 +1,240 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"os/exec"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	defaultPdftotext    = "pdftotext"
+	defaultTimeout      = time.Minute
+	defaultMaxTextBytes = 1 << 20
+
+	// minTermLength drops single characters, which match nearly everything
+	minTermLength = 2
+)
+
+var ErrUnsupported = errors.New("text cannot be extracted from this content type")
+
+type (
+	// Extractor pulls the text out of plain text files and PDFs. PDFs are
+	// read with poppler's pdftotext.
+	Extractor struct {
+		pdftotext    string
+		timeout      time.Duration
+		maxTextBytes int
+	}
+
+	// Params configure an Extractor. Pdftotext is the path of the pdftotext
+	// command and MaxTextBytes caps how much of a file's text is kept.
+	Params struct {
+		Pdftotext    string
+		Timeout      time.Duration
+		MaxTextBytes int
+	}
+)
+
+func New(p Params) *Extractor {
+	if p.Pdftotext == "" {
+		p.Pdftotext = defaultPdftotext
+	}
+	if p.Timeout <= 0 {
+		p.Timeout = defaultTimeout
+	}
+	if p.MaxTextBytes <= 0 {
+		p.MaxTextBytes = defaultMaxTextBytes
+	}
+	return &Extractor{
+		pdftotext:    p.Pdftotext,
+		timeout:      p.Timeout,
+		maxTextBytes: p.MaxTextBytes,
+	}
+}
+
+// Supports reports whether text can be extracted from contentType.
+func (e *Extractor) Supports(contentType string) bool {
+	return contentType == "application/pdf" || contentType == "text/plain"
+}
+
+// Extract returns the text of content, which is of contentType, cut to the
+// configured maximum.
+func (e *Extractor) Extract(ctx context.Context, contentType string, content io.Reader) (string, error) {
+	var text []byte
+	var err error
+	switch contentType {
+	case "text/plain":
+		text, err = io.ReadAll(io.LimitReader(content, int64(e.maxTextBytes)))
+	case "application/pdf":
+		text, err = e.extractPdf(ctx, content)
+	default:
+		return "", ErrUnsupported
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if len(text) > e.maxTextBytes {
+		text = text[:e.maxTextBytes]
+	}
+	return strings.ToValidUTF8(string(text), ""), nil
+}
+
+func (e *Extractor) extractPdf(ctx context.Context, content io.Reader) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, e.pdftotext, "-enc", "UTF-8", "-", "-")
+	cmd.Stdin = content
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			return nil, fmt.Errorf("error extracting pdf text: %w", err)
+		}
+		return nil, fmt.Errorf("error extracting pdf text: %w: %s", err, message)
+	}
+	return stdout.Bytes(), nil
+}
+
+// Terms returns the distinct lowercased words of text, in the order they
+// first appear.
+func Terms(text string) []string {
+	terms := []string{}
+	seen := map[string]bool{}
+	for _, word := range words(text) {
+		term := strings.ToLower(text[word.start:word.end])
+		if utf8.RuneCountInString(term) < minTermLength || seen[term] {
+			continue
+		}
+		seen[term] = true
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// Count returns how many times any of terms occurs as a word in text.
+func Count(text string, terms []string) int {
+	wanted := termSet(terms)
+	count := 0
+	for _, word := range words(text) {
+		if wanted[strings.ToLower(text[word.start:word.end])] {
+			count++
+		}
+	}
+	return count
+}
+
+// Snippet returns about width bytes of text around its first run of terms,
+// HTML escaped, with each occurrence of a term wrapped in <mark> tags.
+func Snippet(text string, terms []string, width int) string {
+	wanted := termSet(terms)
+	found := []span{}
+	for _, word := range words(text) {
+		if wanted[strings.ToLower(text[word.start:word.end])] {
+			found = append(found, word)
+		}
+	}
+
+	// Open the snippet a little before the first match, on a word boundary
+	start, end := 0, len(text)
+	if len(found) > 0 {
+		start = found[0].start - width/4
+	}
+	if start < 0 {
+		start = 0
+	}
+	for start > 0 && start < len(text) && !isBoundary(text, start) {
+		start++
+	}
+	if start+width < end {
+		end = start + width
+		for end > start && !isBoundary(text, end) {
+			end--
+		}
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	position := start
+	for _, match := range found {
+		if match.start < start {
+			continue
+		}
+		if match.end > end {
+			break
+		}
+		b.WriteString(html.EscapeString(text[position:match.start]))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(text[match.start:match.end]))
+		b.WriteString("</mark>")
+		position = match.end
+	}
+	b.WriteString(html.EscapeString(text[position:end]))
+	if end < len(text) {
+		b.WriteString("…")
+	}
+	return collapseSpace(b.String())
+}
+
+type span struct {
+	start int
+	end   int
+}
+
+// words returns the byte spans of the runs of letters and digits in text.
+func words(text string) []span {
+	spans := []span{}
+	start := -1
+	for i, r := range text {
+		wordRune := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if wordRune && start < 0 {
+			start = i
+		}
+		if !wordRune && start >= 0 {
+			spans = append(spans, span{start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, span{start: start, end: len(text)})
+	}
+	return spans
+}
+
+func termSet(terms []string) map[string]bool {
+	set := map[string]bool{}
+	for _, term := range terms {
+		set[strings.ToLower(term)] = true
+	}
+	return set
+}
+
+// isBoundary reports whether i in text is between a space and a non-space,
+// or at either end.
+func isBoundary(text string, i int) bool {
+	if i <= 0 || i >= len(text) {
+		return true
+	}
+	before, _ := utf8.DecodeLastRuneInString(text[:i])
+	after, _ := utf8.DecodeRuneInString(text[i:])
+	return unicode.IsSpace(before) || unicode.IsSpace(after)
+}
+
+// collapseSpace turns each run of whitespace, such as the line breaks of
+// extracted PDF text, into a single space.
+func collapseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -16,6 +16,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
@@ -24,28 +25,176 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	ApiKeyAuthScopes = "apiKeyAuth.Scopes"
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// PostV1PlyAdminDocumentVerifyParams defines parameters for PostV1PlyAdminDocumentVerify.
+type PostV1PlyAdminDocumentVerifyParams struct {
+	PracticeId *string `form:"practiceId,omitempty" json:"practiceId,omitempty"`
+}
+
+// GetV1PlyAdminGrantParams defines parameters for GetV1PlyAdminGrant.
+type GetV1PlyAdminGrantParams struct {
+	// Subject Only list the grants of this subject
+	Subject *string `form:"subject,omitempty" json:"subject,omitempty"`
+
+	// SubjectKind Only list the grants of subjects of this kind
+	SubjectKind *string `form:"subjectKind,omitempty" json:"subjectKind,omitempty"`
+}
+
+// PostV1PlyAdminGrantJSONBody defines parameters for PostV1PlyAdminGrant.
+type PostV1PlyAdminGrantJSONBody struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	GrantId   *string    `json:"grantId,omitempty"`
+
+	// OrganizationId The organization over whose practices the role is granted
+	OrganizationId *string `json:"organizationId,omitempty"`
+
+	// PracticeId The practice the role is granted over
+	PracticeId *string `json:"practiceId,omitempty"`
+
+	// Role One of "practice_viewer", "practice_editor", "coordinator" or "admin"
+	Role *string `json:"role,omitempty"`
+
+	// Subject The subject of a user's token, the name of a service's API key or the id of a user account
+	Subject *string `json:"subject,omitempty"`
+
+	// SubjectKind What subject names, one of "token", "service" or "account". Grants apply only to callers of their kind.
+	SubjectKind *string `json:"subjectKind,omitempty"`
+}
+
+// PostV1PlyAdminMfaPolicyJSONBody defines parameters for PostV1PlyAdminMfaPolicy.
+type PostV1PlyAdminMfaPolicyJSONBody struct {
+	// ExemptServices Lets API keys holding a required role through. Services cannot use multi-factor authentication, so they are otherwise refused.
+	ExemptServices *bool `json:"exemptServices,omitempty"`
+
+	// RequiredRoles Roles whose holders must log in with a second factor, from "viewer", "editor", "manager" and "admin". Every role can currently read SSNs and EINs.
+	RequiredRoles *[]string  `json:"requiredRoles,omitempty"`
+	UpdatedAt     *time.Time `json:"updatedAt,omitempty"`
+}
+
+// PostV1PlyAdminStorageReconcileParams defines parameters for PostV1PlyAdminStorageReconcile.
+type PostV1PlyAdminStorageReconcileParams struct {
+	// Repair Record the actual size of files whose size differs from their record. A file whose content no longer matches its recorded checksum is reported and left alone; records without a checksum get one.
+	Repair *bool `form:"repair,omitempty" json:"repair,omitempty"`
+
+	// Delete Delete files that have no record, and records whose file is missing
+	Delete *bool `form:"delete,omitempty" json:"delete,omitempty"`
+}
+
+// PostV1PlyAffiliationAffiliationIdJSONBody defines parameters for PostV1PlyAffiliationAffiliationId.
+type PostV1PlyAffiliationAffiliationIdJSONBody struct {
+	AffiliationId *string             `json:"affiliationId,omitempty"`
+	EndDate       *openapi_types.Date `json:"endDate,omitempty"`
+	PracticeId    *string             `json:"practiceId,omitempty"`
+	ProviderId    *string             `json:"providerId,omitempty"`
+	Role          *string             `json:"role,omitempty"`
+	StartDate     *openapi_types.Date `json:"startDate,omitempty"`
+}
+
+// GetV1PlyDocumentDocumentIdParams defines parameters for GetV1PlyDocumentDocumentId.
+type GetV1PlyDocumentDocumentIdParams struct {
+	// Range A single byte range, for example "bytes=0-1023"
+	Range *string `json:"Range,omitempty"`
+}
+
+// PostV1PlyDocumentDocumentIdExtractionApplyJSONBody defines parameters for PostV1PlyDocumentDocumentIdExtractionApply.
+type PostV1PlyDocumentDocumentIdExtractionApplyJSONBody struct {
+	// Fields Extracted fields to copy onto the document's provider or practice
+	Fields []struct {
+		// Key The key of an extracted field
+		Key string `json:"key"`
+
+		// Target The record field to set; defaults to the field's suggested target
+		Target *string `json:"target,omitempty"`
+
+		// Value A corrected value to apply instead of the extracted one
+		Value *string `json:"value,omitempty"`
+	} `json:"fields"`
+}
+
+// PostV1PlyDocumentDocumentIdLinkJSONBody defines parameters for PostV1PlyDocumentDocumentIdLink.
+type PostV1PlyDocumentDocumentIdLinkJSONBody struct {
+	// ExpiresIn Seconds until the link expires; defaults to the configured expiry
+	ExpiresIn *int `json:"expiresIn,omitempty"`
+
+	// SingleUse Allow only one download through the link
+	SingleUse *bool `json:"singleUse,omitempty"`
+}
+
+// PostV1PlyDocumentDocumentIdMetadataJSONBody defines parameters for PostV1PlyDocumentDocumentIdMetadata.
+type PostV1PlyDocumentDocumentIdMetadataJSONBody struct {
+	// DocumentType One of "w9", "license", "coi", "cv", "board_certification", "payer_letter", "dea" or "other"
+	DocumentType   *string             `json:"documentType,omitempty"`
+	EnrollmentId   *string             `json:"enrollmentId,omitempty"`
+	ExpirationDate *openapi_types.Date `json:"expirationDate,omitempty"`
+	LocationId     *string             `json:"locationId,omitempty"`
+	ProviderId     *string             `json:"providerId,omitempty"`
+}
+
+// PostV1PlyDocumentDocumentIdVersionMultipartBody defines parameters for PostV1PlyDocumentDocumentIdVersion.
+type PostV1PlyDocumentDocumentIdVersionMultipartBody struct {
+	// File The new version of the document file
+	File openapi_types.File `json:"file"`
+
+	// FileName The original name of the file being uploaded. Must be sent before the file; defaults to the file part's filename.
+	FileName *string `json:"fileName,omitempty"`
+}
+
+// GetV1PlyDocumentDocumentIdVersionVersionParams defines parameters for GetV1PlyDocumentDocumentIdVersionVersion.
+type GetV1PlyDocumentDocumentIdVersionVersionParams struct {
+	// Range A single byte range, for example "bytes=0-1023"
+	Range *string `json:"Range,omitempty"`
+}
+
 // PostV1PlyEnrollmentJSONBody defines parameters for PostV1PlyEnrollment.
 type PostV1PlyEnrollmentJSONBody struct {
-	EnrollmentId *string `json:"enrollmentId,omitempty"`
-	LocationId   *string `json:"locationId,omitempty"`
-	Payer        *string `json:"payer,omitempty"`
-	PracticeId   *string `json:"practiceId,omitempty"`
-	ProviderId   *string `json:"providerId,omitempty"`
-	State        *string `json:"state,omitempty"`
-	Status       *string `json:"status,omitempty"`
-	Type         *string `json:"type,omitempty"`
+	ApprovalDate  *openapi_types.Date `json:"approvalDate,omitempty"`
+	EffectiveDate *openapi_types.Date `json:"effectiveDate,omitempty"`
+	EnrollmentId  *string             `json:"enrollmentId,omitempty"`
+
+	// GroupEnrollmentId For an individual enrollment, the group enrollment its benefits are reassigned to
+	GroupEnrollmentId *string `json:"groupEnrollmentId,omitempty"`
+
+	// Kind Either "group" or "individual"; an enrollment without one is individual. A group enrollment cannot change kind while individual enrollments reference it.
+	Kind                *string             `json:"kind,omitempty"`
+	LocationId          *string             `json:"locationId,omitempty"`
+	Payer               *string             `json:"payer,omitempty"`
+	PayerProviderNumber *string             `json:"payerProviderNumber,omitempty"`
+	PracticeId          *string             `json:"practiceId,omitempty"`
+	ProviderId          *string             `json:"providerId,omitempty"`
+	Ptan                *string             `json:"ptan,omitempty"`
+	RevalidationDueDate *openapi_types.Date `json:"revalidationDueDate,omitempty"`
+	State               *string             `json:"state,omitempty"`
+	Status              *string             `json:"status,omitempty"`
+	SubmittedDate       *openapi_types.Date `json:"submittedDate,omitempty"`
+	Type                *string             `json:"type,omitempty"`
 }
 
 // PostV1PlyEnrollmentEnrollmentIdJSONBody defines parameters for PostV1PlyEnrollmentEnrollmentId.
 type PostV1PlyEnrollmentEnrollmentIdJSONBody struct {
-	EnrollmentId *string `json:"enrollmentId,omitempty"`
-	LocationId   *string `json:"locationId,omitempty"`
-	Payer        *string `json:"payer,omitempty"`
-	PracticeId   *string `json:"practiceId,omitempty"`
-	ProviderId   *string `json:"providerId,omitempty"`
-	State        *string `json:"state,omitempty"`
-	Status       *string `json:"status,omitempty"`
-	Type         *string `json:"type,omitempty"`
+	ApprovalDate  *openapi_types.Date `json:"approvalDate,omitempty"`
+	EffectiveDate *openapi_types.Date `json:"effectiveDate,omitempty"`
+	EnrollmentId  *string             `json:"enrollmentId,omitempty"`
+
+	// GroupEnrollmentId For an individual enrollment, the group enrollment its benefits are reassigned to
+	GroupEnrollmentId *string `json:"groupEnrollmentId,omitempty"`
+
+	// Kind Either "group" or "individual"; an enrollment without one is individual. A group enrollment cannot change kind while individual enrollments reference it.
+	Kind                *string             `json:"kind,omitempty"`
+	LocationId          *string             `json:"locationId,omitempty"`
+	Payer               *string             `json:"payer,omitempty"`
+	PayerProviderNumber *string             `json:"payerProviderNumber,omitempty"`
+	PracticeId          *string             `json:"practiceId,omitempty"`
+	ProviderId          *string             `json:"providerId,omitempty"`
+	Ptan                *string             `json:"ptan,omitempty"`
+	RevalidationDueDate *openapi_types.Date `json:"revalidationDueDate,omitempty"`
+	State               *string             `json:"state,omitempty"`
+	Status              *string             `json:"status,omitempty"`
+	SubmittedDate       *openapi_types.Date `json:"submittedDate,omitempty"`
+	Type                *string             `json:"type,omitempty"`
 }
 
 // PostV1PlyLocationJSONBody defines parameters for PostV1PlyLocation.
@@ -62,29 +211,142 @@ type PostV1PlyLocationLocationIdJSONBody struct {
 	PracticeId *string `json:"practiceId,omitempty"`
 }
 
+// PostV1PlyLoginJSONBody defines parameters for PostV1PlyLogin.
+type PostV1PlyLoginJSONBody struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// PostV1PlyLoginMfaJSONBody defines parameters for PostV1PlyLoginMfa.
+type PostV1PlyLoginMfaJSONBody struct {
+	// Code A six digit code from the user's authenticator app, or one of their recovery codes
+	Code string `json:"code"`
+
+	// MfaToken The token logging in with a password returned
+	MfaToken string `json:"mfaToken"`
+}
+
+// PostV1PlyMeMfaConfirmJSONBody defines parameters for PostV1PlyMeMfaConfirm.
+type PostV1PlyMeMfaConfirmJSONBody struct {
+	// Code A six digit code from the user's authenticator app, or one of their recovery codes
+	Code string `json:"code"`
+}
+
+// PostV1PlyMeMfaDisableJSONBody defines parameters for PostV1PlyMeMfaDisable.
+type PostV1PlyMeMfaDisableJSONBody struct {
+	// Code A six digit code from the user's authenticator app, or one of their recovery codes
+	Code string `json:"code"`
+}
+
+// PostV1PlyMeMfaRecoveryJSONBody defines parameters for PostV1PlyMeMfaRecovery.
+type PostV1PlyMeMfaRecoveryJSONBody struct {
+	// Code A six digit code from the user's authenticator app, or one of their recovery codes
+	Code string `json:"code"`
+}
+
+// PostV1PlyMePasswordJSONBody defines parameters for PostV1PlyMePassword.
+type PostV1PlyMePasswordJSONBody struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
+// PostV1PlyOrganizationJSONBody defines parameters for PostV1PlyOrganization.
+type PostV1PlyOrganizationJSONBody struct {
+	Name           *string `json:"name,omitempty"`
+	OrganizationId *string `json:"organizationId,omitempty"`
+}
+
+// PostV1PlyOrganizationOrganizationIdJSONBody defines parameters for PostV1PlyOrganizationOrganizationId.
+type PostV1PlyOrganizationOrganizationIdJSONBody struct {
+	Name           *string `json:"name,omitempty"`
+	OrganizationId *string `json:"organizationId,omitempty"`
+}
+
+// PostV1PlyPasswordResetJSONBody defines parameters for PostV1PlyPasswordReset.
+type PostV1PlyPasswordResetJSONBody struct {
+	Email string `json:"email"`
+}
+
+// PostV1PlyPasswordResetConfirmJSONBody defines parameters for PostV1PlyPasswordResetConfirm.
+type PostV1PlyPasswordResetConfirmJSONBody struct {
+	Password string `json:"password"`
+
+	// Token The token from the password reset email
+	Token string `json:"token"`
+}
+
 // PostV1PlyPracticeJSONBody defines parameters for PostV1PlyPractice.
 type PostV1PlyPracticeJSONBody struct {
-	Ein        *string `json:"ein,omitempty"`
-	Name       *string `json:"name,omitempty"`
-	OwnerName  *string `json:"owner_name,omitempty"`
-	PracticeId *string `json:"practiceId,omitempty"`
+	Ein            *string `json:"ein,omitempty"`
+	Name           *string `json:"name,omitempty"`
+	OrganizationId *string `json:"organizationId,omitempty"`
+	OwnerName      *string `json:"owner_name,omitempty"`
+	PracticeId     *string `json:"practiceId,omitempty"`
 }
 
 // PostV1PlyPracticePracticeIdJSONBody defines parameters for PostV1PlyPracticePracticeId.
 type PostV1PlyPracticePracticeIdJSONBody struct {
-	Ein        *string `json:"ein,omitempty"`
-	Name       *string `json:"name,omitempty"`
-	OwnerName  *string `json:"owner_name,omitempty"`
-	PracticeId *string `json:"practiceId,omitempty"`
+	Ein            *string `json:"ein,omitempty"`
+	Name           *string `json:"name,omitempty"`
+	OrganizationId *string `json:"organizationId,omitempty"`
+	OwnerName      *string `json:"owner_name,omitempty"`
+	PracticeId     *string `json:"practiceId,omitempty"`
+}
+
+// GetV1PlyPracticePracticeIdDocumentParams defines parameters for GetV1PlyPracticePracticeIdDocument.
+type GetV1PlyPracticePracticeIdDocumentParams struct {
+	DocumentType *string `form:"documentType,omitempty" json:"documentType,omitempty"`
+	ProviderId   *string `form:"providerId,omitempty" json:"providerId,omitempty"`
+	LocationId   *string `form:"locationId,omitempty" json:"locationId,omitempty"`
+	EnrollmentId *string `form:"enrollmentId,omitempty" json:"enrollmentId,omitempty"`
+}
+
+// GetV1PlyPracticePracticeIdDocumentArchiveParams defines parameters for GetV1PlyPracticePracticeIdDocumentArchive.
+type GetV1PlyPracticePracticeIdDocumentArchiveParams struct {
+	DocumentType *string `form:"documentType,omitempty" json:"documentType,omitempty"`
+	ProviderId   *string `form:"providerId,omitempty" json:"providerId,omitempty"`
+	EnrollmentId *string `form:"enrollmentId,omitempty" json:"enrollmentId,omitempty"`
+}
+
+// GetV1PlyPracticePracticeIdDocumentSearchParams defines parameters for GetV1PlyPracticePracticeIdDocumentSearch.
+type GetV1PlyPracticePracticeIdDocumentSearchParams struct {
+	// Q Words to search for; case is ignored
+	Q string `form:"q" json:"q"`
+
+	// Limit Most results to return, from 1 to 100; defaults to 20
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // PostV1PlyPracticePracticeIdUploadMultipartBody defines parameters for PostV1PlyPracticePracticeIdUpload.
 type PostV1PlyPracticePracticeIdUploadMultipartBody struct {
+	// DocumentType One of "w9", "license", "coi", "cv", "board_certification", "payer_letter", "dea" or "other". Metadata fields must be sent before the file.
+	DocumentType   *string             `json:"documentType,omitempty"`
+	EnrollmentId   *string             `json:"enrollmentId,omitempty"`
+	ExpirationDate *openapi_types.Date `json:"expirationDate,omitempty"`
+
 	// File The document file to upload
 	File openapi_types.File `json:"file"`
 
 	// FileName The original name of the file being uploaded
-	FileName string `json:"fileName"`
+	FileName   string  `json:"fileName"`
+	LocationId *string `json:"locationId,omitempty"`
+	ProviderId *string `json:"providerId,omitempty"`
+}
+
+// PostV1PlyPracticePracticeIdUploadResumableJSONBody defines parameters for PostV1PlyPracticePracticeIdUploadResumable.
+type PostV1PlyPracticePracticeIdUploadResumableJSONBody struct {
+	// DocumentType One of "w9", "license", "coi", "cv", "board_certification", "payer_letter", "dea" or "other"
+	DocumentType   *string             `json:"documentType,omitempty"`
+	EnrollmentId   *string             `json:"enrollmentId,omitempty"`
+	ExpirationDate *openapi_types.Date `json:"expirationDate,omitempty"`
+
+	// FileName The original name of the file being uploaded
+	FileName   string  `json:"fileName"`
+	LocationId *string `json:"locationId,omitempty"`
+	ProviderId *string `json:"providerId,omitempty"`
+
+	// Size The total size of the file in bytes
+	Size int64 `json:"size"`
 }
 
 // PostV1PlyProviderJSONBody defines parameters for PostV1PlyProvider.
@@ -103,6 +365,32 @@ type PostV1PlyProviderProviderIdJSONBody struct {
 	Ssn        *string `json:"ssn,omitempty"`
 }
 
+// PostV1PlyProviderProviderIdAffiliationJSONBody defines parameters for PostV1PlyProviderProviderIdAffiliation.
+type PostV1PlyProviderProviderIdAffiliationJSONBody struct {
+	AffiliationId *string             `json:"affiliationId,omitempty"`
+	EndDate       *openapi_types.Date `json:"endDate,omitempty"`
+	PracticeId    *string             `json:"practiceId,omitempty"`
+	ProviderId    *string             `json:"providerId,omitempty"`
+	Role          *string             `json:"role,omitempty"`
+	StartDate     *openapi_types.Date `json:"startDate,omitempty"`
+}
+
+// GetV1PlyReportRevalidationParams defines parameters for GetV1PlyReportRevalidation.
+type GetV1PlyReportRevalidationParams struct {
+	WithinDays *int    `form:"withinDays,omitempty" json:"withinDays,omitempty"`
+	PracticeId *string `form:"practiceId,omitempty" json:"practiceId,omitempty"`
+}
+
+// GetV1PlySharedLinkIdParams defines parameters for GetV1PlySharedLinkId.
+type GetV1PlySharedLinkIdParams struct {
+	// Expires Unix time the link expires at, as signed
+	Expires   int64  `form:"expires" json:"expires"`
+	Signature string `form:"signature" json:"signature"`
+
+	// Range A single byte range, for example "bytes=0-1023"
+	Range *string `json:"Range,omitempty"`
+}
+
 // PostV1PlyTaskTaskIdJSONBody defines parameters for PostV1PlyTaskTaskId.
 type PostV1PlyTaskTaskIdJSONBody struct {
 	Message    *string `json:"message,omitempty"`
@@ -111,6 +399,57 @@ type PostV1PlyTaskTaskIdJSONBody struct {
 	TaskId     *string `json:"taskId,omitempty"`
 }
 
+// PatchV1PlyUploadUploadIdParams defines parameters for PatchV1PlyUploadUploadId.
+type PatchV1PlyUploadUploadIdParams struct {
+	// UploadOffset The byte offset of the chunk in the file, which must equal the upload's current offset
+	UploadOffset int64 `json:"Upload-Offset"`
+}
+
+// PostV1PlyUserInvitationJSONBody defines parameters for PostV1PlyUserInvitation.
+type PostV1PlyUserInvitationJSONBody struct {
+	Email string  `json:"email"`
+	Name  *string `json:"name,omitempty"`
+
+	// OrganizationId The organization over whose practices the role is granted
+	OrganizationId *string `json:"organizationId,omitempty"`
+
+	// PracticeId The practice the role is granted over
+	PracticeId *string `json:"practiceId,omitempty"`
+
+	// Role The role to grant, one of "practice_viewer", "practice_editor", "coordinator" or "admin"
+	Role string `json:"role"`
+}
+
+// PostV1PlyUserInvitationAcceptJSONBody defines parameters for PostV1PlyUserInvitationAccept.
+type PostV1PlyUserInvitationAcceptJSONBody struct {
+	Name     *string `json:"name,omitempty"`
+	Password string  `json:"password"`
+
+	// Token The token from the invitation email
+	Token string `json:"token"`
+}
+
+// PostV1PlyAdminGrantJSONRequestBody defines body for PostV1PlyAdminGrant for application/json ContentType.
+type PostV1PlyAdminGrantJSONRequestBody PostV1PlyAdminGrantJSONBody
+
+// PostV1PlyAdminMfaPolicyJSONRequestBody defines body for PostV1PlyAdminMfaPolicy for application/json ContentType.
+type PostV1PlyAdminMfaPolicyJSONRequestBody PostV1PlyAdminMfaPolicyJSONBody
+
+// PostV1PlyAffiliationAffiliationIdJSONRequestBody defines body for PostV1PlyAffiliationAffiliationId for application/json ContentType.
+type PostV1PlyAffiliationAffiliationIdJSONRequestBody PostV1PlyAffiliationAffiliationIdJSONBody
+
+// PostV1PlyDocumentDocumentIdExtractionApplyJSONRequestBody defines body for PostV1PlyDocumentDocumentIdExtractionApply for application/json ContentType.
+type PostV1PlyDocumentDocumentIdExtractionApplyJSONRequestBody PostV1PlyDocumentDocumentIdExtractionApplyJSONBody
+
+// PostV1PlyDocumentDocumentIdLinkJSONRequestBody defines body for PostV1PlyDocumentDocumentIdLink for application/json ContentType.
+type PostV1PlyDocumentDocumentIdLinkJSONRequestBody PostV1PlyDocumentDocumentIdLinkJSONBody
+
+// PostV1PlyDocumentDocumentIdMetadataJSONRequestBody defines body for PostV1PlyDocumentDocumentIdMetadata for application/json ContentType.
+type PostV1PlyDocumentDocumentIdMetadataJSONRequestBody PostV1PlyDocumentDocumentIdMetadataJSONBody
+
+// PostV1PlyDocumentDocumentIdVersionMultipartRequestBody defines body for PostV1PlyDocumentDocumentIdVersion for multipart/form-data ContentType.
+type PostV1PlyDocumentDocumentIdVersionMultipartRequestBody PostV1PlyDocumentDocumentIdVersionMultipartBody
+
 // PostV1PlyEnrollmentJSONRequestBody defines body for PostV1PlyEnrollment for application/json ContentType.
 type PostV1PlyEnrollmentJSONRequestBody PostV1PlyEnrollmentJSONBody
 
@@ -123,6 +462,36 @@ type PostV1PlyLocationJSONRequestBody PostV1PlyLocationJSONBody
 // PostV1PlyLocationLocationIdJSONRequestBody defines body for PostV1PlyLocationLocationId for application/json ContentType.
 type PostV1PlyLocationLocationIdJSONRequestBody PostV1PlyLocationLocationIdJSONBody
 
+// PostV1PlyLoginJSONRequestBody defines body for PostV1PlyLogin for application/json ContentType.
+type PostV1PlyLoginJSONRequestBody PostV1PlyLoginJSONBody
+
+// PostV1PlyLoginMfaJSONRequestBody defines body for PostV1PlyLoginMfa for application/json ContentType.
+type PostV1PlyLoginMfaJSONRequestBody PostV1PlyLoginMfaJSONBody
+
+// PostV1PlyMeMfaConfirmJSONRequestBody defines body for PostV1PlyMeMfaConfirm for application/json ContentType.
+type PostV1PlyMeMfaConfirmJSONRequestBody PostV1PlyMeMfaConfirmJSONBody
+
+// PostV1PlyMeMfaDisableJSONRequestBody defines body for PostV1PlyMeMfaDisable for application/json ContentType.
+type PostV1PlyMeMfaDisableJSONRequestBody PostV1PlyMeMfaDisableJSONBody
+
+// PostV1PlyMeMfaRecoveryJSONRequestBody defines body for PostV1PlyMeMfaRecovery for application/json ContentType.
+type PostV1PlyMeMfaRecoveryJSONRequestBody PostV1PlyMeMfaRecoveryJSONBody
+
+// PostV1PlyMePasswordJSONRequestBody defines body for PostV1PlyMePassword for application/json ContentType.
+type PostV1PlyMePasswordJSONRequestBody PostV1PlyMePasswordJSONBody
+
+// PostV1PlyOrganizationJSONRequestBody defines body for PostV1PlyOrganization for application/json ContentType.
+type PostV1PlyOrganizationJSONRequestBody PostV1PlyOrganizationJSONBody
+
+// PostV1PlyOrganizationOrganizationIdJSONRequestBody defines body for PostV1PlyOrganizationOrganizationId for application/json ContentType.
+type PostV1PlyOrganizationOrganizationIdJSONRequestBody PostV1PlyOrganizationOrganizationIdJSONBody
+
+// PostV1PlyPasswordResetJSONRequestBody defines body for PostV1PlyPasswordReset for application/json ContentType.
+type PostV1PlyPasswordResetJSONRequestBody PostV1PlyPasswordResetJSONBody
+
+// PostV1PlyPasswordResetConfirmJSONRequestBody defines body for PostV1PlyPasswordResetConfirm for application/json ContentType.
+type PostV1PlyPasswordResetConfirmJSONRequestBody PostV1PlyPasswordResetConfirmJSONBody
+
 // PostV1PlyPracticeJSONRequestBody defines body for PostV1PlyPractice for application/json ContentType.
 type PostV1PlyPracticeJSONRequestBody PostV1PlyPracticeJSONBody
 
@@ -132,23 +501,113 @@ type PostV1PlyPracticePracticeIdJSONRequestBody PostV1PlyPracticePracticeIdJSONB
 // PostV1PlyPracticePracticeIdUploadMultipartRequestBody defines body for PostV1PlyPracticePracticeIdUpload for multipart/form-data ContentType.
 type PostV1PlyPracticePracticeIdUploadMultipartRequestBody PostV1PlyPracticePracticeIdUploadMultipartBody
 
+// PostV1PlyPracticePracticeIdUploadResumableJSONRequestBody defines body for PostV1PlyPracticePracticeIdUploadResumable for application/json ContentType.
+type PostV1PlyPracticePracticeIdUploadResumableJSONRequestBody PostV1PlyPracticePracticeIdUploadResumableJSONBody
+
 // PostV1PlyProviderJSONRequestBody defines body for PostV1PlyProvider for application/json ContentType.
 type PostV1PlyProviderJSONRequestBody PostV1PlyProviderJSONBody
 
 // PostV1PlyProviderProviderIdJSONRequestBody defines body for PostV1PlyProviderProviderId for application/json ContentType.
 type PostV1PlyProviderProviderIdJSONRequestBody PostV1PlyProviderProviderIdJSONBody
 
+// PostV1PlyProviderProviderIdAffiliationJSONRequestBody defines body for PostV1PlyProviderProviderIdAffiliation for application/json ContentType.
+type PostV1PlyProviderProviderIdAffiliationJSONRequestBody PostV1PlyProviderProviderIdAffiliationJSONBody
+
 // PostV1PlyTaskTaskIdJSONRequestBody defines body for PostV1PlyTaskTaskId for application/json ContentType.
 type PostV1PlyTaskTaskIdJSONRequestBody PostV1PlyTaskTaskIdJSONBody
 
+// PostV1PlyUserInvitationJSONRequestBody defines body for PostV1PlyUserInvitation for application/json ContentType.
+type PostV1PlyUserInvitationJSONRequestBody PostV1PlyUserInvitationJSONBody
+
+// PostV1PlyUserInvitationAcceptJSONRequestBody defines body for PostV1PlyUserInvitationAccept for application/json ContentType.
+type PostV1PlyUserInvitationAcceptJSONRequestBody PostV1PlyUserInvitationAcceptJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Verify stored documents against their checksums
+	// (POST /v1/ply/admin/document/verify)
+	PostV1PlyAdminDocumentVerify(w http.ResponseWriter, r *http.Request, params PostV1PlyAdminDocumentVerifyParams)
+	// List role grants
+	// (GET /v1/ply/admin/grant)
+	GetV1PlyAdminGrant(w http.ResponseWriter, r *http.Request, params GetV1PlyAdminGrantParams)
+	// Grant a role
+	// (POST /v1/ply/admin/grant)
+	PostV1PlyAdminGrant(w http.ResponseWriter, r *http.Request)
+	// Revoke a role grant
+	// (DELETE /v1/ply/admin/grant/{grantId})
+	DeleteV1PlyAdminGrantGrantId(w http.ResponseWriter, r *http.Request, grantId string)
+	// Get the MFA policy
+	// (GET /v1/ply/admin/mfa/policy)
+	GetV1PlyAdminMfaPolicy(w http.ResponseWriter, r *http.Request)
+	// Set the MFA policy
+	// (POST /v1/ply/admin/mfa/policy)
+	PostV1PlyAdminMfaPolicy(w http.ResponseWriter, r *http.Request)
+	// Reconcile stored files with document records
+	// (POST /v1/ply/admin/storage/reconcile)
+	PostV1PlyAdminStorageReconcile(w http.ResponseWriter, r *http.Request, params PostV1PlyAdminStorageReconcileParams)
+	// List users
+	// (GET /v1/ply/admin/user)
+	GetV1PlyAdminUser(w http.ResponseWriter, r *http.Request)
+	// Disable a user
+	// (POST /v1/ply/admin/user/{userId}/disable)
+	PostV1PlyAdminUserUserIdDisable(w http.ResponseWriter, r *http.Request, userId string)
+	// Reset a user's multi-factor authentication
+	// (POST /v1/ply/admin/user/{userId}/mfa/reset)
+	PostV1PlyAdminUserUserIdMfaReset(w http.ResponseWriter, r *http.Request, userId string)
+	// Delete an affiliation
+	// (DELETE /v1/ply/affiliation/{affiliationId})
+	DeleteV1PlyAffiliationAffiliationId(w http.ResponseWriter, r *http.Request, affiliationId string)
+	// Read an affiliation
+	// (GET /v1/ply/affiliation/{affiliationId})
+	GetV1PlyAffiliationAffiliationId(w http.ResponseWriter, r *http.Request, affiliationId string)
+	// Update an affiliation
+	// (POST /v1/ply/affiliation/{affiliationId})
+	PostV1PlyAffiliationAffiliationId(w http.ResponseWriter, r *http.Request, affiliationId string)
 	// Delete a document
 	// (DELETE /v1/ply/document/{documentId})
 	DeleteV1PlyDocumentDocumentId(w http.ResponseWriter, r *http.Request, documentId string)
 	// Get a document by ID
 	// (GET /v1/ply/document/{documentId})
-	GetV1PlyDocumentDocumentId(w http.ResponseWriter, r *http.Request, documentId string)
+	GetV1PlyDocumentDocumentId(w http.ResponseWriter, r *http.Request, documentId string, params GetV1PlyDocumentDocumentIdParams)
+	// Read the fields extracted from a document
+	// (GET /v1/ply/document/{documentId}/extraction)
+	GetV1PlyDocumentDocumentIdExtraction(w http.ResponseWriter, r *http.Request, documentId string)
+	// Queue a document for field extraction again
+	// (POST /v1/ply/document/{documentId}/extraction)
+	PostV1PlyDocumentDocumentIdExtraction(w http.ResponseWriter, r *http.Request, documentId string)
+	// Apply extracted fields to provider and practice records
+	// (POST /v1/ply/document/{documentId}/extraction/apply)
+	PostV1PlyDocumentDocumentIdExtractionApply(w http.ResponseWriter, r *http.Request, documentId string)
+	// List a document's shared links
+	// (GET /v1/ply/document/{documentId}/link)
+	GetV1PlyDocumentDocumentIdLink(w http.ResponseWriter, r *http.Request, documentId string)
+	// Create a signed link to a document
+	// (POST /v1/ply/document/{documentId}/link)
+	PostV1PlyDocumentDocumentIdLink(w http.ResponseWriter, r *http.Request, documentId string)
+	// Revoke a shared link
+	// (DELETE /v1/ply/document/{documentId}/link/{linkId})
+	DeleteV1PlyDocumentDocumentIdLinkLinkId(w http.ResponseWriter, r *http.Request, documentId string, linkId string)
+	// Read a document's metadata
+	// (GET /v1/ply/document/{documentId}/metadata)
+	GetV1PlyDocumentDocumentIdMetadata(w http.ResponseWriter, r *http.Request, documentId string)
+	// Update a document's metadata
+	// (POST /v1/ply/document/{documentId}/metadata)
+	PostV1PlyDocumentDocumentIdMetadata(w http.ResponseWriter, r *http.Request, documentId string)
+	// Download a preview image of a document
+	// (GET /v1/ply/document/{documentId}/preview)
+	GetV1PlyDocumentDocumentIdPreview(w http.ResponseWriter, r *http.Request, documentId string)
+	// List a document's versions
+	// (GET /v1/ply/document/{documentId}/version)
+	GetV1PlyDocumentDocumentIdVersion(w http.ResponseWriter, r *http.Request, documentId string)
+	// Upload a new version of a document
+	// (POST /v1/ply/document/{documentId}/version)
+	PostV1PlyDocumentDocumentIdVersion(w http.ResponseWriter, r *http.Request, documentId string)
+	// Get a version of a document
+	// (GET /v1/ply/document/{documentId}/version/{version})
+	GetV1PlyDocumentDocumentIdVersionVersion(w http.ResponseWriter, r *http.Request, documentId string, version int, params GetV1PlyDocumentDocumentIdVersionVersionParams)
+	// Make a version of a document current
+	// (POST /v1/ply/document/{documentId}/version/{version}/current)
+	PostV1PlyDocumentDocumentIdVersionVersionCurrent(w http.ResponseWriter, r *http.Request, documentId string, version int)
 	// Create an enrollment
 	// (POST /v1/ply/enrollment)
 	PostV1PlyEnrollment(w http.ResponseWriter, r *http.Request)
@@ -164,6 +623,9 @@ type ServerInterface interface {
 	// List activities
 	// (GET /v1/ply/enrollment/{enrollmentId}/activity)
 	GetV1PlyEnrollmentEnrollmentIdActivity(w http.ResponseWriter, r *http.Request, enrollmentId string)
+	// List individual enrollments that depend on a group enrollment
+	// (GET /v1/ply/enrollment/{enrollmentId}/dependents)
+	GetV1PlyEnrollmentEnrollmentIdDependents(w http.ResponseWriter, r *http.Request, enrollmentId string)
 	// Create a location
 	// (POST /v1/ply/location)
 	PostV1PlyLocation(w http.ResponseWriter, r *http.Request)
@@ -176,6 +638,60 @@ type ServerInterface interface {
 	// Update a location
 	// (POST /v1/ply/location/{locationId})
 	PostV1PlyLocationLocationId(w http.ResponseWriter, r *http.Request, locationId string)
+	// Log in with an email and password
+	// (POST /v1/ply/login)
+	PostV1PlyLogin(w http.ResponseWriter, r *http.Request)
+	// Complete a login with a second factor
+	// (POST /v1/ply/login/mfa)
+	PostV1PlyLoginMfa(w http.ResponseWriter, r *http.Request)
+	// Read the caller's identity
+	// (GET /v1/ply/me)
+	GetV1PlyMe(w http.ResponseWriter, r *http.Request)
+	// Start enrolling in multi-factor authentication
+	// (POST /v1/ply/me/mfa)
+	PostV1PlyMeMfa(w http.ResponseWriter, r *http.Request)
+	// Confirm multi-factor enrollment
+	// (POST /v1/ply/me/mfa/confirm)
+	PostV1PlyMeMfaConfirm(w http.ResponseWriter, r *http.Request)
+	// Disable multi-factor authentication
+	// (POST /v1/ply/me/mfa/disable)
+	PostV1PlyMeMfaDisable(w http.ResponseWriter, r *http.Request)
+	// Replace recovery codes
+	// (POST /v1/ply/me/mfa/recovery)
+	PostV1PlyMeMfaRecovery(w http.ResponseWriter, r *http.Request)
+	// Change the caller's password
+	// (POST /v1/ply/me/password)
+	PostV1PlyMePassword(w http.ResponseWriter, r *http.Request)
+	// Create an organization
+	// (POST /v1/ply/organization)
+	PostV1PlyOrganization(w http.ResponseWriter, r *http.Request)
+	// List organizations
+	// (GET /v1/ply/organization/list)
+	GetV1PlyOrganizationList(w http.ResponseWriter, r *http.Request)
+	// Read an organization
+	// (GET /v1/ply/organization/{organizationId})
+	GetV1PlyOrganizationOrganizationId(w http.ResponseWriter, r *http.Request, organizationId string)
+	// Update an organization
+	// (POST /v1/ply/organization/{organizationId})
+	PostV1PlyOrganizationOrganizationId(w http.ResponseWriter, r *http.Request, organizationId string)
+	// List enrollments across an organization
+	// (GET /v1/ply/organization/{organizationId}/enrollment)
+	GetV1PlyOrganizationOrganizationIdEnrollment(w http.ResponseWriter, r *http.Request, organizationId string)
+	// List practices in an organization
+	// (GET /v1/ply/organization/{organizationId}/practice)
+	GetV1PlyOrganizationOrganizationIdPractice(w http.ResponseWriter, r *http.Request, organizationId string)
+	// List providers across an organization
+	// (GET /v1/ply/organization/{organizationId}/provider)
+	GetV1PlyOrganizationOrganizationIdProvider(w http.ResponseWriter, r *http.Request, organizationId string)
+	// List open tasks across an organization
+	// (GET /v1/ply/organization/{organizationId}/task)
+	GetV1PlyOrganizationOrganizationIdTask(w http.ResponseWriter, r *http.Request, organizationId string)
+	// Request a password reset
+	// (POST /v1/ply/password/reset)
+	PostV1PlyPasswordReset(w http.ResponseWriter, r *http.Request)
+	// Reset a password
+	// (POST /v1/ply/password/reset/confirm)
+	PostV1PlyPasswordResetConfirm(w http.ResponseWriter, r *http.Request)
 	// Create a practice
 	// (POST /v1/ply/practice)
 	PostV1PlyPractice(w http.ResponseWriter, r *http.Request)
@@ -190,10 +706,19 @@ type ServerInterface interface {
 	PostV1PlyPracticePracticeId(w http.ResponseWriter, r *http.Request, practiceId string)
 	// List documents
 	// (GET /v1/ply/practice/{practiceId}/document)
-	GetV1PlyPracticePracticeIdDocument(w http.ResponseWriter, r *http.Request, practiceId string)
+	GetV1PlyPracticePracticeIdDocument(w http.ResponseWriter, r *http.Request, practiceId string, params GetV1PlyPracticePracticeIdDocumentParams)
+	// Download a practice's documents as a ZIP archive
+	// (GET /v1/ply/practice/{practiceId}/document/archive)
+	GetV1PlyPracticePracticeIdDocumentArchive(w http.ResponseWriter, r *http.Request, practiceId string, params GetV1PlyPracticePracticeIdDocumentArchiveParams)
+	// Search the text of a practice's documents
+	// (GET /v1/ply/practice/{practiceId}/document/search)
+	GetV1PlyPracticePracticeIdDocumentSearch(w http.ResponseWriter, r *http.Request, practiceId string, params GetV1PlyPracticePracticeIdDocumentSearchParams)
 	// List enrollments
 	// (GET /v1/ply/practice/{practiceId}/enrollment)
 	GetV1PlyPracticePracticeIdEnrollment(w http.ResponseWriter, r *http.Request, practiceId string)
+	// Report likely duplicate enrollments
+	// (GET /v1/ply/practice/{practiceId}/enrollment/duplicates)
+	GetV1PlyPracticePracticeIdEnrollmentDuplicates(w http.ResponseWriter, r *http.Request, practiceId string)
 	// List locations
 	// (GET /v1/ply/practice/{practiceId}/location)
 	GetV1PlyPracticePracticeIdLocation(w http.ResponseWriter, r *http.Request, practiceId string)
@@ -206,6 +731,12 @@ type ServerInterface interface {
 	// Upload a document for a practice
 	// (POST /v1/ply/practice/{practiceId}/upload)
 	PostV1PlyPracticePracticeIdUpload(w http.ResponseWriter, r *http.Request, practiceId string)
+	// Start a resumable upload for a practice
+	// (POST /v1/ply/practice/{practiceId}/upload/resumable)
+	PostV1PlyPracticePracticeIdUploadResumable(w http.ResponseWriter, r *http.Request, practiceId string)
+	// Get a practice's document storage usage
+	// (GET /v1/ply/practice/{practiceId}/usage)
+	GetV1PlyPracticePracticeIdUsage(w http.ResponseWriter, r *http.Request, practiceId string)
 	// Create a provider
 	// (POST /v1/ply/provider)
 	PostV1PlyProvider(w http.ResponseWriter, r *http.Request)
@@ -218,15 +749,123 @@ type ServerInterface interface {
 	// Update a provider
 	// (POST /v1/ply/provider/{providerId})
 	PostV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request, providerId string)
+	// List affiliations for a provider
+	// (GET /v1/ply/provider/{providerId}/affiliation)
+	GetV1PlyProviderProviderIdAffiliation(w http.ResponseWriter, r *http.Request, providerId string)
+	// Affiliate a provider with a practice
+	// (POST /v1/ply/provider/{providerId}/affiliation)
+	PostV1PlyProviderProviderIdAffiliation(w http.ResponseWriter, r *http.Request, providerId string)
+	// List enrollments due for revalidation
+	// (GET /v1/ply/report/revalidation)
+	GetV1PlyReportRevalidation(w http.ResponseWriter, r *http.Request, params GetV1PlyReportRevalidationParams)
+	// Download a document through a shared link
+	// (GET /v1/ply/shared/{linkId})
+	GetV1PlySharedLinkId(w http.ResponseWriter, r *http.Request, linkId string, params GetV1PlySharedLinkIdParams)
 	// Update a task
 	// (POST /v1/ply/task/{taskId})
 	PostV1PlyTaskTaskId(w http.ResponseWriter, r *http.Request, taskId string)
+	// Abandon a resumable upload
+	// (DELETE /v1/ply/upload/{uploadId})
+	DeleteV1PlyUploadUploadId(w http.ResponseWriter, r *http.Request, uploadId string)
+	// Read a resumable upload's progress
+	// (GET /v1/ply/upload/{uploadId})
+	GetV1PlyUploadUploadId(w http.ResponseWriter, r *http.Request, uploadId string)
+	// Send the next chunk of a resumable upload
+	// (PATCH /v1/ply/upload/{uploadId})
+	PatchV1PlyUploadUploadId(w http.ResponseWriter, r *http.Request, uploadId string, params PatchV1PlyUploadUploadIdParams)
+	// Finish a resumable upload
+	// (POST /v1/ply/upload/{uploadId}/finalize)
+	PostV1PlyUploadUploadIdFinalize(w http.ResponseWriter, r *http.Request, uploadId string)
+	// Invite a user
+	// (POST /v1/ply/user/invitation)
+	PostV1PlyUserInvitation(w http.ResponseWriter, r *http.Request)
+	// Accept an invitation
+	// (POST /v1/ply/user/invitation/accept)
+	PostV1PlyUserInvitationAccept(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Verify stored documents against their checksums
+// (POST /v1/ply/admin/document/verify)
+func (_ Unimplemented) PostV1PlyAdminDocumentVerify(w http.ResponseWriter, r *http.Request, params PostV1PlyAdminDocumentVerifyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List role grants
+// (GET /v1/ply/admin/grant)
+func (_ Unimplemented) GetV1PlyAdminGrant(w http.ResponseWriter, r *http.Request, params GetV1PlyAdminGrantParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Grant a role
+// (POST /v1/ply/admin/grant)
+func (_ Unimplemented) PostV1PlyAdminGrant(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke a role grant
+// (DELETE /v1/ply/admin/grant/{grantId})
+func (_ Unimplemented) DeleteV1PlyAdminGrantGrantId(w http.ResponseWriter, r *http.Request, grantId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the MFA policy
+// (GET /v1/ply/admin/mfa/policy)
+func (_ Unimplemented) GetV1PlyAdminMfaPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the MFA policy
+// (POST /v1/ply/admin/mfa/policy)
+func (_ Unimplemented) PostV1PlyAdminMfaPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reconcile stored files with document records
+// (POST /v1/ply/admin/storage/reconcile)
+func (_ Unimplemented) PostV1PlyAdminStorageReconcile(w http.ResponseWriter, r *http.Request, params PostV1PlyAdminStorageReconcileParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List users
+// (GET /v1/ply/admin/user)
+func (_ Unimplemented) GetV1PlyAdminUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Disable a user
+// (POST /v1/ply/admin/user/{userId}/disable)
+func (_ Unimplemented) PostV1PlyAdminUserUserIdDisable(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reset a user's multi-factor authentication
+// (POST /v1/ply/admin/user/{userId}/mfa/reset)
+func (_ Unimplemented) PostV1PlyAdminUserUserIdMfaReset(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete an affiliation
+// (DELETE /v1/ply/affiliation/{affiliationId})
+func (_ Unimplemented) DeleteV1PlyAffiliationAffiliationId(w http.ResponseWriter, r *http.Request, affiliationId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Read an affiliation
+// (GET /v1/ply/affiliation/{affiliationId})
+func (_ Unimplemented) GetV1PlyAffiliationAffiliationId(w http.ResponseWriter, r *http.Request, affiliationId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update an affiliation
+// (POST /v1/ply/affiliation/{affiliationId})
+func (_ Unimplemented) PostV1PlyAffiliationAffiliationId(w http.ResponseWriter, r *http.Request, affiliationId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete a document
 // (DELETE /v1/ply/document/{documentId})
 func (_ Unimplemented) DeleteV1PlyDocumentDocumentId(w http.ResponseWriter, r *http.Request, documentId string) {
@@ -235,7 +874,85 @@ func (_ Unimplemented) DeleteV1PlyDocumentDocumentId(w http.ResponseWriter, r *h
 
 // Get a document by ID
 // (GET /v1/ply/document/{documentId})
-func (_ Unimplemented) GetV1PlyDocumentDocumentId(w http.ResponseWriter, r *http.Request, documentId string) {
+func (_ Unimplemented) GetV1PlyDocumentDocumentId(w http.ResponseWriter, r *http.Request, documentId string, params GetV1PlyDocumentDocumentIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Read the fields extracted from a document
+// (GET /v1/ply/document/{documentId}/extraction)
+func (_ Unimplemented) GetV1PlyDocumentDocumentIdExtraction(w http.ResponseWriter, r *http.Request, documentId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Queue a document for field extraction again
+// (POST /v1/ply/document/{documentId}/extraction)
+func (_ Unimplemented) PostV1PlyDocumentDocumentIdExtraction(w http.ResponseWriter, r *http.Request, documentId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Apply extracted fields to provider and practice records
+// (POST /v1/ply/document/{documentId}/extraction/apply)
+func (_ Unimplemented) PostV1PlyDocumentDocumentIdExtractionApply(w http.ResponseWriter, r *http.Request, documentId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List a document's shared links
+// (GET /v1/ply/document/{documentId}/link)
+func (_ Unimplemented) GetV1PlyDocumentDocumentIdLink(w http.ResponseWriter, r *http.Request, documentId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a signed link to a document
+// (POST /v1/ply/document/{documentId}/link)
+func (_ Unimplemented) PostV1PlyDocumentDocumentIdLink(w http.ResponseWriter, r *http.Request, documentId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke a shared link
+// (DELETE /v1/ply/document/{documentId}/link/{linkId})
+func (_ Unimplemented) DeleteV1PlyDocumentDocumentIdLinkLinkId(w http.ResponseWriter, r *http.Request, documentId string, linkId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Read a document's metadata
+// (GET /v1/ply/document/{documentId}/metadata)
+func (_ Unimplemented) GetV1PlyDocumentDocumentIdMetadata(w http.ResponseWriter, r *http.Request, documentId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a document's metadata
+// (POST /v1/ply/document/{documentId}/metadata)
+func (_ Unimplemented) PostV1PlyDocumentDocumentIdMetadata(w http.ResponseWriter, r *http.Request, documentId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a preview image of a document
+// (GET /v1/ply/document/{documentId}/preview)
+func (_ Unimplemented) GetV1PlyDocumentDocumentIdPreview(w http.ResponseWriter, r *http.Request, documentId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List a document's versions
+// (GET /v1/ply/document/{documentId}/version)
+func (_ Unimplemented) GetV1PlyDocumentDocumentIdVersion(w http.ResponseWriter, r *http.Request, documentId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload a new version of a document
+// (POST /v1/ply/document/{documentId}/version)
+func (_ Unimplemented) PostV1PlyDocumentDocumentIdVersion(w http.ResponseWriter, r *http.Request, documentId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a version of a document
+// (GET /v1/ply/document/{documentId}/version/{version})
+func (_ Unimplemented) GetV1PlyDocumentDocumentIdVersionVersion(w http.ResponseWriter, r *http.Request, documentId string, version int, params GetV1PlyDocumentDocumentIdVersionVersionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Make a version of a document current
+// (POST /v1/ply/document/{documentId}/version/{version}/current)
+func (_ Unimplemented) PostV1PlyDocumentDocumentIdVersionVersionCurrent(w http.ResponseWriter, r *http.Request, documentId string, version int) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -269,6 +986,12 @@ func (_ Unimplemented) GetV1PlyEnrollmentEnrollmentIdActivity(w http.ResponseWri
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List individual enrollments that depend on a group enrollment
+// (GET /v1/ply/enrollment/{enrollmentId}/dependents)
+func (_ Unimplemented) GetV1PlyEnrollmentEnrollmentIdDependents(w http.ResponseWriter, r *http.Request, enrollmentId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Create a location
 // (POST /v1/ply/location)
 func (_ Unimplemented) PostV1PlyLocation(w http.ResponseWriter, r *http.Request) {
@@ -293,122 +1016,326 @@ func (_ Unimplemented) PostV1PlyLocationLocationId(w http.ResponseWriter, r *htt
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create a practice
-// (POST /v1/ply/practice)
-func (_ Unimplemented) PostV1PlyPractice(w http.ResponseWriter, r *http.Request) {
+// Log in with an email and password
+// (POST /v1/ply/login)
+func (_ Unimplemented) PostV1PlyLogin(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List practices
-// (GET /v1/ply/practice/list)
-func (_ Unimplemented) GetV1PlyPracticeList(w http.ResponseWriter, r *http.Request) {
+// Complete a login with a second factor
+// (POST /v1/ply/login/mfa)
+func (_ Unimplemented) PostV1PlyLoginMfa(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Read a practice
-// (GET /v1/ply/practice/{practiceId})
-func (_ Unimplemented) GetV1PlyPracticePracticeId(w http.ResponseWriter, r *http.Request, practiceId string) {
+// Read the caller's identity
+// (GET /v1/ply/me)
+func (_ Unimplemented) GetV1PlyMe(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update a practice
-// (POST /v1/ply/practice/{practiceId})
-func (_ Unimplemented) PostV1PlyPracticePracticeId(w http.ResponseWriter, r *http.Request, practiceId string) {
+// Start enrolling in multi-factor authentication
+// (POST /v1/ply/me/mfa)
+func (_ Unimplemented) PostV1PlyMeMfa(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List documents
-// (GET /v1/ply/practice/{practiceId}/document)
-func (_ Unimplemented) GetV1PlyPracticePracticeIdDocument(w http.ResponseWriter, r *http.Request, practiceId string) {
+// Confirm multi-factor enrollment
+// (POST /v1/ply/me/mfa/confirm)
+func (_ Unimplemented) PostV1PlyMeMfaConfirm(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List enrollments
-// (GET /v1/ply/practice/{practiceId}/enrollment)
-func (_ Unimplemented) GetV1PlyPracticePracticeIdEnrollment(w http.ResponseWriter, r *http.Request, practiceId string) {
+// Disable multi-factor authentication
+// (POST /v1/ply/me/mfa/disable)
+func (_ Unimplemented) PostV1PlyMeMfaDisable(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List locations
-// (GET /v1/ply/practice/{practiceId}/location)
-func (_ Unimplemented) GetV1PlyPracticePracticeIdLocation(w http.ResponseWriter, r *http.Request, practiceId string) {
+// Replace recovery codes
+// (POST /v1/ply/me/mfa/recovery)
+func (_ Unimplemented) PostV1PlyMeMfaRecovery(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List providers
-// (GET /v1/ply/practice/{practiceId}/provider)
-func (_ Unimplemented) GetV1PlyPracticePracticeIdProvider(w http.ResponseWriter, r *http.Request, practiceId string) {
+// Change the caller's password
+// (POST /v1/ply/me/password)
+func (_ Unimplemented) PostV1PlyMePassword(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List tasks
-// (GET /v1/ply/practice/{practiceId}/task)
-func (_ Unimplemented) GetV1PlyPracticePracticeIdTask(w http.ResponseWriter, r *http.Request, practiceId string) {
+// Create an organization
+// (POST /v1/ply/organization)
+func (_ Unimplemented) PostV1PlyOrganization(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Upload a document for a practice
-// (POST /v1/ply/practice/{practiceId}/upload)
-func (_ Unimplemented) PostV1PlyPracticePracticeIdUpload(w http.ResponseWriter, r *http.Request, practiceId string) {
+// List organizations
+// (GET /v1/ply/organization/list)
+func (_ Unimplemented) GetV1PlyOrganizationList(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create a provider
-// (POST /v1/ply/provider)
-func (_ Unimplemented) PostV1PlyProvider(w http.ResponseWriter, r *http.Request) {
+// Read an organization
+// (GET /v1/ply/organization/{organizationId})
+func (_ Unimplemented) GetV1PlyOrganizationOrganizationId(w http.ResponseWriter, r *http.Request, organizationId string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete a provider
-// (DELETE /v1/ply/provider/{providerId})
-func (_ Unimplemented) DeleteV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request, providerId string) {
+// Update an organization
+// (POST /v1/ply/organization/{organizationId})
+func (_ Unimplemented) PostV1PlyOrganizationOrganizationId(w http.ResponseWriter, r *http.Request, organizationId string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Read a provider
-// (GET /v1/ply/provider/{providerId})
-func (_ Unimplemented) GetV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request, providerId string) {
+// List enrollments across an organization
+// (GET /v1/ply/organization/{organizationId}/enrollment)
+func (_ Unimplemented) GetV1PlyOrganizationOrganizationIdEnrollment(w http.ResponseWriter, r *http.Request, organizationId string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update a provider
-// (POST /v1/ply/provider/{providerId})
-func (_ Unimplemented) PostV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request, providerId string) {
+// List practices in an organization
+// (GET /v1/ply/organization/{organizationId}/practice)
+func (_ Unimplemented) GetV1PlyOrganizationOrganizationIdPractice(w http.ResponseWriter, r *http.Request, organizationId string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update a task
-// (POST /v1/ply/task/{taskId})
-func (_ Unimplemented) PostV1PlyTaskTaskId(w http.ResponseWriter, r *http.Request, taskId string) {
+// List providers across an organization
+// (GET /v1/ply/organization/{organizationId}/provider)
+func (_ Unimplemented) GetV1PlyOrganizationOrganizationIdProvider(w http.ResponseWriter, r *http.Request, organizationId string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// List open tasks across an organization
+// (GET /v1/ply/organization/{organizationId}/task)
+func (_ Unimplemented) GetV1PlyOrganizationOrganizationIdTask(w http.ResponseWriter, r *http.Request, organizationId string) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// Request a password reset
+// (POST /v1/ply/password/reset)
+func (_ Unimplemented) PostV1PlyPasswordReset(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-// DeleteV1PlyDocumentDocumentId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteV1PlyDocumentDocumentId(w http.ResponseWriter, r *http.Request) {
+// Reset a password
+// (POST /v1/ply/password/reset/confirm)
+func (_ Unimplemented) PostV1PlyPasswordResetConfirm(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a practice
+// (POST /v1/ply/practice)
+func (_ Unimplemented) PostV1PlyPractice(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List practices
+// (GET /v1/ply/practice/list)
+func (_ Unimplemented) GetV1PlyPracticeList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Read a practice
+// (GET /v1/ply/practice/{practiceId})
+func (_ Unimplemented) GetV1PlyPracticePracticeId(w http.ResponseWriter, r *http.Request, practiceId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a practice
+// (POST /v1/ply/practice/{practiceId})
+func (_ Unimplemented) PostV1PlyPracticePracticeId(w http.ResponseWriter, r *http.Request, practiceId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List documents
+// (GET /v1/ply/practice/{practiceId}/document)
+func (_ Unimplemented) GetV1PlyPracticePracticeIdDocument(w http.ResponseWriter, r *http.Request, practiceId string, params GetV1PlyPracticePracticeIdDocumentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a practice's documents as a ZIP archive
+// (GET /v1/ply/practice/{practiceId}/document/archive)
+func (_ Unimplemented) GetV1PlyPracticePracticeIdDocumentArchive(w http.ResponseWriter, r *http.Request, practiceId string, params GetV1PlyPracticePracticeIdDocumentArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search the text of a practice's documents
+// (GET /v1/ply/practice/{practiceId}/document/search)
+func (_ Unimplemented) GetV1PlyPracticePracticeIdDocumentSearch(w http.ResponseWriter, r *http.Request, practiceId string, params GetV1PlyPracticePracticeIdDocumentSearchParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List enrollments
+// (GET /v1/ply/practice/{practiceId}/enrollment)
+func (_ Unimplemented) GetV1PlyPracticePracticeIdEnrollment(w http.ResponseWriter, r *http.Request, practiceId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Report likely duplicate enrollments
+// (GET /v1/ply/practice/{practiceId}/enrollment/duplicates)
+func (_ Unimplemented) GetV1PlyPracticePracticeIdEnrollmentDuplicates(w http.ResponseWriter, r *http.Request, practiceId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List locations
+// (GET /v1/ply/practice/{practiceId}/location)
+func (_ Unimplemented) GetV1PlyPracticePracticeIdLocation(w http.ResponseWriter, r *http.Request, practiceId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List providers
+// (GET /v1/ply/practice/{practiceId}/provider)
+func (_ Unimplemented) GetV1PlyPracticePracticeIdProvider(w http.ResponseWriter, r *http.Request, practiceId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List tasks
+// (GET /v1/ply/practice/{practiceId}/task)
+func (_ Unimplemented) GetV1PlyPracticePracticeIdTask(w http.ResponseWriter, r *http.Request, practiceId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload a document for a practice
+// (POST /v1/ply/practice/{practiceId}/upload)
+func (_ Unimplemented) PostV1PlyPracticePracticeIdUpload(w http.ResponseWriter, r *http.Request, practiceId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a resumable upload for a practice
+// (POST /v1/ply/practice/{practiceId}/upload/resumable)
+func (_ Unimplemented) PostV1PlyPracticePracticeIdUploadResumable(w http.ResponseWriter, r *http.Request, practiceId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a practice's document storage usage
+// (GET /v1/ply/practice/{practiceId}/usage)
+func (_ Unimplemented) GetV1PlyPracticePracticeIdUsage(w http.ResponseWriter, r *http.Request, practiceId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a provider
+// (POST /v1/ply/provider)
+func (_ Unimplemented) PostV1PlyProvider(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a provider
+// (DELETE /v1/ply/provider/{providerId})
+func (_ Unimplemented) DeleteV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request, providerId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Read a provider
+// (GET /v1/ply/provider/{providerId})
+func (_ Unimplemented) GetV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request, providerId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a provider
+// (POST /v1/ply/provider/{providerId})
+func (_ Unimplemented) PostV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request, providerId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List affiliations for a provider
+// (GET /v1/ply/provider/{providerId}/affiliation)
+func (_ Unimplemented) GetV1PlyProviderProviderIdAffiliation(w http.ResponseWriter, r *http.Request, providerId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Affiliate a provider with a practice
+// (POST /v1/ply/provider/{providerId}/affiliation)
+func (_ Unimplemented) PostV1PlyProviderProviderIdAffiliation(w http.ResponseWriter, r *http.Request, providerId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List enrollments due for revalidation
+// (GET /v1/ply/report/revalidation)
+func (_ Unimplemented) GetV1PlyReportRevalidation(w http.ResponseWriter, r *http.Request, params GetV1PlyReportRevalidationParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a document through a shared link
+// (GET /v1/ply/shared/{linkId})
+func (_ Unimplemented) GetV1PlySharedLinkId(w http.ResponseWriter, r *http.Request, linkId string, params GetV1PlySharedLinkIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a task
+// (POST /v1/ply/task/{taskId})
+func (_ Unimplemented) PostV1PlyTaskTaskId(w http.ResponseWriter, r *http.Request, taskId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Abandon a resumable upload
+// (DELETE /v1/ply/upload/{uploadId})
+func (_ Unimplemented) DeleteV1PlyUploadUploadId(w http.ResponseWriter, r *http.Request, uploadId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Read a resumable upload's progress
+// (GET /v1/ply/upload/{uploadId})
+func (_ Unimplemented) GetV1PlyUploadUploadId(w http.ResponseWriter, r *http.Request, uploadId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send the next chunk of a resumable upload
+// (PATCH /v1/ply/upload/{uploadId})
+func (_ Unimplemented) PatchV1PlyUploadUploadId(w http.ResponseWriter, r *http.Request, uploadId string, params PatchV1PlyUploadUploadIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Finish a resumable upload
+// (POST /v1/ply/upload/{uploadId}/finalize)
+func (_ Unimplemented) PostV1PlyUploadUploadIdFinalize(w http.ResponseWriter, r *http.Request, uploadId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Invite a user
+// (POST /v1/ply/user/invitation)
+func (_ Unimplemented) PostV1PlyUserInvitation(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Accept an invitation
+// (POST /v1/ply/user/invitation/accept)
+func (_ Unimplemented) PostV1PlyUserInvitationAccept(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// PostV1PlyAdminDocumentVerify operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyAdminDocumentVerify(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "documentId" -------------
-	var documentId string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "documentId", runtime.ParamLocationPath, chi.URLParam(r, "documentId"), &documentId)
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV1PlyAdminDocumentVerifyParams
+
+	// ------------- Optional query parameter "practiceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "practiceId", r.URL.Query(), &params.PracticeId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteV1PlyDocumentDocumentId(w, r, documentId)
+		siw.Handler.PostV1PlyAdminDocumentVerify(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -418,23 +1345,37 @@ func (siw *ServerInterfaceWrapper) DeleteV1PlyDocumentDocumentId(w http.Response
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV1PlyDocumentDocumentId operation middleware
-func (siw *ServerInterfaceWrapper) GetV1PlyDocumentDocumentId(w http.ResponseWriter, r *http.Request) {
+// GetV1PlyAdminGrant operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyAdminGrant(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "documentId" -------------
-	var documentId string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "documentId", runtime.ParamLocationPath, chi.URLParam(r, "documentId"), &documentId)
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1PlyAdminGrantParams
+
+	// ------------- Optional query parameter "subject" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "subject", r.URL.Query(), &params.Subject)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subject", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "subjectKind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "subjectKind", r.URL.Query(), &params.SubjectKind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subjectKind", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV1PlyDocumentDocumentId(w, r, documentId)
+		siw.Handler.GetV1PlyAdminGrant(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -444,12 +1385,16 @@ func (siw *ServerInterfaceWrapper) GetV1PlyDocumentDocumentId(w http.ResponseWri
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostV1PlyEnrollment operation middleware
-func (siw *ServerInterfaceWrapper) PostV1PlyEnrollment(w http.ResponseWriter, r *http.Request) {
+// PostV1PlyAdminGrant operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyAdminGrant(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostV1PlyEnrollment(w, r)
+		siw.Handler.PostV1PlyAdminGrant(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -459,23 +1404,27 @@ func (siw *ServerInterfaceWrapper) PostV1PlyEnrollment(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteV1PlyEnrollmentEnrollmentId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteV1PlyEnrollmentEnrollmentId(w http.ResponseWriter, r *http.Request) {
+// DeleteV1PlyAdminGrantGrantId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV1PlyAdminGrantGrantId(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "enrollmentId" -------------
-	var enrollmentId string
+	// ------------- Path parameter "grantId" -------------
+	var grantId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "enrollmentId", runtime.ParamLocationPath, chi.URLParam(r, "enrollmentId"), &enrollmentId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "grantId", runtime.ParamLocationPath, chi.URLParam(r, "grantId"), &grantId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "enrollmentId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "grantId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteV1PlyEnrollmentEnrollmentId(w, r, enrollmentId)
+		siw.Handler.DeleteV1PlyAdminGrantGrantId(w, r, grantId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -485,23 +1434,16 @@ func (siw *ServerInterfaceWrapper) DeleteV1PlyEnrollmentEnrollmentId(w http.Resp
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV1PlyEnrollmentEnrollmentId operation middleware
-func (siw *ServerInterfaceWrapper) GetV1PlyEnrollmentEnrollmentId(w http.ResponseWriter, r *http.Request) {
+// GetV1PlyAdminMfaPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyAdminMfaPolicy(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "enrollmentId" -------------
-	var enrollmentId string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "enrollmentId", runtime.ParamLocationPath, chi.URLParam(r, "enrollmentId"), &enrollmentId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "enrollmentId", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV1PlyEnrollmentEnrollmentId(w, r, enrollmentId)
+		siw.Handler.GetV1PlyAdminMfaPolicy(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -511,23 +1453,16 @@ func (siw *ServerInterfaceWrapper) GetV1PlyEnrollmentEnrollmentId(w http.Respons
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostV1PlyEnrollmentEnrollmentId operation middleware
-func (siw *ServerInterfaceWrapper) PostV1PlyEnrollmentEnrollmentId(w http.ResponseWriter, r *http.Request) {
+// PostV1PlyAdminMfaPolicy operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyAdminMfaPolicy(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "enrollmentId" -------------
-	var enrollmentId string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "enrollmentId", runtime.ParamLocationPath, chi.URLParam(r, "enrollmentId"), &enrollmentId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "enrollmentId", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostV1PlyEnrollmentEnrollmentId(w, r, enrollmentId)
+		siw.Handler.PostV1PlyAdminMfaPolicy(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -537,23 +1472,37 @@ func (siw *ServerInterfaceWrapper) PostV1PlyEnrollmentEnrollmentId(w http.Respon
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV1PlyEnrollmentEnrollmentIdActivity operation middleware
-func (siw *ServerInterfaceWrapper) GetV1PlyEnrollmentEnrollmentIdActivity(w http.ResponseWriter, r *http.Request) {
+// PostV1PlyAdminStorageReconcile operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyAdminStorageReconcile(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "enrollmentId" -------------
-	var enrollmentId string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "enrollmentId", runtime.ParamLocationPath, chi.URLParam(r, "enrollmentId"), &enrollmentId)
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostV1PlyAdminStorageReconcileParams
+
+	// ------------- Optional query parameter "repair" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repair", r.URL.Query(), &params.Repair)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "enrollmentId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repair", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "delete" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "delete", r.URL.Query(), &params.Delete)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "delete", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV1PlyEnrollmentEnrollmentIdActivity(w, r, enrollmentId)
+		siw.Handler.PostV1PlyAdminStorageReconcile(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -563,12 +1512,16 @@ func (siw *ServerInterfaceWrapper) GetV1PlyEnrollmentEnrollmentIdActivity(w http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostV1PlyLocation operation middleware
-func (siw *ServerInterfaceWrapper) PostV1PlyLocation(w http.ResponseWriter, r *http.Request) {
+// GetV1PlyAdminUser operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyAdminUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostV1PlyLocation(w, r)
+		siw.Handler.GetV1PlyAdminUser(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -578,23 +1531,27 @@ func (siw *ServerInterfaceWrapper) PostV1PlyLocation(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteV1PlyLocationLocationId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteV1PlyLocationLocationId(w http.ResponseWriter, r *http.Request) {
+// PostV1PlyAdminUserUserIdDisable operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyAdminUserUserIdDisable(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "locationId" -------------
-	var locationId string
+	// ------------- Path parameter "userId" -------------
+	var userId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "locationId", runtime.ParamLocationPath, chi.URLParam(r, "locationId"), &locationId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, chi.URLParam(r, "userId"), &userId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locationId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteV1PlyLocationLocationId(w, r, locationId)
+		siw.Handler.PostV1PlyAdminUserUserIdDisable(w, r, userId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -604,23 +1561,27 @@ func (siw *ServerInterfaceWrapper) DeleteV1PlyLocationLocationId(w http.Response
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV1PlyLocationLocationId operation middleware
-func (siw *ServerInterfaceWrapper) GetV1PlyLocationLocationId(w http.ResponseWriter, r *http.Request) {
+// PostV1PlyAdminUserUserIdMfaReset operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyAdminUserUserIdMfaReset(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "locationId" -------------
-	var locationId string
+	// ------------- Path parameter "userId" -------------
+	var userId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "locationId", runtime.ParamLocationPath, chi.URLParam(r, "locationId"), &locationId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userId", runtime.ParamLocationPath, chi.URLParam(r, "userId"), &userId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locationId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV1PlyLocationLocationId(w, r, locationId)
+		siw.Handler.PostV1PlyAdminUserUserIdMfaReset(w, r, userId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -630,23 +1591,27 @@ func (siw *ServerInterfaceWrapper) GetV1PlyLocationLocationId(w http.ResponseWri
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostV1PlyLocationLocationId operation middleware
-func (siw *ServerInterfaceWrapper) PostV1PlyLocationLocationId(w http.ResponseWriter, r *http.Request) {
+// DeleteV1PlyAffiliationAffiliationId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV1PlyAffiliationAffiliationId(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "locationId" -------------
-	var locationId string
+	// ------------- Path parameter "affiliationId" -------------
+	var affiliationId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "locationId", runtime.ParamLocationPath, chi.URLParam(r, "locationId"), &locationId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "affiliationId", runtime.ParamLocationPath, chi.URLParam(r, "affiliationId"), &affiliationId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locationId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "affiliationId", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostV1PlyLocationLocationId(w, r, locationId)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteV1PlyAffiliationAffiliationId(w, r, affiliationId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -656,12 +1621,27 @@ func (siw *ServerInterfaceWrapper) PostV1PlyLocationLocationId(w http.ResponseWr
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostV1PlyPractice operation middleware
-func (siw *ServerInterfaceWrapper) PostV1PlyPractice(w http.ResponseWriter, r *http.Request) {
+// GetV1PlyAffiliationAffiliationId operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyAffiliationAffiliationId(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "affiliationId" -------------
+	var affiliationId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "affiliationId", runtime.ParamLocationPath, chi.URLParam(r, "affiliationId"), &affiliationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "affiliationId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostV1PlyPractice(w, r)
+		siw.Handler.GetV1PlyAffiliationAffiliationId(w, r, affiliationId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -671,12 +1651,27 @@ func (siw *ServerInterfaceWrapper) PostV1PlyPractice(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV1PlyPracticeList operation middleware
-func (siw *ServerInterfaceWrapper) GetV1PlyPracticeList(w http.ResponseWriter, r *http.Request) {
+// PostV1PlyAffiliationAffiliationId operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyAffiliationAffiliationId(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "affiliationId" -------------
+	var affiliationId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "affiliationId", runtime.ParamLocationPath, chi.URLParam(r, "affiliationId"), &affiliationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "affiliationId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV1PlyPracticeList(w, r)
+		siw.Handler.PostV1PlyAffiliationAffiliationId(w, r, affiliationId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -686,23 +1681,27 @@ func (siw *ServerInterfaceWrapper) GetV1PlyPracticeList(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV1PlyPracticePracticeId operation middleware
-func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeId(w http.ResponseWriter, r *http.Request) {
+// DeleteV1PlyDocumentDocumentId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV1PlyDocumentDocumentId(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "practiceId" -------------
-	var practiceId string
+	// ------------- Path parameter "documentId" -------------
+	var documentId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "documentId", runtime.ParamLocationPath, chi.URLParam(r, "documentId"), &documentId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV1PlyPracticePracticeId(w, r, practiceId)
+		siw.Handler.DeleteV1PlyDocumentDocumentId(w, r, documentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -712,23 +1711,51 @@ func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeId(w http.ResponseWri
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostV1PlyPracticePracticeId operation middleware
-func (siw *ServerInterfaceWrapper) PostV1PlyPracticePracticeId(w http.ResponseWriter, r *http.Request) {
+// GetV1PlyDocumentDocumentId operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyDocumentDocumentId(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "practiceId" -------------
-	var practiceId string
+	// ------------- Path parameter "documentId" -------------
+	var documentId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "documentId", runtime.ParamLocationPath, chi.URLParam(r, "documentId"), &documentId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1PlyDocumentDocumentIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Range")]; found {
+		var Range string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Range", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Range", runtime.ParamLocationHeader, valueList[0], &Range)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Range", Err: err})
+			return
+		}
+
+		params.Range = &Range
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostV1PlyPracticePracticeId(w, r, practiceId)
+		siw.Handler.GetV1PlyDocumentDocumentId(w, r, documentId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -738,23 +1765,27 @@ func (siw *ServerInterfaceWrapper) PostV1PlyPracticePracticeId(w http.ResponseWr
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV1PlyPracticePracticeIdDocument operation middleware
-func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdDocument(w http.ResponseWriter, r *http.Request) {
+// GetV1PlyDocumentDocumentIdExtraction operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyDocumentDocumentIdExtraction(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "practiceId" -------------
-	var practiceId string
+	// ------------- Path parameter "documentId" -------------
+	var documentId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "documentId", runtime.ParamLocationPath, chi.URLParam(r, "documentId"), &documentId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV1PlyPracticePracticeIdDocument(w, r, practiceId)
+		siw.Handler.GetV1PlyDocumentDocumentIdExtraction(w, r, documentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -764,23 +1795,27 @@ func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdDocument(w http.Res
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV1PlyPracticePracticeIdEnrollment operation middleware
-func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdEnrollment(w http.ResponseWriter, r *http.Request) {
+// PostV1PlyDocumentDocumentIdExtraction operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyDocumentDocumentIdExtraction(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "practiceId" -------------
-	var practiceId string
+	// ------------- Path parameter "documentId" -------------
+	var documentId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "documentId", runtime.ParamLocationPath, chi.URLParam(r, "documentId"), &documentId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV1PlyPracticePracticeIdEnrollment(w, r, practiceId)
+		siw.Handler.PostV1PlyDocumentDocumentIdExtraction(w, r, documentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -790,23 +1825,27 @@ func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdEnrollment(w http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV1PlyPracticePracticeIdLocation operation middleware
-func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdLocation(w http.ResponseWriter, r *http.Request) {
+// PostV1PlyDocumentDocumentIdExtractionApply operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyDocumentDocumentIdExtractionApply(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "practiceId" -------------
-	var practiceId string
+	// ------------- Path parameter "documentId" -------------
+	var documentId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "documentId", runtime.ParamLocationPath, chi.URLParam(r, "documentId"), &documentId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV1PlyPracticePracticeIdLocation(w, r, practiceId)
+		siw.Handler.PostV1PlyDocumentDocumentIdExtractionApply(w, r, documentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -816,23 +1855,27 @@ func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdLocation(w http.Res
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV1PlyPracticePracticeIdProvider operation middleware
-func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdProvider(w http.ResponseWriter, r *http.Request) {
+// GetV1PlyDocumentDocumentIdLink operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyDocumentDocumentIdLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "practiceId" -------------
-	var practiceId string
+	// ------------- Path parameter "documentId" -------------
+	var documentId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "documentId", runtime.ParamLocationPath, chi.URLParam(r, "documentId"), &documentId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV1PlyPracticePracticeIdProvider(w, r, practiceId)
+		siw.Handler.GetV1PlyDocumentDocumentIdLink(w, r, documentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -842,23 +1885,27 @@ func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdProvider(w http.Res
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV1PlyPracticePracticeIdTask operation middleware
-func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdTask(w http.ResponseWriter, r *http.Request) {
+// PostV1PlyDocumentDocumentIdLink operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyDocumentDocumentIdLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "practiceId" -------------
-	var practiceId string
+	// ------------- Path parameter "documentId" -------------
+	var documentId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "documentId", runtime.ParamLocationPath, chi.URLParam(r, "documentId"), &documentId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV1PlyPracticePracticeIdTask(w, r, practiceId)
+		siw.Handler.PostV1PlyDocumentDocumentIdLink(w, r, documentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -868,23 +1915,36 @@ func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdTask(w http.Respons
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostV1PlyPracticePracticeIdUpload operation middleware
-func (siw *ServerInterfaceWrapper) PostV1PlyPracticePracticeIdUpload(w http.ResponseWriter, r *http.Request) {
+// DeleteV1PlyDocumentDocumentIdLinkLinkId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV1PlyDocumentDocumentIdLinkLinkId(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "practiceId" -------------
-	var practiceId string
+	// ------------- Path parameter "documentId" -------------
+	var documentId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "documentId", runtime.ParamLocationPath, chi.URLParam(r, "documentId"), &documentId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "linkId" -------------
+	var linkId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "linkId", runtime.ParamLocationPath, chi.URLParam(r, "linkId"), &linkId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "linkId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostV1PlyPracticePracticeIdUpload(w, r, practiceId)
+		siw.Handler.DeleteV1PlyDocumentDocumentIdLinkLinkId(w, r, documentId, linkId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -894,12 +1954,27 @@ func (siw *ServerInterfaceWrapper) PostV1PlyPracticePracticeIdUpload(w http.Resp
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostV1PlyProvider operation middleware
-func (siw *ServerInterfaceWrapper) PostV1PlyProvider(w http.ResponseWriter, r *http.Request) {
+// GetV1PlyDocumentDocumentIdMetadata operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyDocumentDocumentIdMetadata(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "documentId" -------------
+	var documentId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "documentId", runtime.ParamLocationPath, chi.URLParam(r, "documentId"), &documentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostV1PlyProvider(w, r)
+		siw.Handler.GetV1PlyDocumentDocumentIdMetadata(w, r, documentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -909,23 +1984,27 @@ func (siw *ServerInterfaceWrapper) PostV1PlyProvider(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteV1PlyProviderProviderId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request) {
+// PostV1PlyDocumentDocumentIdMetadata operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyDocumentDocumentIdMetadata(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "providerId" -------------
-	var providerId string
+	// ------------- Path parameter "documentId" -------------
+	var documentId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "providerId", runtime.ParamLocationPath, chi.URLParam(r, "providerId"), &providerId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "documentId", runtime.ParamLocationPath, chi.URLParam(r, "documentId"), &documentId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "providerId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteV1PlyProviderProviderId(w, r, providerId)
+		siw.Handler.PostV1PlyDocumentDocumentIdMetadata(w, r, documentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -935,23 +2014,27 @@ func (siw *ServerInterfaceWrapper) DeleteV1PlyProviderProviderId(w http.Response
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetV1PlyProviderProviderId operation middleware
-func (siw *ServerInterfaceWrapper) GetV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request) {
+// GetV1PlyDocumentDocumentIdPreview operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyDocumentDocumentIdPreview(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "providerId" -------------
-	var providerId string
+	// ------------- Path parameter "documentId" -------------
+	var documentId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "providerId", runtime.ParamLocationPath, chi.URLParam(r, "providerId"), &providerId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "documentId", runtime.ParamLocationPath, chi.URLParam(r, "documentId"), &documentId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "providerId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetV1PlyProviderProviderId(w, r, providerId)
+		siw.Handler.GetV1PlyDocumentDocumentIdPreview(w, r, documentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -961,23 +2044,27 @@ func (siw *ServerInterfaceWrapper) GetV1PlyProviderProviderId(w http.ResponseWri
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostV1PlyProviderProviderId operation middleware
-func (siw *ServerInterfaceWrapper) PostV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request) {
+// GetV1PlyDocumentDocumentIdVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyDocumentDocumentIdVersion(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "providerId" -------------
-	var providerId string
+	// ------------- Path parameter "documentId" -------------
+	var documentId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "providerId", runtime.ParamLocationPath, chi.URLParam(r, "providerId"), &providerId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "documentId", runtime.ParamLocationPath, chi.URLParam(r, "documentId"), &documentId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "providerId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostV1PlyProviderProviderId(w, r, providerId)
+		siw.Handler.GetV1PlyDocumentDocumentIdVersion(w, r, documentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -987,23 +2074,27 @@ func (siw *ServerInterfaceWrapper) PostV1PlyProviderProviderId(w http.ResponseWr
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostV1PlyTaskTaskId operation middleware
-func (siw *ServerInterfaceWrapper) PostV1PlyTaskTaskId(w http.ResponseWriter, r *http.Request) {
+// PostV1PlyDocumentDocumentIdVersion operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyDocumentDocumentIdVersion(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "taskId" -------------
-	var taskId string
+	// ------------- Path parameter "documentId" -------------
+	var documentId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "taskId", runtime.ParamLocationPath, chi.URLParam(r, "taskId"), &taskId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "documentId", runtime.ParamLocationPath, chi.URLParam(r, "documentId"), &documentId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostV1PlyTaskTaskId(w, r, taskId)
+		siw.Handler.PostV1PlyDocumentDocumentIdVersion(w, r, documentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1013,1081 +2104,6971 @@ func (siw *ServerInterfaceWrapper) PostV1PlyTaskTaskId(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// GetV1PlyDocumentDocumentIdVersionVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyDocumentDocumentIdVersionVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "documentId" -------------
+	var documentId string
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "documentId", runtime.ParamLocationPath, chi.URLParam(r, "documentId"), &documentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Path parameter "version" -------------
+	var version int
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "version", runtime.ParamLocationPath, chi.URLParam(r, "version"), &version)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
 
-type RequiredParamError struct {
-	ParamName string
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
-
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1PlyDocumentDocumentIdVersionVersionParams
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	headers := r.Header
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Optional header parameter "Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Range")]; found {
+		var Range string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Range", Count: n})
+			return
+		}
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Range", runtime.ParamLocationHeader, valueList[0], &Range)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Range", Err: err})
+			return
+		}
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+		params.Range = &Range
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	}
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyDocumentDocumentIdVersionVersion(w, r, documentId, version, params)
+	}))
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+// PostV1PlyDocumentDocumentIdVersionVersionCurrent operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyDocumentDocumentIdVersionVersionCurrent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	var err error
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	// ------------- Path parameter "documentId" -------------
+	var documentId string
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "documentId", runtime.ParamLocationPath, chi.URLParam(r, "documentId"), &documentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
+		return
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+
+	// ------------- Path parameter "version" -------------
+	var version int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "version", runtime.ParamLocationPath, chi.URLParam(r, "version"), &version)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v1/ply/document/{documentId}", wrapper.DeleteV1PlyDocumentDocumentId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/ply/document/{documentId}", wrapper.GetV1PlyDocumentDocumentId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/ply/enrollment", wrapper.PostV1PlyEnrollment)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v1/ply/enrollment/{enrollmentId}", wrapper.DeleteV1PlyEnrollmentEnrollmentId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/ply/enrollment/{enrollmentId}", wrapper.GetV1PlyEnrollmentEnrollmentId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/ply/enrollment/{enrollmentId}", wrapper.PostV1PlyEnrollmentEnrollmentId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/ply/enrollment/{enrollmentId}/activity", wrapper.GetV1PlyEnrollmentEnrollmentIdActivity)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/ply/location", wrapper.PostV1PlyLocation)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v1/ply/location/{locationId}", wrapper.DeleteV1PlyLocationLocationId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/ply/location/{locationId}", wrapper.GetV1PlyLocationLocationId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/ply/location/{locationId}", wrapper.PostV1PlyLocationLocationId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/ply/practice", wrapper.PostV1PlyPractice)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/ply/practice/list", wrapper.GetV1PlyPracticeList)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/ply/practice/{practiceId}", wrapper.GetV1PlyPracticePracticeId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/ply/practice/{practiceId}", wrapper.PostV1PlyPracticePracticeId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/ply/practice/{practiceId}/document", wrapper.GetV1PlyPracticePracticeIdDocument)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/ply/practice/{practiceId}/enrollment", wrapper.GetV1PlyPracticePracticeIdEnrollment)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/ply/practice/{practiceId}/location", wrapper.GetV1PlyPracticePracticeIdLocation)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/ply/practice/{practiceId}/provider", wrapper.GetV1PlyPracticePracticeIdProvider)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/ply/practice/{practiceId}/task", wrapper.GetV1PlyPracticePracticeIdTask)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/ply/practice/{practiceId}/upload", wrapper.PostV1PlyPracticePracticeIdUpload)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/ply/provider", wrapper.PostV1PlyProvider)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v1/ply/provider/{providerId}", wrapper.DeleteV1PlyProviderProviderId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/ply/provider/{providerId}", wrapper.GetV1PlyProviderProviderId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/ply/provider/{providerId}", wrapper.PostV1PlyProviderProviderId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/ply/task/{taskId}", wrapper.PostV1PlyTaskTaskId)
-	})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return r
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type DeleteV1PlyDocumentDocumentIdRequestObject struct {
-	DocumentId string `json:"documentId"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyDocumentDocumentIdVersionVersionCurrent(w, r, documentId, version)
+	}))
 
-type DeleteV1PlyDocumentDocumentIdResponseObject interface {
-	VisitDeleteV1PlyDocumentDocumentIdResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteV1PlyDocumentDocumentId200JSONResponse struct {
-	Status *string `json:"status,omitempty"`
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response DeleteV1PlyDocumentDocumentId200JSONResponse) VisitDeleteV1PlyDocumentDocumentIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostV1PlyEnrollment operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyEnrollment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type DeleteV1PlyDocumentDocumentId500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-func (response DeleteV1PlyDocumentDocumentId500JSONResponse) VisitDeleteV1PlyDocumentDocumentIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyEnrollment(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetV1PlyDocumentDocumentIdRequestObject struct {
-	DocumentId string `json:"documentId"`
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetV1PlyDocumentDocumentIdResponseObject interface {
-	VisitGetV1PlyDocumentDocumentIdResponse(w http.ResponseWriter) error
-}
+// DeleteV1PlyEnrollmentEnrollmentId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV1PlyEnrollmentEnrollmentId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetV1PlyDocumentDocumentId200AsteriskResponse struct {
-	Body          io.Reader
-	ContentType   string
-	ContentLength int64
-}
+	var err error
 
-func (response GetV1PlyDocumentDocumentId200AsteriskResponse) VisitGetV1PlyDocumentDocumentIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", response.ContentType)
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
-	w.WriteHeader(200)
+	// ------------- Path parameter "enrollmentId" -------------
+	var enrollmentId string
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+	err = runtime.BindStyledParameterWithLocation("simple", false, "enrollmentId", runtime.ParamLocationPath, chi.URLParam(r, "enrollmentId"), &enrollmentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "enrollmentId", Err: err})
+		return
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
-}
 
-type GetV1PlyDocumentDocumentId500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetV1PlyDocumentDocumentId500JSONResponse) VisitGetV1PlyDocumentDocumentIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteV1PlyEnrollmentEnrollmentId(w, r, enrollmentId)
+	}))
 
-type PostV1PlyEnrollmentRequestObject struct {
-	Body *PostV1PlyEnrollmentJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostV1PlyEnrollmentResponseObject interface {
-	VisitPostV1PlyEnrollmentResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PostV1PlyEnrollment200JSONResponse struct {
-	EnrollmentId *string `json:"enrollmentId,omitempty"`
-}
+// GetV1PlyEnrollmentEnrollmentId operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyEnrollmentEnrollmentId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response PostV1PlyEnrollment200JSONResponse) VisitPostV1PlyEnrollmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "enrollmentId" -------------
+	var enrollmentId string
 
-type PostV1PlyEnrollment500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "enrollmentId", runtime.ParamLocationPath, chi.URLParam(r, "enrollmentId"), &enrollmentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "enrollmentId", Err: err})
+		return
+	}
 
-func (response PostV1PlyEnrollment500JSONResponse) VisitPostV1PlyEnrollmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type DeleteV1PlyEnrollmentEnrollmentIdRequestObject struct {
-	EnrollmentId string `json:"enrollmentId"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyEnrollmentEnrollmentId(w, r, enrollmentId)
+	}))
 
-type DeleteV1PlyEnrollmentEnrollmentIdResponseObject interface {
-	VisitDeleteV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteV1PlyEnrollmentEnrollmentId200JSONResponse struct {
-	Status *string `json:"status,omitempty"`
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response DeleteV1PlyEnrollmentEnrollmentId200JSONResponse) VisitDeleteV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// PostV1PlyEnrollmentEnrollmentId operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyEnrollmentEnrollmentId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type DeleteV1PlyEnrollmentEnrollmentId500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-}
+	var err error
 
-func (response DeleteV1PlyEnrollmentEnrollmentId500JSONResponse) VisitDeleteV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Path parameter "enrollmentId" -------------
+	var enrollmentId string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "enrollmentId", runtime.ParamLocationPath, chi.URLParam(r, "enrollmentId"), &enrollmentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "enrollmentId", Err: err})
+		return
+	}
 
-type GetV1PlyEnrollmentEnrollmentIdRequestObject struct {
-	EnrollmentId string `json:"enrollmentId"`
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetV1PlyEnrollmentEnrollmentIdResponseObject interface {
-	VisitGetV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type GetV1PlyEnrollmentEnrollmentId200JSONResponse struct {
-	EnrollmentId *string `json:"enrollmentId,omitempty"`
-	LocationId   *string `json:"locationId,omitempty"`
-	Payer        *string `json:"payer,omitempty"`
-	PracticeId   *string `json:"practiceId,omitempty"`
-	ProviderId   *string `json:"providerId,omitempty"`
-	State        *string `json:"state,omitempty"`
-	Status       *string `json:"status,omitempty"`
-	Type         *string `json:"type,omitempty"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyEnrollmentEnrollmentId(w, r, enrollmentId)
+	}))
 
-func (response GetV1PlyEnrollmentEnrollmentId200JSONResponse) VisitGetV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetV1PlyEnrollmentEnrollmentId500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-}
+// GetV1PlyEnrollmentEnrollmentIdActivity operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyEnrollmentEnrollmentIdActivity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetV1PlyEnrollmentEnrollmentId500JSONResponse) VisitGetV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "enrollmentId" -------------
+	var enrollmentId string
 
-type PostV1PlyEnrollmentEnrollmentIdRequestObject struct {
-	EnrollmentId string `json:"enrollmentId"`
-	Body         *PostV1PlyEnrollmentEnrollmentIdJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "enrollmentId", runtime.ParamLocationPath, chi.URLParam(r, "enrollmentId"), &enrollmentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "enrollmentId", Err: err})
+		return
+	}
 
-type PostV1PlyEnrollmentEnrollmentIdResponseObject interface {
-	VisitPostV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PostV1PlyEnrollmentEnrollmentId200JSONResponse struct {
-	Status *string `json:"status,omitempty"`
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-func (response PostV1PlyEnrollmentEnrollmentId200JSONResponse) VisitPostV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyEnrollmentEnrollmentIdActivity(w, r, enrollmentId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostV1PlyEnrollmentEnrollmentId500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response PostV1PlyEnrollmentEnrollmentId500JSONResponse) VisitPostV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetV1PlyEnrollmentEnrollmentIdDependents operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyEnrollmentEnrollmentIdDependents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetV1PlyEnrollmentEnrollmentIdActivityRequestObject struct {
-	EnrollmentId string `json:"enrollmentId"`
-}
+	var err error
 
-type GetV1PlyEnrollmentEnrollmentIdActivityResponseObject interface {
-	VisitGetV1PlyEnrollmentEnrollmentIdActivityResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "enrollmentId" -------------
+	var enrollmentId string
 
-type GetV1PlyEnrollmentEnrollmentIdActivity200JSONResponse struct {
-	Activities *[]struct {
-		ActivityId   *string `json:"activityId,omitempty"`
-		EnrollmentId *string `json:"enrollmentId,omitempty"`
-		Message      *string `json:"message,omitempty"`
-	} `json:"activities,omitempty"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "enrollmentId", runtime.ParamLocationPath, chi.URLParam(r, "enrollmentId"), &enrollmentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "enrollmentId", Err: err})
+		return
+	}
 
-func (response GetV1PlyEnrollmentEnrollmentIdActivity200JSONResponse) VisitGetV1PlyEnrollmentEnrollmentIdActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type GetV1PlyEnrollmentEnrollmentIdActivity500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyEnrollmentEnrollmentIdDependents(w, r, enrollmentId)
+	}))
 
-func (response GetV1PlyEnrollmentEnrollmentIdActivity500JSONResponse) VisitGetV1PlyEnrollmentEnrollmentIdActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PostV1PlyLocationRequestObject struct {
-	Body *PostV1PlyLocationJSONRequestBody
-}
+// PostV1PlyLocation operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyLocation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type PostV1PlyLocationResponseObject interface {
-	VisitPostV1PlyLocationResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PostV1PlyLocation200JSONResponse struct {
-	LocationId *string `json:"locationId,omitempty"`
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-func (response PostV1PlyLocation200JSONResponse) VisitPostV1PlyLocationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyLocation(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostV1PlyLocation500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response PostV1PlyLocation500JSONResponse) VisitPostV1PlyLocationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// DeleteV1PlyLocationLocationId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV1PlyLocationLocationId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type DeleteV1PlyLocationLocationIdRequestObject struct {
-	LocationId string `json:"locationId"`
-}
+	// ------------- Path parameter "locationId" -------------
+	var locationId string
 
-type DeleteV1PlyLocationLocationIdResponseObject interface {
-	VisitDeleteV1PlyLocationLocationIdResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "locationId", runtime.ParamLocationPath, chi.URLParam(r, "locationId"), &locationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locationId", Err: err})
+		return
+	}
 
-type DeleteV1PlyLocationLocationId200JSONResponse struct {
-	Status *string `json:"status,omitempty"`
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response DeleteV1PlyLocationLocationId200JSONResponse) VisitDeleteV1PlyLocationLocationIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteV1PlyLocationLocationId(w, r, locationId)
+	}))
 
-type DeleteV1PlyLocationLocationId500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response DeleteV1PlyLocationLocationId500JSONResponse) VisitDeleteV1PlyLocationLocationIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetV1PlyLocationLocationId operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyLocationLocationId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetV1PlyLocationLocationIdRequestObject struct {
-	LocationId string `json:"locationId"`
-}
-
-type GetV1PlyLocationLocationIdResponseObject interface {
-	VisitGetV1PlyLocationLocationIdResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "locationId" -------------
+	var locationId string
 
-type GetV1PlyLocationLocationId200JSONResponse struct {
-	Address    *string `json:"address,omitempty"`
-	LocationId *string `json:"locationId,omitempty"`
-	PracticeId *string `json:"practiceId,omitempty"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "locationId", runtime.ParamLocationPath, chi.URLParam(r, "locationId"), &locationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locationId", Err: err})
+		return
+	}
 
-func (response GetV1PlyLocationLocationId200JSONResponse) VisitGetV1PlyLocationLocationIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type GetV1PlyLocationLocationId500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyLocationLocationId(w, r, locationId)
+	}))
 
-func (response GetV1PlyLocationLocationId500JSONResponse) VisitGetV1PlyLocationLocationIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PostV1PlyLocationLocationIdRequestObject struct {
-	LocationId string `json:"locationId"`
-	Body       *PostV1PlyLocationLocationIdJSONRequestBody
-}
+// PostV1PlyLocationLocationId operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyLocationLocationId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type PostV1PlyLocationLocationIdResponseObject interface {
-	VisitPostV1PlyLocationLocationIdResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type PostV1PlyLocationLocationId200JSONResponse struct {
-	Status *string `json:"status,omitempty"`
-}
+	// ------------- Path parameter "locationId" -------------
+	var locationId string
 
-func (response PostV1PlyLocationLocationId200JSONResponse) VisitPostV1PlyLocationLocationIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "locationId", runtime.ParamLocationPath, chi.URLParam(r, "locationId"), &locationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locationId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PostV1PlyLocationLocationId500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-func (response PostV1PlyLocationLocationId500JSONResponse) VisitPostV1PlyLocationLocationIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyLocationLocationId(w, r, locationId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostV1PlyPracticeRequestObject struct {
-	Body *PostV1PlyPracticeJSONRequestBody
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PostV1PlyPracticeResponseObject interface {
-	VisitPostV1PlyPracticeResponse(w http.ResponseWriter) error
-}
+// PostV1PlyLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyLogin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type PostV1PlyPractice200JSONResponse struct {
-	PracticeId *string `json:"practiceId,omitempty"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyLogin(w, r)
+	}))
 
-func (response PostV1PlyPractice200JSONResponse) VisitPostV1PlyPracticeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PostV1PlyPractice500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-}
+// PostV1PlyLoginMfa operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyLoginMfa(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response PostV1PlyPractice500JSONResponse) VisitPostV1PlyPracticeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyLoginMfa(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetV1PlyPracticeListRequestObject struct {
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetV1PlyPracticeListResponseObject interface {
-	VisitGetV1PlyPracticeListResponse(w http.ResponseWriter) error
-}
+// GetV1PlyMe operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyMe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetV1PlyPracticeList200JSONResponse struct {
-	Practices *[]struct {
-		Ein        *string `json:"ein,omitempty"`
-		Name       *string `json:"name,omitempty"`
-		OwnerName  *string `json:"owner_name,omitempty"`
-		PracticeId *string `json:"practiceId,omitempty"`
-	} `json:"practices,omitempty"`
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetV1PlyPracticeList200JSONResponse) VisitGetV1PlyPracticeListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyMe(w, r)
+	}))
 
-type GetV1PlyPracticeList500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response GetV1PlyPracticeList500JSONResponse) VisitGetV1PlyPracticeListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// PostV1PlyMeMfa operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyMeMfa(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetV1PlyPracticePracticeIdRequestObject struct {
-	PracticeId string `json:"practiceId"`
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type GetV1PlyPracticePracticeIdResponseObject interface {
-	VisitGetV1PlyPracticePracticeIdResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyMeMfa(w, r)
+	}))
 
-type GetV1PlyPracticePracticeId200JSONResponse struct {
-	Ein        *string `json:"ein,omitempty"`
-	Name       *string `json:"name,omitempty"`
-	OwnerName  *string `json:"owner_name,omitempty"`
-	PracticeId *string `json:"practiceId,omitempty"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response GetV1PlyPracticePracticeId200JSONResponse) VisitGetV1PlyPracticePracticeIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostV1PlyMeMfaConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyMeMfaConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetV1PlyPracticePracticeId500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-func (response GetV1PlyPracticePracticeId500JSONResponse) VisitGetV1PlyPracticePracticeIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyMeMfaConfirm(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostV1PlyPracticePracticeIdRequestObject struct {
-	PracticeId string `json:"practiceId"`
-	Body       *PostV1PlyPracticePracticeIdJSONRequestBody
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PostV1PlyPracticePracticeIdResponseObject interface {
-	VisitPostV1PlyPracticePracticeIdResponse(w http.ResponseWriter) error
-}
+// PostV1PlyMeMfaDisable operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyMeMfaDisable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type PostV1PlyPracticePracticeId200JSONResponse struct {
-	Status *string `json:"status,omitempty"`
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response PostV1PlyPracticePracticeId200JSONResponse) VisitPostV1PlyPracticePracticeIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyMeMfaDisable(w, r)
+	}))
 
-type PostV1PlyPracticePracticeId500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response PostV1PlyPracticePracticeId500JSONResponse) VisitPostV1PlyPracticePracticeIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// PostV1PlyMeMfaRecovery operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyMeMfaRecovery(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetV1PlyPracticePracticeIdDocumentRequestObject struct {
-	PracticeId string `json:"practiceId"`
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type GetV1PlyPracticePracticeIdDocumentResponseObject interface {
-	VisitGetV1PlyPracticePracticeIdDocumentResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyMeMfaRecovery(w, r)
+	}))
 
-type GetV1PlyPracticePracticeIdDocument200JSONResponse struct {
-	Documents *[]struct {
-		DocumentId  *string `json:"documentId,omitempty"`
-		FileName    *string `json:"file_name,omitempty"`
-		PracticeId  *string `json:"practiceId,omitempty"`
-		StoragePath *string `json:"storage_path,omitempty"`
-	} `json:"documents,omitempty"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response GetV1PlyPracticePracticeIdDocument200JSONResponse) VisitGetV1PlyPracticePracticeIdDocumentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostV1PlyMePassword operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyMePassword(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetV1PlyPracticePracticeIdDocument500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-func (response GetV1PlyPracticePracticeIdDocument500JSONResponse) VisitGetV1PlyPracticePracticeIdDocumentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyMePassword(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetV1PlyPracticePracticeIdEnrollmentRequestObject struct {
-	PracticeId string `json:"practiceId"`
-}
+// PostV1PlyOrganization operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetV1PlyPracticePracticeIdEnrollmentResponseObject interface {
-	VisitGetV1PlyPracticePracticeIdEnrollmentResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetV1PlyPracticePracticeIdEnrollment200JSONResponse struct {
-	Enrollments *[]struct {
-		EnrollmentId *string `json:"enrollmentId,omitempty"`
-		LocationId   *string `json:"locationId,omitempty"`
-		Payer        *string `json:"payer,omitempty"`
-		PracticeId   *string `json:"practiceId,omitempty"`
-		ProviderId   *string `json:"providerId,omitempty"`
-		State        *string `json:"state,omitempty"`
-		Status       *string `json:"status,omitempty"`
-		Type         *string `json:"type,omitempty"`
-	} `json:"enrollments,omitempty"`
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-func (response GetV1PlyPracticePracticeIdEnrollment200JSONResponse) VisitGetV1PlyPracticePracticeIdEnrollmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyOrganization(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetV1PlyPracticePracticeIdEnrollment500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response GetV1PlyPracticePracticeIdEnrollment500JSONResponse) VisitGetV1PlyPracticePracticeIdEnrollmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetV1PlyOrganizationList operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyOrganizationList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetV1PlyPracticePracticeIdLocationRequestObject struct {
-	PracticeId string `json:"practiceId"`
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetV1PlyPracticePracticeIdLocationResponseObject interface {
-	VisitGetV1PlyPracticePracticeIdLocationResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type GetV1PlyPracticePracticeIdLocation200JSONResponse struct {
-	Locations *[]struct {
-		Address    *string `json:"address,omitempty"`
-		LocationId *string `json:"locationId,omitempty"`
-		PracticeId *string `json:"practiceId,omitempty"`
-	} `json:"locations,omitempty"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyOrganizationList(w, r)
+	}))
 
-func (response GetV1PlyPracticePracticeIdLocation200JSONResponse) VisitGetV1PlyPracticePracticeIdLocationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetV1PlyPracticePracticeIdLocation500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-}
+// GetV1PlyOrganizationOrganizationId operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyOrganizationOrganizationId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetV1PlyPracticePracticeIdLocation500JSONResponse) VisitGetV1PlyPracticePracticeIdLocationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId string
 
-type GetV1PlyPracticePracticeIdProviderRequestObject struct {
-	PracticeId string `json:"practiceId"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, chi.URLParam(r, "organizationId"), &organizationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
 
-type GetV1PlyPracticePracticeIdProviderResponseObject interface {
-	VisitGetV1PlyPracticePracticeIdProviderResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetV1PlyPracticePracticeIdProvider200JSONResponse struct {
-	Providers *[]struct {
-		Name       *string `json:"name,omitempty"`
-		PracticeId *string `json:"practiceId,omitempty"`
-		ProviderId *string `json:"providerId,omitempty"`
-		Ssn        *string `json:"ssn,omitempty"`
-	} `json:"providers,omitempty"`
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-func (response GetV1PlyPracticePracticeIdProvider200JSONResponse) VisitGetV1PlyPracticePracticeIdProviderResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyOrganizationOrganizationId(w, r, organizationId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetV1PlyPracticePracticeIdProvider500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response GetV1PlyPracticePracticeIdProvider500JSONResponse) VisitGetV1PlyPracticePracticeIdProviderResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// PostV1PlyOrganizationOrganizationId operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyOrganizationOrganizationId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetV1PlyPracticePracticeIdTaskRequestObject struct {
-	PracticeId string `json:"practiceId"`
-}
+	var err error
 
-type GetV1PlyPracticePracticeIdTaskResponseObject interface {
-	VisitGetV1PlyPracticePracticeIdTaskResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId string
 
-type GetV1PlyPracticePracticeIdTask200JSONResponse struct {
-	Tasks *[]struct {
-		Message    *string `json:"message,omitempty"`
-		PracticeId *string `json:"practiceId,omitempty"`
-		Status     *string `json:"status,omitempty"`
-		TaskId     *string `json:"taskId,omitempty"`
-	} `json:"tasks,omitempty"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, chi.URLParam(r, "organizationId"), &organizationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
 
-func (response GetV1PlyPracticePracticeIdTask200JSONResponse) VisitGetV1PlyPracticePracticeIdTaskResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type GetV1PlyPracticePracticeIdTask500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyOrganizationOrganizationId(w, r, organizationId)
+	}))
 
-func (response GetV1PlyPracticePracticeIdTask500JSONResponse) VisitGetV1PlyPracticePracticeIdTaskResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PostV1PlyPracticePracticeIdUploadRequestObject struct {
-	PracticeId string `json:"practiceId"`
-	Body       *multipart.Reader
-}
+// GetV1PlyOrganizationOrganizationIdEnrollment operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyOrganizationOrganizationIdEnrollment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type PostV1PlyPracticePracticeIdUploadResponseObject interface {
-	VisitPostV1PlyPracticePracticeIdUploadResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type PostV1PlyPracticePracticeIdUpload200JSONResponse struct {
-	DocumentId *string `json:"documentId,omitempty"`
-}
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId string
 
-func (response PostV1PlyPracticePracticeIdUpload200JSONResponse) VisitPostV1PlyPracticePracticeIdUploadResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, chi.URLParam(r, "organizationId"), &organizationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PostV1PlyPracticePracticeIdUpload500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-func (response PostV1PlyPracticePracticeIdUpload500JSONResponse) VisitPostV1PlyPracticePracticeIdUploadResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyOrganizationOrganizationIdEnrollment(w, r, organizationId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostV1PlyProviderRequestObject struct {
-	Body *PostV1PlyProviderJSONRequestBody
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PostV1PlyProviderResponseObject interface {
-	VisitPostV1PlyProviderResponse(w http.ResponseWriter) error
-}
+// GetV1PlyOrganizationOrganizationIdPractice operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyOrganizationOrganizationIdPractice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type PostV1PlyProvider200JSONResponse struct {
-	ProviderId *string `json:"providerId,omitempty"`
-}
+	var err error
 
-func (response PostV1PlyProvider200JSONResponse) VisitPostV1PlyProviderResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, chi.URLParam(r, "organizationId"), &organizationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
 
-type PostV1PlyProvider500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response PostV1PlyProvider500JSONResponse) VisitPostV1PlyProviderResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyOrganizationOrganizationIdPractice(w, r, organizationId)
+	}))
 
-type DeleteV1PlyProviderProviderIdRequestObject struct {
-	ProviderId string `json:"providerId"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteV1PlyProviderProviderIdResponseObject interface {
-	VisitDeleteV1PlyProviderProviderIdResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type DeleteV1PlyProviderProviderId200JSONResponse struct {
-	Status *string `json:"status,omitempty"`
-}
+// GetV1PlyOrganizationOrganizationIdProvider operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyOrganizationOrganizationIdProvider(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response DeleteV1PlyProviderProviderId200JSONResponse) VisitDeleteV1PlyProviderProviderIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId string
 
-type DeleteV1PlyProviderProviderId500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, chi.URLParam(r, "organizationId"), &organizationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
 
-func (response DeleteV1PlyProviderProviderId500JSONResponse) VisitDeleteV1PlyProviderProviderIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type GetV1PlyProviderProviderIdRequestObject struct {
-	ProviderId string `json:"providerId"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyOrganizationOrganizationIdProvider(w, r, organizationId)
+	}))
 
-type GetV1PlyProviderProviderIdResponseObject interface {
-	VisitGetV1PlyProviderProviderIdResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetV1PlyProviderProviderId200JSONResponse struct {
-	Name       *string `json:"name,omitempty"`
-	PracticeId *string `json:"practiceId,omitempty"`
-	ProviderId *string `json:"providerId,omitempty"`
-	Ssn        *string `json:"ssn,omitempty"`
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response GetV1PlyProviderProviderId200JSONResponse) VisitGetV1PlyProviderProviderIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetV1PlyOrganizationOrganizationIdTask operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyOrganizationOrganizationIdTask(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetV1PlyProviderProviderId500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
-}
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId string
 
-func (response GetV1PlyProviderProviderId500JSONResponse) VisitGetV1PlyProviderProviderIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, chi.URLParam(r, "organizationId"), &organizationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PostV1PlyProviderProviderIdRequestObject struct {
-	ProviderId string `json:"providerId"`
-	Body       *PostV1PlyProviderProviderIdJSONRequestBody
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type PostV1PlyProviderProviderIdResponseObject interface {
-	VisitPostV1PlyProviderProviderIdResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyOrganizationOrganizationIdTask(w, r, organizationId)
+	}))
 
-type PostV1PlyProviderProviderId200JSONResponse struct {
-	Status *string `json:"status,omitempty"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response PostV1PlyProviderProviderId200JSONResponse) VisitPostV1PlyProviderProviderIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostV1PlyPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyPasswordReset(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyPasswordReset(w, r)
+	}))
 
-type PostV1PlyProviderProviderId500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response PostV1PlyProviderProviderId500JSONResponse) VisitPostV1PlyProviderProviderIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// PostV1PlyPasswordResetConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyPasswordResetConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyPasswordResetConfirm(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PostV1PlyTaskTaskIdRequestObject struct {
-	TaskId string `json:"taskId"`
-	Body   *PostV1PlyTaskTaskIdJSONRequestBody
+// PostV1PlyPractice operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyPractice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyPractice(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PostV1PlyTaskTaskIdResponseObject interface {
-	VisitPostV1PlyTaskTaskIdResponse(w http.ResponseWriter) error
+// GetV1PlyPracticeList operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyPracticeList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyPracticeList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PostV1PlyTaskTaskId200JSONResponse struct {
-	Status *string `json:"status,omitempty"`
+// GetV1PlyPracticePracticeId operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "practiceId" -------------
+	var practiceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyPracticePracticeId(w, r, practiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response PostV1PlyTaskTaskId200JSONResponse) VisitPostV1PlyTaskTaskIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostV1PlyPracticePracticeId operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyPracticePracticeId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
+	var err error
+
+	// ------------- Path parameter "practiceId" -------------
+	var practiceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyPracticePracticeId(w, r, practiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PostV1PlyTaskTaskId500JSONResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
+// GetV1PlyPracticePracticeIdDocument operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdDocument(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "practiceId" -------------
+	var practiceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1PlyPracticePracticeIdDocumentParams
+
+	// ------------- Optional query parameter "documentType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "documentType", r.URL.Query(), &params.DocumentType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "providerId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "providerId", r.URL.Query(), &params.ProviderId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "providerId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "locationId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locationId", r.URL.Query(), &params.LocationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locationId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "enrollmentId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "enrollmentId", r.URL.Query(), &params.EnrollmentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "enrollmentId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyPracticePracticeIdDocument(w, r, practiceId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (response PostV1PlyTaskTaskId500JSONResponse) VisitPostV1PlyTaskTaskIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetV1PlyPracticePracticeIdDocumentArchive operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdDocumentArchive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
+	var err error
+
+	// ------------- Path parameter "practiceId" -------------
+	var practiceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1PlyPracticePracticeIdDocumentArchiveParams
+
+	// ------------- Optional query parameter "documentType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "documentType", r.URL.Query(), &params.DocumentType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "providerId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "providerId", r.URL.Query(), &params.ProviderId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "providerId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "enrollmentId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "enrollmentId", r.URL.Query(), &params.EnrollmentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "enrollmentId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyPracticePracticeIdDocumentArchive(w, r, practiceId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Delete a document
-	// (DELETE /v1/ply/document/{documentId})
-	DeleteV1PlyDocumentDocumentId(ctx context.Context, request DeleteV1PlyDocumentDocumentIdRequestObject) (DeleteV1PlyDocumentDocumentIdResponseObject, error)
-	// Get a document by ID
+// GetV1PlyPracticePracticeIdDocumentSearch operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdDocumentSearch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "practiceId" -------------
+	var practiceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1PlyPracticePracticeIdDocumentSearchParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyPracticePracticeIdDocumentSearch(w, r, practiceId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV1PlyPracticePracticeIdEnrollment operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdEnrollment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "practiceId" -------------
+	var practiceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyPracticePracticeIdEnrollment(w, r, practiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV1PlyPracticePracticeIdEnrollmentDuplicates operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdEnrollmentDuplicates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "practiceId" -------------
+	var practiceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyPracticePracticeIdEnrollmentDuplicates(w, r, practiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV1PlyPracticePracticeIdLocation operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdLocation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "practiceId" -------------
+	var practiceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyPracticePracticeIdLocation(w, r, practiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV1PlyPracticePracticeIdProvider operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdProvider(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "practiceId" -------------
+	var practiceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyPracticePracticeIdProvider(w, r, practiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV1PlyPracticePracticeIdTask operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdTask(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "practiceId" -------------
+	var practiceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyPracticePracticeIdTask(w, r, practiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV1PlyPracticePracticeIdUpload operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyPracticePracticeIdUpload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "practiceId" -------------
+	var practiceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyPracticePracticeIdUpload(w, r, practiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV1PlyPracticePracticeIdUploadResumable operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyPracticePracticeIdUploadResumable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "practiceId" -------------
+	var practiceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyPracticePracticeIdUploadResumable(w, r, practiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV1PlyPracticePracticeIdUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyPracticePracticeIdUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "practiceId" -------------
+	var practiceId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "practiceId", runtime.ParamLocationPath, chi.URLParam(r, "practiceId"), &practiceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyPracticePracticeIdUsage(w, r, practiceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV1PlyProvider operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyProvider(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyProvider(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteV1PlyProviderProviderId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "providerId" -------------
+	var providerId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "providerId", runtime.ParamLocationPath, chi.URLParam(r, "providerId"), &providerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "providerId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteV1PlyProviderProviderId(w, r, providerId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV1PlyProviderProviderId operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "providerId" -------------
+	var providerId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "providerId", runtime.ParamLocationPath, chi.URLParam(r, "providerId"), &providerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "providerId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyProviderProviderId(w, r, providerId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV1PlyProviderProviderId operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "providerId" -------------
+	var providerId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "providerId", runtime.ParamLocationPath, chi.URLParam(r, "providerId"), &providerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "providerId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyProviderProviderId(w, r, providerId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV1PlyProviderProviderIdAffiliation operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyProviderProviderIdAffiliation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "providerId" -------------
+	var providerId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "providerId", runtime.ParamLocationPath, chi.URLParam(r, "providerId"), &providerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "providerId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyProviderProviderIdAffiliation(w, r, providerId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV1PlyProviderProviderIdAffiliation operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyProviderProviderIdAffiliation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "providerId" -------------
+	var providerId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "providerId", runtime.ParamLocationPath, chi.URLParam(r, "providerId"), &providerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "providerId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyProviderProviderIdAffiliation(w, r, providerId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV1PlyReportRevalidation operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyReportRevalidation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1PlyReportRevalidationParams
+
+	// ------------- Optional query parameter "withinDays" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "withinDays", r.URL.Query(), &params.WithinDays)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "withinDays", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "practiceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "practiceId", r.URL.Query(), &params.PracticeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "practiceId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyReportRevalidation(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV1PlySharedLinkId operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlySharedLinkId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "linkId" -------------
+	var linkId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "linkId", runtime.ParamLocationPath, chi.URLParam(r, "linkId"), &linkId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "linkId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetV1PlySharedLinkIdParams
+
+	// ------------- Required query parameter "expires" -------------
+
+	if paramValue := r.URL.Query().Get("expires"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "expires"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "expires", r.URL.Query(), &params.Expires)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expires", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "signature" -------------
+
+	if paramValue := r.URL.Query().Get("signature"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "signature"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "signature", r.URL.Query(), &params.Signature)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signature", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Range")]; found {
+		var Range string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Range", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Range", runtime.ParamLocationHeader, valueList[0], &Range)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Range", Err: err})
+			return
+		}
+
+		params.Range = &Range
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlySharedLinkId(w, r, linkId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV1PlyTaskTaskId operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyTaskTaskId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "taskId" -------------
+	var taskId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "taskId", runtime.ParamLocationPath, chi.URLParam(r, "taskId"), &taskId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyTaskTaskId(w, r, taskId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteV1PlyUploadUploadId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteV1PlyUploadUploadId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uploadId" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "uploadId", runtime.ParamLocationPath, chi.URLParam(r, "uploadId"), &uploadId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteV1PlyUploadUploadId(w, r, uploadId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetV1PlyUploadUploadId operation middleware
+func (siw *ServerInterfaceWrapper) GetV1PlyUploadUploadId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uploadId" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "uploadId", runtime.ParamLocationPath, chi.URLParam(r, "uploadId"), &uploadId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetV1PlyUploadUploadId(w, r, uploadId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PatchV1PlyUploadUploadId operation middleware
+func (siw *ServerInterfaceWrapper) PatchV1PlyUploadUploadId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uploadId" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "uploadId", runtime.ParamLocationPath, chi.URLParam(r, "uploadId"), &uploadId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchV1PlyUploadUploadIdParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Upload-Offset" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Upload-Offset")]; found {
+		var UploadOffset int64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Upload-Offset", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Upload-Offset", runtime.ParamLocationHeader, valueList[0], &UploadOffset)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Upload-Offset", Err: err})
+			return
+		}
+
+		params.UploadOffset = UploadOffset
+
+	} else {
+		err := fmt.Errorf("Header parameter Upload-Offset is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Upload-Offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchV1PlyUploadUploadId(w, r, uploadId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV1PlyUploadUploadIdFinalize operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyUploadUploadIdFinalize(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uploadId" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "uploadId", runtime.ParamLocationPath, chi.URLParam(r, "uploadId"), &uploadId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyUploadUploadIdFinalize(w, r, uploadId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV1PlyUserInvitation operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyUserInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyUserInvitation(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostV1PlyUserInvitationAccept operation middleware
+func (siw *ServerInterfaceWrapper) PostV1PlyUserInvitationAccept(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostV1PlyUserInvitationAccept(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/admin/document/verify", wrapper.PostV1PlyAdminDocumentVerify)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/admin/grant", wrapper.GetV1PlyAdminGrant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/admin/grant", wrapper.PostV1PlyAdminGrant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/ply/admin/grant/{grantId}", wrapper.DeleteV1PlyAdminGrantGrantId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/admin/mfa/policy", wrapper.GetV1PlyAdminMfaPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/admin/mfa/policy", wrapper.PostV1PlyAdminMfaPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/admin/storage/reconcile", wrapper.PostV1PlyAdminStorageReconcile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/admin/user", wrapper.GetV1PlyAdminUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/admin/user/{userId}/disable", wrapper.PostV1PlyAdminUserUserIdDisable)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/admin/user/{userId}/mfa/reset", wrapper.PostV1PlyAdminUserUserIdMfaReset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/ply/affiliation/{affiliationId}", wrapper.DeleteV1PlyAffiliationAffiliationId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/affiliation/{affiliationId}", wrapper.GetV1PlyAffiliationAffiliationId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/affiliation/{affiliationId}", wrapper.PostV1PlyAffiliationAffiliationId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/ply/document/{documentId}", wrapper.DeleteV1PlyDocumentDocumentId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/document/{documentId}", wrapper.GetV1PlyDocumentDocumentId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/document/{documentId}/extraction", wrapper.GetV1PlyDocumentDocumentIdExtraction)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/document/{documentId}/extraction", wrapper.PostV1PlyDocumentDocumentIdExtraction)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/document/{documentId}/extraction/apply", wrapper.PostV1PlyDocumentDocumentIdExtractionApply)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/document/{documentId}/link", wrapper.GetV1PlyDocumentDocumentIdLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/document/{documentId}/link", wrapper.PostV1PlyDocumentDocumentIdLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/ply/document/{documentId}/link/{linkId}", wrapper.DeleteV1PlyDocumentDocumentIdLinkLinkId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/document/{documentId}/metadata", wrapper.GetV1PlyDocumentDocumentIdMetadata)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/document/{documentId}/metadata", wrapper.PostV1PlyDocumentDocumentIdMetadata)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/document/{documentId}/preview", wrapper.GetV1PlyDocumentDocumentIdPreview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/document/{documentId}/version", wrapper.GetV1PlyDocumentDocumentIdVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/document/{documentId}/version", wrapper.PostV1PlyDocumentDocumentIdVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/document/{documentId}/version/{version}", wrapper.GetV1PlyDocumentDocumentIdVersionVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/document/{documentId}/version/{version}/current", wrapper.PostV1PlyDocumentDocumentIdVersionVersionCurrent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/enrollment", wrapper.PostV1PlyEnrollment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/ply/enrollment/{enrollmentId}", wrapper.DeleteV1PlyEnrollmentEnrollmentId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/enrollment/{enrollmentId}", wrapper.GetV1PlyEnrollmentEnrollmentId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/enrollment/{enrollmentId}", wrapper.PostV1PlyEnrollmentEnrollmentId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/enrollment/{enrollmentId}/activity", wrapper.GetV1PlyEnrollmentEnrollmentIdActivity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/enrollment/{enrollmentId}/dependents", wrapper.GetV1PlyEnrollmentEnrollmentIdDependents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/location", wrapper.PostV1PlyLocation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/ply/location/{locationId}", wrapper.DeleteV1PlyLocationLocationId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/location/{locationId}", wrapper.GetV1PlyLocationLocationId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/location/{locationId}", wrapper.PostV1PlyLocationLocationId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/login", wrapper.PostV1PlyLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/login/mfa", wrapper.PostV1PlyLoginMfa)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/me", wrapper.GetV1PlyMe)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/me/mfa", wrapper.PostV1PlyMeMfa)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/me/mfa/confirm", wrapper.PostV1PlyMeMfaConfirm)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/me/mfa/disable", wrapper.PostV1PlyMeMfaDisable)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/me/mfa/recovery", wrapper.PostV1PlyMeMfaRecovery)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/me/password", wrapper.PostV1PlyMePassword)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/organization", wrapper.PostV1PlyOrganization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/organization/list", wrapper.GetV1PlyOrganizationList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/organization/{organizationId}", wrapper.GetV1PlyOrganizationOrganizationId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/organization/{organizationId}", wrapper.PostV1PlyOrganizationOrganizationId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/organization/{organizationId}/enrollment", wrapper.GetV1PlyOrganizationOrganizationIdEnrollment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/organization/{organizationId}/practice", wrapper.GetV1PlyOrganizationOrganizationIdPractice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/organization/{organizationId}/provider", wrapper.GetV1PlyOrganizationOrganizationIdProvider)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/organization/{organizationId}/task", wrapper.GetV1PlyOrganizationOrganizationIdTask)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/password/reset", wrapper.PostV1PlyPasswordReset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/password/reset/confirm", wrapper.PostV1PlyPasswordResetConfirm)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/practice", wrapper.PostV1PlyPractice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/practice/list", wrapper.GetV1PlyPracticeList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/practice/{practiceId}", wrapper.GetV1PlyPracticePracticeId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/practice/{practiceId}", wrapper.PostV1PlyPracticePracticeId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/practice/{practiceId}/document", wrapper.GetV1PlyPracticePracticeIdDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/practice/{practiceId}/document/archive", wrapper.GetV1PlyPracticePracticeIdDocumentArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/practice/{practiceId}/document/search", wrapper.GetV1PlyPracticePracticeIdDocumentSearch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/practice/{practiceId}/enrollment", wrapper.GetV1PlyPracticePracticeIdEnrollment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/practice/{practiceId}/enrollment/duplicates", wrapper.GetV1PlyPracticePracticeIdEnrollmentDuplicates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/practice/{practiceId}/location", wrapper.GetV1PlyPracticePracticeIdLocation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/practice/{practiceId}/provider", wrapper.GetV1PlyPracticePracticeIdProvider)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/practice/{practiceId}/task", wrapper.GetV1PlyPracticePracticeIdTask)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/practice/{practiceId}/upload", wrapper.PostV1PlyPracticePracticeIdUpload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/practice/{practiceId}/upload/resumable", wrapper.PostV1PlyPracticePracticeIdUploadResumable)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/practice/{practiceId}/usage", wrapper.GetV1PlyPracticePracticeIdUsage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/provider", wrapper.PostV1PlyProvider)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/ply/provider/{providerId}", wrapper.DeleteV1PlyProviderProviderId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/provider/{providerId}", wrapper.GetV1PlyProviderProviderId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/provider/{providerId}", wrapper.PostV1PlyProviderProviderId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/provider/{providerId}/affiliation", wrapper.GetV1PlyProviderProviderIdAffiliation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/provider/{providerId}/affiliation", wrapper.PostV1PlyProviderProviderIdAffiliation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/report/revalidation", wrapper.GetV1PlyReportRevalidation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/shared/{linkId}", wrapper.GetV1PlySharedLinkId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/task/{taskId}", wrapper.PostV1PlyTaskTaskId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/ply/upload/{uploadId}", wrapper.DeleteV1PlyUploadUploadId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/ply/upload/{uploadId}", wrapper.GetV1PlyUploadUploadId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/ply/upload/{uploadId}", wrapper.PatchV1PlyUploadUploadId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/upload/{uploadId}/finalize", wrapper.PostV1PlyUploadUploadIdFinalize)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/user/invitation", wrapper.PostV1PlyUserInvitation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/ply/user/invitation/accept", wrapper.PostV1PlyUserInvitationAccept)
+	})
+
+	return r
+}
+
+type PostV1PlyAdminDocumentVerifyRequestObject struct {
+	Params PostV1PlyAdminDocumentVerifyParams
+}
+
+type PostV1PlyAdminDocumentVerifyResponseObject interface {
+	VisitPostV1PlyAdminDocumentVerifyResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyAdminDocumentVerify200JSONResponse struct {
+	Checked  *int `json:"checked,omitempty"`
+	Problems *[]struct {
+		ActualSha256   *string `json:"actualSha256,omitempty"`
+		DocumentId     *string `json:"documentId,omitempty"`
+		ExpectedSha256 *string `json:"expectedSha256,omitempty"`
+		Message        *string `json:"message,omitempty"`
+		PracticeId     *string `json:"practiceId,omitempty"`
+
+		// Status One of "missing", "corrupt" or "error"
+		Status *string `json:"status,omitempty"`
+	} `json:"problems,omitempty"`
+
+	// Recorded Documents that had no checksum and have now had one recorded
+	Recorded *int `json:"recorded,omitempty"`
+}
+
+func (response PostV1PlyAdminDocumentVerify200JSONResponse) VisitPostV1PlyAdminDocumentVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyAdminDocumentVerify500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyAdminDocumentVerify500JSONResponse) VisitPostV1PlyAdminDocumentVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyAdminGrantRequestObject struct {
+	Params GetV1PlyAdminGrantParams
+}
+
+type GetV1PlyAdminGrantResponseObject interface {
+	VisitGetV1PlyAdminGrantResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyAdminGrant200JSONResponse struct {
+	Grants *[]struct {
+		CreatedAt *time.Time `json:"createdAt,omitempty"`
+		GrantId   *string    `json:"grantId,omitempty"`
+
+		// OrganizationId The organization over whose practices the role is granted
+		OrganizationId *string `json:"organizationId,omitempty"`
+
+		// PracticeId The practice the role is granted over
+		PracticeId *string `json:"practiceId,omitempty"`
+
+		// Role One of "practice_viewer", "practice_editor", "coordinator" or "admin"
+		Role *string `json:"role,omitempty"`
+
+		// Subject The subject of a user's token, the name of a service's API key or the id of a user account
+		Subject *string `json:"subject,omitempty"`
+
+		// SubjectKind What subject names, one of "token", "service" or "account". Grants apply only to callers of their kind.
+		SubjectKind *string `json:"subjectKind,omitempty"`
+	} `json:"grants,omitempty"`
+}
+
+func (response GetV1PlyAdminGrant200JSONResponse) VisitGetV1PlyAdminGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyAdminGrant500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyAdminGrant500JSONResponse) VisitGetV1PlyAdminGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyAdminGrantRequestObject struct {
+	Body *PostV1PlyAdminGrantJSONRequestBody
+}
+
+type PostV1PlyAdminGrantResponseObject interface {
+	VisitPostV1PlyAdminGrantResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyAdminGrant200JSONResponse struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	GrantId   *string    `json:"grantId,omitempty"`
+
+	// OrganizationId The organization over whose practices the role is granted
+	OrganizationId *string `json:"organizationId,omitempty"`
+
+	// PracticeId The practice the role is granted over
+	PracticeId *string `json:"practiceId,omitempty"`
+
+	// Role One of "practice_viewer", "practice_editor", "coordinator" or "admin"
+	Role *string `json:"role,omitempty"`
+
+	// Subject The subject of a user's token, the name of a service's API key or the id of a user account
+	Subject *string `json:"subject,omitempty"`
+
+	// SubjectKind What subject names, one of "token", "service" or "account". Grants apply only to callers of their kind.
+	SubjectKind *string `json:"subjectKind,omitempty"`
+}
+
+func (response PostV1PlyAdminGrant200JSONResponse) VisitPostV1PlyAdminGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyAdminGrant400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyAdminGrant400JSONResponse) VisitPostV1PlyAdminGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyAdminGrant500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyAdminGrant500JSONResponse) VisitPostV1PlyAdminGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyAdminGrantGrantIdRequestObject struct {
+	GrantId string `json:"grantId"`
+}
+
+type DeleteV1PlyAdminGrantGrantIdResponseObject interface {
+	VisitDeleteV1PlyAdminGrantGrantIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteV1PlyAdminGrantGrantId200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response DeleteV1PlyAdminGrantGrantId200JSONResponse) VisitDeleteV1PlyAdminGrantGrantIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyAdminGrantGrantId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response DeleteV1PlyAdminGrantGrantId500JSONResponse) VisitDeleteV1PlyAdminGrantGrantIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyAdminMfaPolicyRequestObject struct {
+}
+
+type GetV1PlyAdminMfaPolicyResponseObject interface {
+	VisitGetV1PlyAdminMfaPolicyResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyAdminMfaPolicy200JSONResponse struct {
+	// ExemptServices Lets API keys holding a required role through. Services cannot use multi-factor authentication, so they are otherwise refused.
+	ExemptServices *bool `json:"exemptServices,omitempty"`
+
+	// RequiredRoles Roles whose holders must log in with a second factor, from "viewer", "editor", "manager" and "admin". Every role can currently read SSNs and EINs.
+	RequiredRoles *[]string  `json:"requiredRoles,omitempty"`
+	UpdatedAt     *time.Time `json:"updatedAt,omitempty"`
+}
+
+func (response GetV1PlyAdminMfaPolicy200JSONResponse) VisitGetV1PlyAdminMfaPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyAdminMfaPolicy500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyAdminMfaPolicy500JSONResponse) VisitGetV1PlyAdminMfaPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyAdminMfaPolicyRequestObject struct {
+	Body *PostV1PlyAdminMfaPolicyJSONRequestBody
+}
+
+type PostV1PlyAdminMfaPolicyResponseObject interface {
+	VisitPostV1PlyAdminMfaPolicyResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyAdminMfaPolicy200JSONResponse struct {
+	// ExemptServices Lets API keys holding a required role through. Services cannot use multi-factor authentication, so they are otherwise refused.
+	ExemptServices *bool `json:"exemptServices,omitempty"`
+
+	// RequiredRoles Roles whose holders must log in with a second factor, from "viewer", "editor", "manager" and "admin". Every role can currently read SSNs and EINs.
+	RequiredRoles *[]string  `json:"requiredRoles,omitempty"`
+	UpdatedAt     *time.Time `json:"updatedAt,omitempty"`
+}
+
+func (response PostV1PlyAdminMfaPolicy200JSONResponse) VisitPostV1PlyAdminMfaPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyAdminMfaPolicy400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyAdminMfaPolicy400JSONResponse) VisitPostV1PlyAdminMfaPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyAdminMfaPolicy500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyAdminMfaPolicy500JSONResponse) VisitPostV1PlyAdminMfaPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyAdminStorageReconcileRequestObject struct {
+	Params PostV1PlyAdminStorageReconcileParams
+}
+
+type PostV1PlyAdminStorageReconcileResponseObject interface {
+	VisitPostV1PlyAdminStorageReconcileResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyAdminStorageReconcile200JSONResponse struct {
+	// Files Files found in storage
+	Files    *int `json:"files,omitempty"`
+	Problems *[]struct {
+		// Action One of "repaired" or "deleted" when the problem was fixed
+		Action     *string `json:"action,omitempty"`
+		ActualSize *int64  `json:"actualSize,omitempty"`
+		DocumentId *string `json:"documentId,omitempty"`
+
+		// ExpectedSize Size of the file as stored, according to its record
+		ExpectedSize *int64 `json:"expectedSize,omitempty"`
+
+		// Key Storage key of the file
+		Key *string `json:"key,omitempty"`
+
+		// Kind One of "orphan_file", "missing_file" or "size_mismatch"
+		Kind *string `json:"kind,omitempty"`
+
+		// Message Why a requested fix failed
+		Message    *string `json:"message,omitempty"`
+		PracticeId *string `json:"practiceId,omitempty"`
+
+		// Version Set when the record is of an earlier document version
+		Version *int `json:"version,omitempty"`
+	} `json:"problems,omitempty"`
+
+	// Records Document and version records checked against their files
+	Records *int `json:"records,omitempty"`
+}
+
+func (response PostV1PlyAdminStorageReconcile200JSONResponse) VisitPostV1PlyAdminStorageReconcileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyAdminStorageReconcile500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyAdminStorageReconcile500JSONResponse) VisitPostV1PlyAdminStorageReconcileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyAdminUserRequestObject struct {
+}
+
+type GetV1PlyAdminUserResponseObject interface {
+	VisitGetV1PlyAdminUserResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyAdminUser200JSONResponse struct {
+	Users *[]struct {
+		CreatedAt   *time.Time `json:"createdAt,omitempty"`
+		Email       *string    `json:"email,omitempty"`
+		LastLoginAt *time.Time `json:"lastLoginAt,omitempty"`
+		MfaEnabled  *bool      `json:"mfaEnabled,omitempty"`
+		Name        *string    `json:"name,omitempty"`
+
+		// Status One of "invited", "active" or "disabled"
+		Status *string `json:"status,omitempty"`
+		UserId *string `json:"userId,omitempty"`
+	} `json:"users,omitempty"`
+}
+
+func (response GetV1PlyAdminUser200JSONResponse) VisitGetV1PlyAdminUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyAdminUser500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyAdminUser500JSONResponse) VisitGetV1PlyAdminUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyAdminUserUserIdDisableRequestObject struct {
+	UserId string `json:"userId"`
+}
+
+type PostV1PlyAdminUserUserIdDisableResponseObject interface {
+	VisitPostV1PlyAdminUserUserIdDisableResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyAdminUserUserIdDisable200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response PostV1PlyAdminUserUserIdDisable200JSONResponse) VisitPostV1PlyAdminUserUserIdDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyAdminUserUserIdDisable404JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyAdminUserUserIdDisable404JSONResponse) VisitPostV1PlyAdminUserUserIdDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyAdminUserUserIdDisable500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyAdminUserUserIdDisable500JSONResponse) VisitPostV1PlyAdminUserUserIdDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyAdminUserUserIdMfaResetRequestObject struct {
+	UserId string `json:"userId"`
+}
+
+type PostV1PlyAdminUserUserIdMfaResetResponseObject interface {
+	VisitPostV1PlyAdminUserUserIdMfaResetResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyAdminUserUserIdMfaReset200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response PostV1PlyAdminUserUserIdMfaReset200JSONResponse) VisitPostV1PlyAdminUserUserIdMfaResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyAdminUserUserIdMfaReset404JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyAdminUserUserIdMfaReset404JSONResponse) VisitPostV1PlyAdminUserUserIdMfaResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyAdminUserUserIdMfaReset500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyAdminUserUserIdMfaReset500JSONResponse) VisitPostV1PlyAdminUserUserIdMfaResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyAffiliationAffiliationIdRequestObject struct {
+	AffiliationId string `json:"affiliationId"`
+}
+
+type DeleteV1PlyAffiliationAffiliationIdResponseObject interface {
+	VisitDeleteV1PlyAffiliationAffiliationIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteV1PlyAffiliationAffiliationId200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response DeleteV1PlyAffiliationAffiliationId200JSONResponse) VisitDeleteV1PlyAffiliationAffiliationIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyAffiliationAffiliationId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response DeleteV1PlyAffiliationAffiliationId500JSONResponse) VisitDeleteV1PlyAffiliationAffiliationIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyAffiliationAffiliationIdRequestObject struct {
+	AffiliationId string `json:"affiliationId"`
+}
+
+type GetV1PlyAffiliationAffiliationIdResponseObject interface {
+	VisitGetV1PlyAffiliationAffiliationIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyAffiliationAffiliationId200JSONResponse struct {
+	AffiliationId *string             `json:"affiliationId,omitempty"`
+	EndDate       *openapi_types.Date `json:"endDate,omitempty"`
+	PracticeId    *string             `json:"practiceId,omitempty"`
+	ProviderId    *string             `json:"providerId,omitempty"`
+	Role          *string             `json:"role,omitempty"`
+	StartDate     *openapi_types.Date `json:"startDate,omitempty"`
+}
+
+func (response GetV1PlyAffiliationAffiliationId200JSONResponse) VisitGetV1PlyAffiliationAffiliationIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyAffiliationAffiliationId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyAffiliationAffiliationId500JSONResponse) VisitGetV1PlyAffiliationAffiliationIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyAffiliationAffiliationIdRequestObject struct {
+	AffiliationId string `json:"affiliationId"`
+	Body          *PostV1PlyAffiliationAffiliationIdJSONRequestBody
+}
+
+type PostV1PlyAffiliationAffiliationIdResponseObject interface {
+	VisitPostV1PlyAffiliationAffiliationIdResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyAffiliationAffiliationId200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response PostV1PlyAffiliationAffiliationId200JSONResponse) VisitPostV1PlyAffiliationAffiliationIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyAffiliationAffiliationId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyAffiliationAffiliationId500JSONResponse) VisitPostV1PlyAffiliationAffiliationIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyDocumentDocumentIdRequestObject struct {
+	DocumentId string `json:"documentId"`
+}
+
+type DeleteV1PlyDocumentDocumentIdResponseObject interface {
+	VisitDeleteV1PlyDocumentDocumentIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteV1PlyDocumentDocumentId200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response DeleteV1PlyDocumentDocumentId200JSONResponse) VisitDeleteV1PlyDocumentDocumentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyDocumentDocumentId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response DeleteV1PlyDocumentDocumentId500JSONResponse) VisitDeleteV1PlyDocumentDocumentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyDocumentDocumentIdRequestObject struct {
+	DocumentId string `json:"documentId"`
+	Params     GetV1PlyDocumentDocumentIdParams
+}
+
+type GetV1PlyDocumentDocumentIdResponseObject interface {
+	VisitGetV1PlyDocumentDocumentIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyDocumentDocumentId200ResponseHeaders struct {
+	AcceptRanges        string
+	ContentDisposition  string
+	XContentTypeOptions string
+}
+
+type GetV1PlyDocumentDocumentId200AsteriskResponse struct {
+	Body          io.Reader
+	Headers       GetV1PlyDocumentDocumentId200ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetV1PlyDocumentDocumentId200AsteriskResponse) VisitGetV1PlyDocumentDocumentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Accept-Ranges", fmt.Sprint(response.Headers.AcceptRanges))
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("X-Content-Type-Options", fmt.Sprint(response.Headers.XContentTypeOptions))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1PlyDocumentDocumentId206ResponseHeaders struct {
+	AcceptRanges        string
+	ContentDisposition  string
+	ContentRange        string
+	XContentTypeOptions string
+}
+
+type GetV1PlyDocumentDocumentId206AsteriskResponse struct {
+	Body          io.Reader
+	Headers       GetV1PlyDocumentDocumentId206ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetV1PlyDocumentDocumentId206AsteriskResponse) VisitGetV1PlyDocumentDocumentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Accept-Ranges", fmt.Sprint(response.Headers.AcceptRanges))
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("Content-Range", fmt.Sprint(response.Headers.ContentRange))
+	w.Header().Set("X-Content-Type-Options", fmt.Sprint(response.Headers.XContentTypeOptions))
+	w.WriteHeader(206)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1PlyDocumentDocumentId403JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyDocumentDocumentId403JSONResponse) VisitGetV1PlyDocumentDocumentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyDocumentDocumentId416ResponseHeaders struct {
+	ContentRange string
+}
+
+type GetV1PlyDocumentDocumentId416JSONResponse struct {
+	Body struct {
+		Code    int32  `json:"code"`
+		Message string `json:"message"`
+	}
+	Headers GetV1PlyDocumentDocumentId416ResponseHeaders
+}
+
+func (response GetV1PlyDocumentDocumentId416JSONResponse) VisitGetV1PlyDocumentDocumentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Range", fmt.Sprint(response.Headers.ContentRange))
+	w.WriteHeader(416)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1PlyDocumentDocumentId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyDocumentDocumentId500JSONResponse) VisitGetV1PlyDocumentDocumentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyDocumentDocumentIdExtractionRequestObject struct {
+	DocumentId string `json:"documentId"`
+}
+
+type GetV1PlyDocumentDocumentIdExtractionResponseObject interface {
+	VisitGetV1PlyDocumentDocumentIdExtractionResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyDocumentDocumentIdExtraction200JSONResponse struct {
+	DocumentId *string `json:"documentId,omitempty"`
+	Fields     *[]struct {
+		Applied    *bool    `json:"applied,omitempty"`
+		Confidence *float64 `json:"confidence,omitempty"`
+
+		// Key The label the extractor found, as printed on the document
+		Key  *string `json:"key,omitempty"`
+		Page *int    `json:"page,omitempty"`
+
+		// Target The record field the value is suggested for, one of "provider.name", "provider.ssn", "practice.name", "practice.ein" or "practice.owner_name"; empty when there is no suggestion
+		Target *string `json:"target,omitempty"`
+		Value  *string `json:"value,omitempty"`
+	} `json:"fields,omitempty"`
+
+	// Status One of "pending", "running", "completed" or "failed"
+	Status *string `json:"status,omitempty"`
+}
+
+func (response GetV1PlyDocumentDocumentIdExtraction200JSONResponse) VisitGetV1PlyDocumentDocumentIdExtractionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyDocumentDocumentIdExtraction500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyDocumentDocumentIdExtraction500JSONResponse) VisitGetV1PlyDocumentDocumentIdExtractionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdExtractionRequestObject struct {
+	DocumentId string `json:"documentId"`
+}
+
+type PostV1PlyDocumentDocumentIdExtractionResponseObject interface {
+	VisitPostV1PlyDocumentDocumentIdExtractionResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyDocumentDocumentIdExtraction200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response PostV1PlyDocumentDocumentIdExtraction200JSONResponse) VisitPostV1PlyDocumentDocumentIdExtractionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdExtraction500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdExtraction500JSONResponse) VisitPostV1PlyDocumentDocumentIdExtractionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdExtractionApplyRequestObject struct {
+	DocumentId string `json:"documentId"`
+	Body       *PostV1PlyDocumentDocumentIdExtractionApplyJSONRequestBody
+}
+
+type PostV1PlyDocumentDocumentIdExtractionApplyResponseObject interface {
+	VisitPostV1PlyDocumentDocumentIdExtractionApplyResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyDocumentDocumentIdExtractionApply200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response PostV1PlyDocumentDocumentIdExtractionApply200JSONResponse) VisitPostV1PlyDocumentDocumentIdExtractionApplyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdExtractionApply400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdExtractionApply400JSONResponse) VisitPostV1PlyDocumentDocumentIdExtractionApplyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdExtractionApply403JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdExtractionApply403JSONResponse) VisitPostV1PlyDocumentDocumentIdExtractionApplyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdExtractionApply409JSONResponse struct {
+	Code int32 `json:"code"`
+
+	// ExistingId The identifier of the record that conflicts with the request
+	ExistingId *string `json:"existingId,omitempty"`
+	Message    string  `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdExtractionApply409JSONResponse) VisitPostV1PlyDocumentDocumentIdExtractionApplyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdExtractionApply500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdExtractionApply500JSONResponse) VisitPostV1PlyDocumentDocumentIdExtractionApplyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyDocumentDocumentIdLinkRequestObject struct {
+	DocumentId string `json:"documentId"`
+}
+
+type GetV1PlyDocumentDocumentIdLinkResponseObject interface {
+	VisitGetV1PlyDocumentDocumentIdLinkResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyDocumentDocumentIdLink200JSONResponse struct {
+	Links *[]struct {
+		CreatedAt  *time.Time `json:"createdAt,omitempty"`
+		DocumentId *string    `json:"documentId,omitempty"`
+		ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+		LinkId     *string    `json:"linkId,omitempty"`
+		PracticeId *string    `json:"practiceId,omitempty"`
+		RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+		SingleUse  *bool      `json:"singleUse,omitempty"`
+
+		// Url Signed download URL; omitted once the link may no longer be used
+		Url    *string    `json:"url,omitempty"`
+		UsedAt *time.Time `json:"usedAt,omitempty"`
+
+		// Version The document version the link serves
+		Version *int `json:"version,omitempty"`
+	} `json:"links,omitempty"`
+}
+
+func (response GetV1PlyDocumentDocumentIdLink200JSONResponse) VisitGetV1PlyDocumentDocumentIdLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyDocumentDocumentIdLink500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyDocumentDocumentIdLink500JSONResponse) VisitGetV1PlyDocumentDocumentIdLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdLinkRequestObject struct {
+	DocumentId string `json:"documentId"`
+	Body       *PostV1PlyDocumentDocumentIdLinkJSONRequestBody
+}
+
+type PostV1PlyDocumentDocumentIdLinkResponseObject interface {
+	VisitPostV1PlyDocumentDocumentIdLinkResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyDocumentDocumentIdLink201JSONResponse struct {
+	CreatedAt  *time.Time `json:"createdAt,omitempty"`
+	DocumentId *string    `json:"documentId,omitempty"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	LinkId     *string    `json:"linkId,omitempty"`
+	PracticeId *string    `json:"practiceId,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	SingleUse  *bool      `json:"singleUse,omitempty"`
+
+	// Url Signed download URL; omitted once the link may no longer be used
+	Url    *string    `json:"url,omitempty"`
+	UsedAt *time.Time `json:"usedAt,omitempty"`
+
+	// Version The document version the link serves
+	Version *int `json:"version,omitempty"`
+}
+
+func (response PostV1PlyDocumentDocumentIdLink201JSONResponse) VisitPostV1PlyDocumentDocumentIdLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdLink400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdLink400JSONResponse) VisitPostV1PlyDocumentDocumentIdLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdLink403JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdLink403JSONResponse) VisitPostV1PlyDocumentDocumentIdLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdLink404JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdLink404JSONResponse) VisitPostV1PlyDocumentDocumentIdLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdLink500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdLink500JSONResponse) VisitPostV1PlyDocumentDocumentIdLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdLink503JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdLink503JSONResponse) VisitPostV1PlyDocumentDocumentIdLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyDocumentDocumentIdLinkLinkIdRequestObject struct {
+	DocumentId string `json:"documentId"`
+	LinkId     string `json:"linkId"`
+}
+
+type DeleteV1PlyDocumentDocumentIdLinkLinkIdResponseObject interface {
+	VisitDeleteV1PlyDocumentDocumentIdLinkLinkIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteV1PlyDocumentDocumentIdLinkLinkId200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response DeleteV1PlyDocumentDocumentIdLinkLinkId200JSONResponse) VisitDeleteV1PlyDocumentDocumentIdLinkLinkIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyDocumentDocumentIdLinkLinkId404JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response DeleteV1PlyDocumentDocumentIdLinkLinkId404JSONResponse) VisitDeleteV1PlyDocumentDocumentIdLinkLinkIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyDocumentDocumentIdLinkLinkId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response DeleteV1PlyDocumentDocumentIdLinkLinkId500JSONResponse) VisitDeleteV1PlyDocumentDocumentIdLinkLinkIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyDocumentDocumentIdMetadataRequestObject struct {
+	DocumentId string `json:"documentId"`
+}
+
+type GetV1PlyDocumentDocumentIdMetadataResponseObject interface {
+	VisitGetV1PlyDocumentDocumentIdMetadataResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyDocumentDocumentIdMetadata200JSONResponse struct {
+	// ContentType The media type detected from the file content
+	ContentType *string `json:"content_type,omitempty"`
+
+	// CurrentVersion The version whose file the document currently serves
+	CurrentVersion *int    `json:"currentVersion,omitempty"`
+	DocumentId     *string `json:"documentId,omitempty"`
+
+	// DocumentType One of "w9", "license", "coi", "cv", "board_certification", "payer_letter", "dea" or "other"
+	DocumentType    *string             `json:"documentType,omitempty"`
+	EnrollmentId    *string             `json:"enrollmentId,omitempty"`
+	ExpirationDate  *openapi_types.Date `json:"expirationDate,omitempty"`
+	ExtractedFields *[]struct {
+		Applied    *bool    `json:"applied,omitempty"`
+		Confidence *float64 `json:"confidence,omitempty"`
+
+		// Key The label the extractor found, as printed on the document
+		Key  *string `json:"key,omitempty"`
+		Page *int    `json:"page,omitempty"`
+
+		// Target The record field the value is suggested for, one of "provider.name", "provider.ssn", "practice.name", "practice.ein" or "practice.owner_name"; empty when there is no suggestion
+		Target *string `json:"target,omitempty"`
+		Value  *string `json:"value,omitempty"`
+	} `json:"extractedFields,omitempty"`
+
+	// ExtractionStatus One of "pending", "running", "completed" or "failed"; empty when extraction is disabled
+	ExtractionStatus *string `json:"extractionStatus,omitempty"`
+	FileName         *string `json:"file_name,omitempty"`
+	LocationId       *string `json:"locationId,omitempty"`
+	PracticeId       *string `json:"practiceId,omitempty"`
+
+	// PreviewAvailable Whether a preview of the current version can be downloaded
+	PreviewAvailable *bool   `json:"previewAvailable,omitempty"`
+	ProviderId       *string `json:"providerId,omitempty"`
+
+	// ScanSignature The malware found in an infected file
+	ScanSignature *string `json:"scanSignature,omitempty"`
+
+	// ScanStatus One of "clean", "infected", "error" or "too_large", for files larger than the scanner accepts, which are not scanned again; empty when not scanned. Only clean files can be downloaded while scanning is enabled.
+	ScanStatus *string `json:"scanStatus,omitempty"`
+
+	// Sha256 Hex-encoded SHA-256 of the file content
+	Sha256      *string `json:"sha256,omitempty"`
+	Size        *int64  `json:"size,omitempty"`
+	StoragePath *string `json:"storage_path,omitempty"`
+}
+
+func (response GetV1PlyDocumentDocumentIdMetadata200JSONResponse) VisitGetV1PlyDocumentDocumentIdMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyDocumentDocumentIdMetadata500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyDocumentDocumentIdMetadata500JSONResponse) VisitGetV1PlyDocumentDocumentIdMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdMetadataRequestObject struct {
+	DocumentId string `json:"documentId"`
+	Body       *PostV1PlyDocumentDocumentIdMetadataJSONRequestBody
+}
+
+type PostV1PlyDocumentDocumentIdMetadataResponseObject interface {
+	VisitPostV1PlyDocumentDocumentIdMetadataResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyDocumentDocumentIdMetadata200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response PostV1PlyDocumentDocumentIdMetadata200JSONResponse) VisitPostV1PlyDocumentDocumentIdMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdMetadata400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdMetadata400JSONResponse) VisitPostV1PlyDocumentDocumentIdMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdMetadata500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdMetadata500JSONResponse) VisitPostV1PlyDocumentDocumentIdMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyDocumentDocumentIdPreviewRequestObject struct {
+	DocumentId string `json:"documentId"`
+}
+
+type GetV1PlyDocumentDocumentIdPreviewResponseObject interface {
+	VisitGetV1PlyDocumentDocumentIdPreviewResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyDocumentDocumentIdPreview200ResponseHeaders struct {
+	CacheControl string
+}
+
+type GetV1PlyDocumentDocumentIdPreview200ImagepngResponse struct {
+	Body          io.Reader
+	Headers       GetV1PlyDocumentDocumentIdPreview200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetV1PlyDocumentDocumentIdPreview200ImagepngResponse) VisitGetV1PlyDocumentDocumentIdPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/png")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1PlyDocumentDocumentIdPreview403JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyDocumentDocumentIdPreview403JSONResponse) VisitGetV1PlyDocumentDocumentIdPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyDocumentDocumentIdPreview404JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyDocumentDocumentIdPreview404JSONResponse) VisitGetV1PlyDocumentDocumentIdPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyDocumentDocumentIdPreview500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyDocumentDocumentIdPreview500JSONResponse) VisitGetV1PlyDocumentDocumentIdPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyDocumentDocumentIdVersionRequestObject struct {
+	DocumentId string `json:"documentId"`
+}
+
+type GetV1PlyDocumentDocumentIdVersionResponseObject interface {
+	VisitGetV1PlyDocumentDocumentIdVersionResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyDocumentDocumentIdVersion200JSONResponse struct {
+	CurrentVersion *int `json:"currentVersion,omitempty"`
+	Versions       *[]struct {
+		// ContentType The media type detected from the file content
+		ContentType *string `json:"content_type,omitempty"`
+		DocumentId  *string `json:"documentId,omitempty"`
+		FileName    *string `json:"file_name,omitempty"`
+
+		// ScanSignature The malware found in an infected file
+		ScanSignature *string `json:"scanSignature,omitempty"`
+
+		// ScanStatus One of "clean", "infected", "error" or "too_large", for files larger than the scanner accepts, which are not scanned again; empty when not scanned. Only clean files can be downloaded while scanning is enabled.
+		ScanStatus *string `json:"scanStatus,omitempty"`
+
+		// Sha256 Hex-encoded SHA-256 of the file content
+		Sha256     *string    `json:"sha256,omitempty"`
+		Size       *int64     `json:"size,omitempty"`
+		UploadedAt *time.Time `json:"uploadedAt,omitempty"`
+		Version    *int       `json:"version,omitempty"`
+	} `json:"versions,omitempty"`
+}
+
+func (response GetV1PlyDocumentDocumentIdVersion200JSONResponse) VisitGetV1PlyDocumentDocumentIdVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyDocumentDocumentIdVersion500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyDocumentDocumentIdVersion500JSONResponse) VisitGetV1PlyDocumentDocumentIdVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdVersionRequestObject struct {
+	DocumentId string `json:"documentId"`
+	Body       *multipart.Reader
+}
+
+type PostV1PlyDocumentDocumentIdVersionResponseObject interface {
+	VisitPostV1PlyDocumentDocumentIdVersionResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyDocumentDocumentIdVersion200JSONResponse struct {
+	DocumentId *string `json:"documentId,omitempty"`
+	Version    *int    `json:"version,omitempty"`
+}
+
+func (response PostV1PlyDocumentDocumentIdVersion200JSONResponse) VisitPostV1PlyDocumentDocumentIdVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdVersion409JSONResponse struct {
+	Code int32 `json:"code"`
+
+	// ExistingId The identifier of the record that conflicts with the request
+	ExistingId *string `json:"existingId,omitempty"`
+	Message    string  `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdVersion409JSONResponse) VisitPostV1PlyDocumentDocumentIdVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdVersion413JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdVersion413JSONResponse) VisitPostV1PlyDocumentDocumentIdVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdVersion415JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdVersion415JSONResponse) VisitPostV1PlyDocumentDocumentIdVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(415)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdVersion500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdVersion500JSONResponse) VisitPostV1PlyDocumentDocumentIdVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdVersion507JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdVersion507JSONResponse) VisitPostV1PlyDocumentDocumentIdVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(507)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyDocumentDocumentIdVersionVersionRequestObject struct {
+	DocumentId string `json:"documentId"`
+	Version    int    `json:"version"`
+	Params     GetV1PlyDocumentDocumentIdVersionVersionParams
+}
+
+type GetV1PlyDocumentDocumentIdVersionVersionResponseObject interface {
+	VisitGetV1PlyDocumentDocumentIdVersionVersionResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyDocumentDocumentIdVersionVersion200ResponseHeaders struct {
+	AcceptRanges        string
+	ContentDisposition  string
+	XContentTypeOptions string
+}
+
+type GetV1PlyDocumentDocumentIdVersionVersion200AsteriskResponse struct {
+	Body          io.Reader
+	Headers       GetV1PlyDocumentDocumentIdVersionVersion200ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetV1PlyDocumentDocumentIdVersionVersion200AsteriskResponse) VisitGetV1PlyDocumentDocumentIdVersionVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Accept-Ranges", fmt.Sprint(response.Headers.AcceptRanges))
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("X-Content-Type-Options", fmt.Sprint(response.Headers.XContentTypeOptions))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1PlyDocumentDocumentIdVersionVersion206ResponseHeaders struct {
+	AcceptRanges        string
+	ContentDisposition  string
+	ContentRange        string
+	XContentTypeOptions string
+}
+
+type GetV1PlyDocumentDocumentIdVersionVersion206AsteriskResponse struct {
+	Body          io.Reader
+	Headers       GetV1PlyDocumentDocumentIdVersionVersion206ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetV1PlyDocumentDocumentIdVersionVersion206AsteriskResponse) VisitGetV1PlyDocumentDocumentIdVersionVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Accept-Ranges", fmt.Sprint(response.Headers.AcceptRanges))
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("Content-Range", fmt.Sprint(response.Headers.ContentRange))
+	w.Header().Set("X-Content-Type-Options", fmt.Sprint(response.Headers.XContentTypeOptions))
+	w.WriteHeader(206)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1PlyDocumentDocumentIdVersionVersion403JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyDocumentDocumentIdVersionVersion403JSONResponse) VisitGetV1PlyDocumentDocumentIdVersionVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyDocumentDocumentIdVersionVersion404JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyDocumentDocumentIdVersionVersion404JSONResponse) VisitGetV1PlyDocumentDocumentIdVersionVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyDocumentDocumentIdVersionVersion416ResponseHeaders struct {
+	ContentRange string
+}
+
+type GetV1PlyDocumentDocumentIdVersionVersion416JSONResponse struct {
+	Body struct {
+		Code    int32  `json:"code"`
+		Message string `json:"message"`
+	}
+	Headers GetV1PlyDocumentDocumentIdVersionVersion416ResponseHeaders
+}
+
+func (response GetV1PlyDocumentDocumentIdVersionVersion416JSONResponse) VisitGetV1PlyDocumentDocumentIdVersionVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Range", fmt.Sprint(response.Headers.ContentRange))
+	w.WriteHeader(416)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1PlyDocumentDocumentIdVersionVersion500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyDocumentDocumentIdVersionVersion500JSONResponse) VisitGetV1PlyDocumentDocumentIdVersionVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdVersionVersionCurrentRequestObject struct {
+	DocumentId string `json:"documentId"`
+	Version    int    `json:"version"`
+}
+
+type PostV1PlyDocumentDocumentIdVersionVersionCurrentResponseObject interface {
+	VisitPostV1PlyDocumentDocumentIdVersionVersionCurrentResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyDocumentDocumentIdVersionVersionCurrent200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response PostV1PlyDocumentDocumentIdVersionVersionCurrent200JSONResponse) VisitPostV1PlyDocumentDocumentIdVersionVersionCurrentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdVersionVersionCurrent404JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdVersionVersionCurrent404JSONResponse) VisitPostV1PlyDocumentDocumentIdVersionVersionCurrentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyDocumentDocumentIdVersionVersionCurrent500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyDocumentDocumentIdVersionVersionCurrent500JSONResponse) VisitPostV1PlyDocumentDocumentIdVersionVersionCurrentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyEnrollmentRequestObject struct {
+	Body *PostV1PlyEnrollmentJSONRequestBody
+}
+
+type PostV1PlyEnrollmentResponseObject interface {
+	VisitPostV1PlyEnrollmentResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyEnrollment200JSONResponse struct {
+	EnrollmentId *string `json:"enrollmentId,omitempty"`
+}
+
+func (response PostV1PlyEnrollment200JSONResponse) VisitPostV1PlyEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyEnrollment400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyEnrollment400JSONResponse) VisitPostV1PlyEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyEnrollment409JSONResponse struct {
+	Code int32 `json:"code"`
+
+	// ExistingId The identifier of the record that conflicts with the request
+	ExistingId *string `json:"existingId,omitempty"`
+	Message    string  `json:"message"`
+}
+
+func (response PostV1PlyEnrollment409JSONResponse) VisitPostV1PlyEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyEnrollment500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyEnrollment500JSONResponse) VisitPostV1PlyEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyEnrollmentEnrollmentIdRequestObject struct {
+	EnrollmentId string `json:"enrollmentId"`
+}
+
+type DeleteV1PlyEnrollmentEnrollmentIdResponseObject interface {
+	VisitDeleteV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteV1PlyEnrollmentEnrollmentId200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response DeleteV1PlyEnrollmentEnrollmentId200JSONResponse) VisitDeleteV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyEnrollmentEnrollmentId409JSONResponse struct {
+	Code int32 `json:"code"`
+
+	// ExistingId The identifier of the record that conflicts with the request
+	ExistingId *string `json:"existingId,omitempty"`
+	Message    string  `json:"message"`
+}
+
+func (response DeleteV1PlyEnrollmentEnrollmentId409JSONResponse) VisitDeleteV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyEnrollmentEnrollmentId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response DeleteV1PlyEnrollmentEnrollmentId500JSONResponse) VisitDeleteV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyEnrollmentEnrollmentIdRequestObject struct {
+	EnrollmentId string `json:"enrollmentId"`
+}
+
+type GetV1PlyEnrollmentEnrollmentIdResponseObject interface {
+	VisitGetV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyEnrollmentEnrollmentId200JSONResponse struct {
+	ApprovalDate  *openapi_types.Date `json:"approvalDate,omitempty"`
+	EffectiveDate *openapi_types.Date `json:"effectiveDate,omitempty"`
+	EnrollmentId  *string             `json:"enrollmentId,omitempty"`
+
+	// GroupEnrollmentId For an individual enrollment, the group enrollment its benefits are reassigned to
+	GroupEnrollmentId *string `json:"groupEnrollmentId,omitempty"`
+
+	// Kind Either "group" or "individual"; an enrollment without one is individual. A group enrollment cannot change kind while individual enrollments reference it.
+	Kind                *string             `json:"kind,omitempty"`
+	LocationId          *string             `json:"locationId,omitempty"`
+	Payer               *string             `json:"payer,omitempty"`
+	PayerProviderNumber *string             `json:"payerProviderNumber,omitempty"`
+	PracticeId          *string             `json:"practiceId,omitempty"`
+	ProviderId          *string             `json:"providerId,omitempty"`
+	Ptan                *string             `json:"ptan,omitempty"`
+	RevalidationDueDate *openapi_types.Date `json:"revalidationDueDate,omitempty"`
+	State               *string             `json:"state,omitempty"`
+	Status              *string             `json:"status,omitempty"`
+	SubmittedDate       *openapi_types.Date `json:"submittedDate,omitempty"`
+	Type                *string             `json:"type,omitempty"`
+}
+
+func (response GetV1PlyEnrollmentEnrollmentId200JSONResponse) VisitGetV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyEnrollmentEnrollmentId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyEnrollmentEnrollmentId500JSONResponse) VisitGetV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyEnrollmentEnrollmentIdRequestObject struct {
+	EnrollmentId string `json:"enrollmentId"`
+	Body         *PostV1PlyEnrollmentEnrollmentIdJSONRequestBody
+}
+
+type PostV1PlyEnrollmentEnrollmentIdResponseObject interface {
+	VisitPostV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyEnrollmentEnrollmentId200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response PostV1PlyEnrollmentEnrollmentId200JSONResponse) VisitPostV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyEnrollmentEnrollmentId400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyEnrollmentEnrollmentId400JSONResponse) VisitPostV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyEnrollmentEnrollmentId409JSONResponse struct {
+	Code int32 `json:"code"`
+
+	// ExistingId The identifier of the record that conflicts with the request
+	ExistingId *string `json:"existingId,omitempty"`
+	Message    string  `json:"message"`
+}
+
+func (response PostV1PlyEnrollmentEnrollmentId409JSONResponse) VisitPostV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyEnrollmentEnrollmentId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyEnrollmentEnrollmentId500JSONResponse) VisitPostV1PlyEnrollmentEnrollmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyEnrollmentEnrollmentIdActivityRequestObject struct {
+	EnrollmentId string `json:"enrollmentId"`
+}
+
+type GetV1PlyEnrollmentEnrollmentIdActivityResponseObject interface {
+	VisitGetV1PlyEnrollmentEnrollmentIdActivityResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyEnrollmentEnrollmentIdActivity200JSONResponse struct {
+	Activities *[]struct {
+		ActivityId   *string `json:"activityId,omitempty"`
+		EnrollmentId *string `json:"enrollmentId,omitempty"`
+		Message      *string `json:"message,omitempty"`
+	} `json:"activities,omitempty"`
+}
+
+func (response GetV1PlyEnrollmentEnrollmentIdActivity200JSONResponse) VisitGetV1PlyEnrollmentEnrollmentIdActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyEnrollmentEnrollmentIdActivity500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyEnrollmentEnrollmentIdActivity500JSONResponse) VisitGetV1PlyEnrollmentEnrollmentIdActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyEnrollmentEnrollmentIdDependentsRequestObject struct {
+	EnrollmentId string `json:"enrollmentId"`
+}
+
+type GetV1PlyEnrollmentEnrollmentIdDependentsResponseObject interface {
+	VisitGetV1PlyEnrollmentEnrollmentIdDependentsResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyEnrollmentEnrollmentIdDependents200JSONResponse struct {
+	Enrollments *[]struct {
+		ApprovalDate  *openapi_types.Date `json:"approvalDate,omitempty"`
+		EffectiveDate *openapi_types.Date `json:"effectiveDate,omitempty"`
+		EnrollmentId  *string             `json:"enrollmentId,omitempty"`
+
+		// GroupEnrollmentId For an individual enrollment, the group enrollment its benefits are reassigned to
+		GroupEnrollmentId *string `json:"groupEnrollmentId,omitempty"`
+
+		// Kind Either "group" or "individual"; an enrollment without one is individual. A group enrollment cannot change kind while individual enrollments reference it.
+		Kind                *string             `json:"kind,omitempty"`
+		LocationId          *string             `json:"locationId,omitempty"`
+		Payer               *string             `json:"payer,omitempty"`
+		PayerProviderNumber *string             `json:"payerProviderNumber,omitempty"`
+		PracticeId          *string             `json:"practiceId,omitempty"`
+		ProviderId          *string             `json:"providerId,omitempty"`
+		Ptan                *string             `json:"ptan,omitempty"`
+		RevalidationDueDate *openapi_types.Date `json:"revalidationDueDate,omitempty"`
+		State               *string             `json:"state,omitempty"`
+		Status              *string             `json:"status,omitempty"`
+		SubmittedDate       *openapi_types.Date `json:"submittedDate,omitempty"`
+		Type                *string             `json:"type,omitempty"`
+	} `json:"enrollments,omitempty"`
+}
+
+func (response GetV1PlyEnrollmentEnrollmentIdDependents200JSONResponse) VisitGetV1PlyEnrollmentEnrollmentIdDependentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyEnrollmentEnrollmentIdDependents500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyEnrollmentEnrollmentIdDependents500JSONResponse) VisitGetV1PlyEnrollmentEnrollmentIdDependentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyLocationRequestObject struct {
+	Body *PostV1PlyLocationJSONRequestBody
+}
+
+type PostV1PlyLocationResponseObject interface {
+	VisitPostV1PlyLocationResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyLocation200JSONResponse struct {
+	LocationId *string `json:"locationId,omitempty"`
+}
+
+func (response PostV1PlyLocation200JSONResponse) VisitPostV1PlyLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyLocation500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyLocation500JSONResponse) VisitPostV1PlyLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyLocationLocationIdRequestObject struct {
+	LocationId string `json:"locationId"`
+}
+
+type DeleteV1PlyLocationLocationIdResponseObject interface {
+	VisitDeleteV1PlyLocationLocationIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteV1PlyLocationLocationId200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response DeleteV1PlyLocationLocationId200JSONResponse) VisitDeleteV1PlyLocationLocationIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyLocationLocationId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response DeleteV1PlyLocationLocationId500JSONResponse) VisitDeleteV1PlyLocationLocationIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyLocationLocationIdRequestObject struct {
+	LocationId string `json:"locationId"`
+}
+
+type GetV1PlyLocationLocationIdResponseObject interface {
+	VisitGetV1PlyLocationLocationIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyLocationLocationId200JSONResponse struct {
+	Address    *string `json:"address,omitempty"`
+	LocationId *string `json:"locationId,omitempty"`
+	PracticeId *string `json:"practiceId,omitempty"`
+}
+
+func (response GetV1PlyLocationLocationId200JSONResponse) VisitGetV1PlyLocationLocationIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyLocationLocationId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyLocationLocationId500JSONResponse) VisitGetV1PlyLocationLocationIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyLocationLocationIdRequestObject struct {
+	LocationId string `json:"locationId"`
+	Body       *PostV1PlyLocationLocationIdJSONRequestBody
+}
+
+type PostV1PlyLocationLocationIdResponseObject interface {
+	VisitPostV1PlyLocationLocationIdResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyLocationLocationId200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response PostV1PlyLocationLocationId200JSONResponse) VisitPostV1PlyLocationLocationIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyLocationLocationId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyLocationLocationId500JSONResponse) VisitPostV1PlyLocationLocationIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyLoginRequestObject struct {
+	Body *PostV1PlyLoginJSONRequestBody
+}
+
+type PostV1PlyLoginResponseObject interface {
+	VisitPostV1PlyLoginResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyLogin200JSONResponse struct {
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// MfaRequired Set when the user has multi-factor authentication, in which case there is no token until mfaToken and a code are sent to /v1/ply/login/mfa
+	MfaRequired *bool `json:"mfaRequired,omitempty"`
+
+	// MfaToken A short-lived token to complete the login with
+	MfaToken *string `json:"mfaToken,omitempty"`
+
+	// Token A bearer token for the user
+	Token *string `json:"token,omitempty"`
+	User  *struct {
+		CreatedAt   *time.Time `json:"createdAt,omitempty"`
+		Email       *string    `json:"email,omitempty"`
+		LastLoginAt *time.Time `json:"lastLoginAt,omitempty"`
+		MfaEnabled  *bool      `json:"mfaEnabled,omitempty"`
+		Name        *string    `json:"name,omitempty"`
+
+		// Status One of "invited", "active" or "disabled"
+		Status *string `json:"status,omitempty"`
+		UserId *string `json:"userId,omitempty"`
+	} `json:"user,omitempty"`
+}
+
+func (response PostV1PlyLogin200JSONResponse) VisitPostV1PlyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyLogin401JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyLogin401JSONResponse) VisitPostV1PlyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyLogin500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyLogin500JSONResponse) VisitPostV1PlyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyLogin503JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyLogin503JSONResponse) VisitPostV1PlyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyLoginMfaRequestObject struct {
+	Body *PostV1PlyLoginMfaJSONRequestBody
+}
+
+type PostV1PlyLoginMfaResponseObject interface {
+	VisitPostV1PlyLoginMfaResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyLoginMfa200JSONResponse struct {
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// MfaRequired Set when the user has multi-factor authentication, in which case there is no token until mfaToken and a code are sent to /v1/ply/login/mfa
+	MfaRequired *bool `json:"mfaRequired,omitempty"`
+
+	// MfaToken A short-lived token to complete the login with
+	MfaToken *string `json:"mfaToken,omitempty"`
+
+	// Token A bearer token for the user
+	Token *string `json:"token,omitempty"`
+	User  *struct {
+		CreatedAt   *time.Time `json:"createdAt,omitempty"`
+		Email       *string    `json:"email,omitempty"`
+		LastLoginAt *time.Time `json:"lastLoginAt,omitempty"`
+		MfaEnabled  *bool      `json:"mfaEnabled,omitempty"`
+		Name        *string    `json:"name,omitempty"`
+
+		// Status One of "invited", "active" or "disabled"
+		Status *string `json:"status,omitempty"`
+		UserId *string `json:"userId,omitempty"`
+	} `json:"user,omitempty"`
+}
+
+func (response PostV1PlyLoginMfa200JSONResponse) VisitPostV1PlyLoginMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyLoginMfa401JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyLoginMfa401JSONResponse) VisitPostV1PlyLoginMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyLoginMfa429JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyLoginMfa429JSONResponse) VisitPostV1PlyLoginMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyLoginMfa500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyLoginMfa500JSONResponse) VisitPostV1PlyLoginMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyLoginMfa503JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyLoginMfa503JSONResponse) VisitPostV1PlyLoginMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyMeRequestObject struct {
+}
+
+type GetV1PlyMeResponseObject interface {
+	VisitGetV1PlyMeResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyMe200JSONResponse struct {
+	Email  *string `json:"email,omitempty"`
+	Grants *[]struct {
+		CreatedAt *time.Time `json:"createdAt,omitempty"`
+		GrantId   *string    `json:"grantId,omitempty"`
+
+		// OrganizationId The organization over whose practices the role is granted
+		OrganizationId *string `json:"organizationId,omitempty"`
+
+		// PracticeId The practice the role is granted over
+		PracticeId *string `json:"practiceId,omitempty"`
+
+		// Role One of "practice_viewer", "practice_editor", "coordinator" or "admin"
+		Role *string `json:"role,omitempty"`
+
+		// Subject The subject of a user's token, the name of a service's API key or the id of a user account
+		Subject *string `json:"subject,omitempty"`
+
+		// SubjectKind What subject names, one of "token", "service" or "account". Grants apply only to callers of their kind.
+		SubjectKind *string `json:"subjectKind,omitempty"`
+	} `json:"grants,omitempty"`
+
+	// Kind Either "user" or "service"
+	Kind *string `json:"kind,omitempty"`
+	Name *string `json:"name,omitempty"`
+
+	// Subject The subject role grants are made to
+	Subject *string `json:"subject,omitempty"`
+	User    *struct {
+		CreatedAt   *time.Time `json:"createdAt,omitempty"`
+		Email       *string    `json:"email,omitempty"`
+		LastLoginAt *time.Time `json:"lastLoginAt,omitempty"`
+		MfaEnabled  *bool      `json:"mfaEnabled,omitempty"`
+		Name        *string    `json:"name,omitempty"`
+
+		// Status One of "invited", "active" or "disabled"
+		Status *string `json:"status,omitempty"`
+		UserId *string `json:"userId,omitempty"`
+	} `json:"user,omitempty"`
+}
+
+func (response GetV1PlyMe200JSONResponse) VisitGetV1PlyMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyMe401JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyMe401JSONResponse) VisitGetV1PlyMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyMe500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyMe500JSONResponse) VisitGetV1PlyMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfaRequestObject struct {
+}
+
+type PostV1PlyMeMfaResponseObject interface {
+	VisitPostV1PlyMeMfaResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyMeMfa200JSONResponse struct {
+	// ProvisioningUri An otpauth URI enrolling the secret, usually shown as a QR code
+	ProvisioningUri *string `json:"provisioningUri,omitempty"`
+
+	// Secret The base32 encoded TOTP secret, for typing into an authenticator app
+	Secret *string `json:"secret,omitempty"`
+}
+
+func (response PostV1PlyMeMfa200JSONResponse) VisitPostV1PlyMeMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfa401JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyMeMfa401JSONResponse) VisitPostV1PlyMeMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfa409JSONResponse struct {
+	Code int32 `json:"code"`
+
+	// ExistingId The identifier of the record that conflicts with the request
+	ExistingId *string `json:"existingId,omitempty"`
+	Message    string  `json:"message"`
+}
+
+func (response PostV1PlyMeMfa409JSONResponse) VisitPostV1PlyMeMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfa500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyMeMfa500JSONResponse) VisitPostV1PlyMeMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfaConfirmRequestObject struct {
+	Body *PostV1PlyMeMfaConfirmJSONRequestBody
+}
+
+type PostV1PlyMeMfaConfirmResponseObject interface {
+	VisitPostV1PlyMeMfaConfirmResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyMeMfaConfirm200JSONResponse struct {
+	// RecoveryCodes Single-use codes to log in with when the authenticator app is unavailable. They are only shown once.
+	RecoveryCodes *[]string `json:"recoveryCodes,omitempty"`
+	Session       *struct {
+		ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+		// MfaRequired Set when the user has multi-factor authentication, in which case there is no token until mfaToken and a code are sent to /v1/ply/login/mfa
+		MfaRequired *bool `json:"mfaRequired,omitempty"`
+
+		// MfaToken A short-lived token to complete the login with
+		MfaToken *string `json:"mfaToken,omitempty"`
+
+		// Token A bearer token for the user
+		Token *string `json:"token,omitempty"`
+		User  *struct {
+			CreatedAt   *time.Time `json:"createdAt,omitempty"`
+			Email       *string    `json:"email,omitempty"`
+			LastLoginAt *time.Time `json:"lastLoginAt,omitempty"`
+			MfaEnabled  *bool      `json:"mfaEnabled,omitempty"`
+			Name        *string    `json:"name,omitempty"`
+
+			// Status One of "invited", "active" or "disabled"
+			Status *string `json:"status,omitempty"`
+			UserId *string `json:"userId,omitempty"`
+		} `json:"user,omitempty"`
+	} `json:"session,omitempty"`
+}
+
+func (response PostV1PlyMeMfaConfirm200JSONResponse) VisitPostV1PlyMeMfaConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfaConfirm400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyMeMfaConfirm400JSONResponse) VisitPostV1PlyMeMfaConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfaConfirm401JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyMeMfaConfirm401JSONResponse) VisitPostV1PlyMeMfaConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfaConfirm500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyMeMfaConfirm500JSONResponse) VisitPostV1PlyMeMfaConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfaDisableRequestObject struct {
+	Body *PostV1PlyMeMfaDisableJSONRequestBody
+}
+
+type PostV1PlyMeMfaDisableResponseObject interface {
+	VisitPostV1PlyMeMfaDisableResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyMeMfaDisable200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response PostV1PlyMeMfaDisable200JSONResponse) VisitPostV1PlyMeMfaDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfaDisable400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyMeMfaDisable400JSONResponse) VisitPostV1PlyMeMfaDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfaDisable401JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyMeMfaDisable401JSONResponse) VisitPostV1PlyMeMfaDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfaDisable409JSONResponse struct {
+	Code int32 `json:"code"`
+
+	// ExistingId The identifier of the record that conflicts with the request
+	ExistingId *string `json:"existingId,omitempty"`
+	Message    string  `json:"message"`
+}
+
+func (response PostV1PlyMeMfaDisable409JSONResponse) VisitPostV1PlyMeMfaDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfaDisable429JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyMeMfaDisable429JSONResponse) VisitPostV1PlyMeMfaDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfaDisable500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyMeMfaDisable500JSONResponse) VisitPostV1PlyMeMfaDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfaRecoveryRequestObject struct {
+	Body *PostV1PlyMeMfaRecoveryJSONRequestBody
+}
+
+type PostV1PlyMeMfaRecoveryResponseObject interface {
+	VisitPostV1PlyMeMfaRecoveryResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyMeMfaRecovery200JSONResponse struct {
+	// RecoveryCodes Single-use codes to log in with when the authenticator app is unavailable. They are only shown once.
+	RecoveryCodes *[]string `json:"recoveryCodes,omitempty"`
+	Session       *struct {
+		ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+		// MfaRequired Set when the user has multi-factor authentication, in which case there is no token until mfaToken and a code are sent to /v1/ply/login/mfa
+		MfaRequired *bool `json:"mfaRequired,omitempty"`
+
+		// MfaToken A short-lived token to complete the login with
+		MfaToken *string `json:"mfaToken,omitempty"`
+
+		// Token A bearer token for the user
+		Token *string `json:"token,omitempty"`
+		User  *struct {
+			CreatedAt   *time.Time `json:"createdAt,omitempty"`
+			Email       *string    `json:"email,omitempty"`
+			LastLoginAt *time.Time `json:"lastLoginAt,omitempty"`
+			MfaEnabled  *bool      `json:"mfaEnabled,omitempty"`
+			Name        *string    `json:"name,omitempty"`
+
+			// Status One of "invited", "active" or "disabled"
+			Status *string `json:"status,omitempty"`
+			UserId *string `json:"userId,omitempty"`
+		} `json:"user,omitempty"`
+	} `json:"session,omitempty"`
+}
+
+func (response PostV1PlyMeMfaRecovery200JSONResponse) VisitPostV1PlyMeMfaRecoveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfaRecovery400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyMeMfaRecovery400JSONResponse) VisitPostV1PlyMeMfaRecoveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfaRecovery401JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyMeMfaRecovery401JSONResponse) VisitPostV1PlyMeMfaRecoveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfaRecovery429JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyMeMfaRecovery429JSONResponse) VisitPostV1PlyMeMfaRecoveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMeMfaRecovery500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyMeMfaRecovery500JSONResponse) VisitPostV1PlyMeMfaRecoveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMePasswordRequestObject struct {
+	Body *PostV1PlyMePasswordJSONRequestBody
+}
+
+type PostV1PlyMePasswordResponseObject interface {
+	VisitPostV1PlyMePasswordResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyMePassword200JSONResponse struct {
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// MfaRequired Set when the user has multi-factor authentication, in which case there is no token until mfaToken and a code are sent to /v1/ply/login/mfa
+	MfaRequired *bool `json:"mfaRequired,omitempty"`
+
+	// MfaToken A short-lived token to complete the login with
+	MfaToken *string `json:"mfaToken,omitempty"`
+
+	// Token A bearer token for the user
+	Token *string `json:"token,omitempty"`
+	User  *struct {
+		CreatedAt   *time.Time `json:"createdAt,omitempty"`
+		Email       *string    `json:"email,omitempty"`
+		LastLoginAt *time.Time `json:"lastLoginAt,omitempty"`
+		MfaEnabled  *bool      `json:"mfaEnabled,omitempty"`
+		Name        *string    `json:"name,omitempty"`
+
+		// Status One of "invited", "active" or "disabled"
+		Status *string `json:"status,omitempty"`
+		UserId *string `json:"userId,omitempty"`
+	} `json:"user,omitempty"`
+}
+
+func (response PostV1PlyMePassword200JSONResponse) VisitPostV1PlyMePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMePassword400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyMePassword400JSONResponse) VisitPostV1PlyMePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMePassword401JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyMePassword401JSONResponse) VisitPostV1PlyMePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyMePassword500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyMePassword500JSONResponse) VisitPostV1PlyMePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyOrganizationRequestObject struct {
+	Body *PostV1PlyOrganizationJSONRequestBody
+}
+
+type PostV1PlyOrganizationResponseObject interface {
+	VisitPostV1PlyOrganizationResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyOrganization200JSONResponse struct {
+	OrganizationId *string `json:"organizationId,omitempty"`
+}
+
+func (response PostV1PlyOrganization200JSONResponse) VisitPostV1PlyOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyOrganization500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyOrganization500JSONResponse) VisitPostV1PlyOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyOrganizationListRequestObject struct {
+}
+
+type GetV1PlyOrganizationListResponseObject interface {
+	VisitGetV1PlyOrganizationListResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyOrganizationList200JSONResponse struct {
+	Organizations *[]struct {
+		Name           *string `json:"name,omitempty"`
+		OrganizationId *string `json:"organizationId,omitempty"`
+	} `json:"organizations,omitempty"`
+}
+
+func (response GetV1PlyOrganizationList200JSONResponse) VisitGetV1PlyOrganizationListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyOrganizationList500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyOrganizationList500JSONResponse) VisitGetV1PlyOrganizationListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyOrganizationOrganizationIdRequestObject struct {
+	OrganizationId string `json:"organizationId"`
+}
+
+type GetV1PlyOrganizationOrganizationIdResponseObject interface {
+	VisitGetV1PlyOrganizationOrganizationIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyOrganizationOrganizationId200JSONResponse struct {
+	Name           *string `json:"name,omitempty"`
+	OrganizationId *string `json:"organizationId,omitempty"`
+}
+
+func (response GetV1PlyOrganizationOrganizationId200JSONResponse) VisitGetV1PlyOrganizationOrganizationIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyOrganizationOrganizationId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyOrganizationOrganizationId500JSONResponse) VisitGetV1PlyOrganizationOrganizationIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyOrganizationOrganizationIdRequestObject struct {
+	OrganizationId string `json:"organizationId"`
+	Body           *PostV1PlyOrganizationOrganizationIdJSONRequestBody
+}
+
+type PostV1PlyOrganizationOrganizationIdResponseObject interface {
+	VisitPostV1PlyOrganizationOrganizationIdResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyOrganizationOrganizationId200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response PostV1PlyOrganizationOrganizationId200JSONResponse) VisitPostV1PlyOrganizationOrganizationIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyOrganizationOrganizationId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyOrganizationOrganizationId500JSONResponse) VisitPostV1PlyOrganizationOrganizationIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyOrganizationOrganizationIdEnrollmentRequestObject struct {
+	OrganizationId string `json:"organizationId"`
+}
+
+type GetV1PlyOrganizationOrganizationIdEnrollmentResponseObject interface {
+	VisitGetV1PlyOrganizationOrganizationIdEnrollmentResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyOrganizationOrganizationIdEnrollment200JSONResponse struct {
+	Enrollments *[]struct {
+		ApprovalDate  *openapi_types.Date `json:"approvalDate,omitempty"`
+		EffectiveDate *openapi_types.Date `json:"effectiveDate,omitempty"`
+		EnrollmentId  *string             `json:"enrollmentId,omitempty"`
+
+		// GroupEnrollmentId For an individual enrollment, the group enrollment its benefits are reassigned to
+		GroupEnrollmentId *string `json:"groupEnrollmentId,omitempty"`
+
+		// Kind Either "group" or "individual"; an enrollment without one is individual. A group enrollment cannot change kind while individual enrollments reference it.
+		Kind                *string             `json:"kind,omitempty"`
+		LocationId          *string             `json:"locationId,omitempty"`
+		Payer               *string             `json:"payer,omitempty"`
+		PayerProviderNumber *string             `json:"payerProviderNumber,omitempty"`
+		PracticeId          *string             `json:"practiceId,omitempty"`
+		ProviderId          *string             `json:"providerId,omitempty"`
+		Ptan                *string             `json:"ptan,omitempty"`
+		RevalidationDueDate *openapi_types.Date `json:"revalidationDueDate,omitempty"`
+		State               *string             `json:"state,omitempty"`
+		Status              *string             `json:"status,omitempty"`
+		SubmittedDate       *openapi_types.Date `json:"submittedDate,omitempty"`
+		Type                *string             `json:"type,omitempty"`
+	} `json:"enrollments,omitempty"`
+}
+
+func (response GetV1PlyOrganizationOrganizationIdEnrollment200JSONResponse) VisitGetV1PlyOrganizationOrganizationIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyOrganizationOrganizationIdEnrollment500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyOrganizationOrganizationIdEnrollment500JSONResponse) VisitGetV1PlyOrganizationOrganizationIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyOrganizationOrganizationIdPracticeRequestObject struct {
+	OrganizationId string `json:"organizationId"`
+}
+
+type GetV1PlyOrganizationOrganizationIdPracticeResponseObject interface {
+	VisitGetV1PlyOrganizationOrganizationIdPracticeResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyOrganizationOrganizationIdPractice200JSONResponse struct {
+	Practices *[]struct {
+		Ein            *string `json:"ein,omitempty"`
+		Name           *string `json:"name,omitempty"`
+		OrganizationId *string `json:"organizationId,omitempty"`
+		OwnerName      *string `json:"owner_name,omitempty"`
+		PracticeId     *string `json:"practiceId,omitempty"`
+	} `json:"practices,omitempty"`
+}
+
+func (response GetV1PlyOrganizationOrganizationIdPractice200JSONResponse) VisitGetV1PlyOrganizationOrganizationIdPracticeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyOrganizationOrganizationIdPractice500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyOrganizationOrganizationIdPractice500JSONResponse) VisitGetV1PlyOrganizationOrganizationIdPracticeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyOrganizationOrganizationIdProviderRequestObject struct {
+	OrganizationId string `json:"organizationId"`
+}
+
+type GetV1PlyOrganizationOrganizationIdProviderResponseObject interface {
+	VisitGetV1PlyOrganizationOrganizationIdProviderResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyOrganizationOrganizationIdProvider200JSONResponse struct {
+	Providers *[]struct {
+		Name       *string `json:"name,omitempty"`
+		PracticeId *string `json:"practiceId,omitempty"`
+		ProviderId *string `json:"providerId,omitempty"`
+		Ssn        *string `json:"ssn,omitempty"`
+	} `json:"providers,omitempty"`
+}
+
+func (response GetV1PlyOrganizationOrganizationIdProvider200JSONResponse) VisitGetV1PlyOrganizationOrganizationIdProviderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyOrganizationOrganizationIdProvider500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyOrganizationOrganizationIdProvider500JSONResponse) VisitGetV1PlyOrganizationOrganizationIdProviderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyOrganizationOrganizationIdTaskRequestObject struct {
+	OrganizationId string `json:"organizationId"`
+}
+
+type GetV1PlyOrganizationOrganizationIdTaskResponseObject interface {
+	VisitGetV1PlyOrganizationOrganizationIdTaskResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyOrganizationOrganizationIdTask200JSONResponse struct {
+	Tasks *[]struct {
+		Message    *string `json:"message,omitempty"`
+		PracticeId *string `json:"practiceId,omitempty"`
+		Status     *string `json:"status,omitempty"`
+		TaskId     *string `json:"taskId,omitempty"`
+	} `json:"tasks,omitempty"`
+}
+
+func (response GetV1PlyOrganizationOrganizationIdTask200JSONResponse) VisitGetV1PlyOrganizationOrganizationIdTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyOrganizationOrganizationIdTask500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyOrganizationOrganizationIdTask500JSONResponse) VisitGetV1PlyOrganizationOrganizationIdTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPasswordResetRequestObject struct {
+	Body *PostV1PlyPasswordResetJSONRequestBody
+}
+
+type PostV1PlyPasswordResetResponseObject interface {
+	VisitPostV1PlyPasswordResetResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyPasswordReset200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response PostV1PlyPasswordReset200JSONResponse) VisitPostV1PlyPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPasswordReset500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPasswordReset500JSONResponse) VisitPostV1PlyPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPasswordReset503JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPasswordReset503JSONResponse) VisitPostV1PlyPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPasswordResetConfirmRequestObject struct {
+	Body *PostV1PlyPasswordResetConfirmJSONRequestBody
+}
+
+type PostV1PlyPasswordResetConfirmResponseObject interface {
+	VisitPostV1PlyPasswordResetConfirmResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyPasswordResetConfirm200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response PostV1PlyPasswordResetConfirm200JSONResponse) VisitPostV1PlyPasswordResetConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPasswordResetConfirm400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPasswordResetConfirm400JSONResponse) VisitPostV1PlyPasswordResetConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPasswordResetConfirm500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPasswordResetConfirm500JSONResponse) VisitPostV1PlyPasswordResetConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPasswordResetConfirm503JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPasswordResetConfirm503JSONResponse) VisitPostV1PlyPasswordResetConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPracticeRequestObject struct {
+	Body *PostV1PlyPracticeJSONRequestBody
+}
+
+type PostV1PlyPracticeResponseObject interface {
+	VisitPostV1PlyPracticeResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyPractice200JSONResponse struct {
+	PracticeId *string `json:"practiceId,omitempty"`
+}
+
+func (response PostV1PlyPractice200JSONResponse) VisitPostV1PlyPracticeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPractice400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPractice400JSONResponse) VisitPostV1PlyPracticeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPractice500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPractice500JSONResponse) VisitPostV1PlyPracticeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticeListRequestObject struct {
+}
+
+type GetV1PlyPracticeListResponseObject interface {
+	VisitGetV1PlyPracticeListResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyPracticeList200JSONResponse struct {
+	Practices *[]struct {
+		Ein            *string `json:"ein,omitempty"`
+		Name           *string `json:"name,omitempty"`
+		OrganizationId *string `json:"organizationId,omitempty"`
+		OwnerName      *string `json:"owner_name,omitempty"`
+		PracticeId     *string `json:"practiceId,omitempty"`
+	} `json:"practices,omitempty"`
+}
+
+func (response GetV1PlyPracticeList200JSONResponse) VisitGetV1PlyPracticeListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticeList500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyPracticeList500JSONResponse) VisitGetV1PlyPracticeListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdRequestObject struct {
+	PracticeId string `json:"practiceId"`
+}
+
+type GetV1PlyPracticePracticeIdResponseObject interface {
+	VisitGetV1PlyPracticePracticeIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyPracticePracticeId200JSONResponse struct {
+	Ein            *string `json:"ein,omitempty"`
+	Name           *string `json:"name,omitempty"`
+	OrganizationId *string `json:"organizationId,omitempty"`
+	OwnerName      *string `json:"owner_name,omitempty"`
+	PracticeId     *string `json:"practiceId,omitempty"`
+}
+
+func (response GetV1PlyPracticePracticeId200JSONResponse) VisitGetV1PlyPracticePracticeIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyPracticePracticeId500JSONResponse) VisitGetV1PlyPracticePracticeIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPracticePracticeIdRequestObject struct {
+	PracticeId string `json:"practiceId"`
+	Body       *PostV1PlyPracticePracticeIdJSONRequestBody
+}
+
+type PostV1PlyPracticePracticeIdResponseObject interface {
+	VisitPostV1PlyPracticePracticeIdResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyPracticePracticeId200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response PostV1PlyPracticePracticeId200JSONResponse) VisitPostV1PlyPracticePracticeIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPracticePracticeId400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPracticePracticeId400JSONResponse) VisitPostV1PlyPracticePracticeIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPracticePracticeId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPracticePracticeId500JSONResponse) VisitPostV1PlyPracticePracticeIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdDocumentRequestObject struct {
+	PracticeId string `json:"practiceId"`
+	Params     GetV1PlyPracticePracticeIdDocumentParams
+}
+
+type GetV1PlyPracticePracticeIdDocumentResponseObject interface {
+	VisitGetV1PlyPracticePracticeIdDocumentResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyPracticePracticeIdDocument200JSONResponse struct {
+	Documents *[]struct {
+		// ContentType The media type detected from the file content
+		ContentType *string `json:"content_type,omitempty"`
+
+		// CurrentVersion The version whose file the document currently serves
+		CurrentVersion *int    `json:"currentVersion,omitempty"`
+		DocumentId     *string `json:"documentId,omitempty"`
+
+		// DocumentType One of "w9", "license", "coi", "cv", "board_certification", "payer_letter", "dea" or "other"
+		DocumentType    *string             `json:"documentType,omitempty"`
+		EnrollmentId    *string             `json:"enrollmentId,omitempty"`
+		ExpirationDate  *openapi_types.Date `json:"expirationDate,omitempty"`
+		ExtractedFields *[]struct {
+			Applied    *bool    `json:"applied,omitempty"`
+			Confidence *float64 `json:"confidence,omitempty"`
+
+			// Key The label the extractor found, as printed on the document
+			Key  *string `json:"key,omitempty"`
+			Page *int    `json:"page,omitempty"`
+
+			// Target The record field the value is suggested for, one of "provider.name", "provider.ssn", "practice.name", "practice.ein" or "practice.owner_name"; empty when there is no suggestion
+			Target *string `json:"target,omitempty"`
+			Value  *string `json:"value,omitempty"`
+		} `json:"extractedFields,omitempty"`
+
+		// ExtractionStatus One of "pending", "running", "completed" or "failed"; empty when extraction is disabled
+		ExtractionStatus *string `json:"extractionStatus,omitempty"`
+		FileName         *string `json:"file_name,omitempty"`
+		LocationId       *string `json:"locationId,omitempty"`
+		PracticeId       *string `json:"practiceId,omitempty"`
+
+		// PreviewAvailable Whether a preview of the current version can be downloaded
+		PreviewAvailable *bool   `json:"previewAvailable,omitempty"`
+		ProviderId       *string `json:"providerId,omitempty"`
+
+		// ScanSignature The malware found in an infected file
+		ScanSignature *string `json:"scanSignature,omitempty"`
+
+		// ScanStatus One of "clean", "infected", "error" or "too_large", for files larger than the scanner accepts, which are not scanned again; empty when not scanned. Only clean files can be downloaded while scanning is enabled.
+		ScanStatus *string `json:"scanStatus,omitempty"`
+
+		// Sha256 Hex-encoded SHA-256 of the file content
+		Sha256      *string `json:"sha256,omitempty"`
+		Size        *int64  `json:"size,omitempty"`
+		StoragePath *string `json:"storage_path,omitempty"`
+	} `json:"documents,omitempty"`
+}
+
+func (response GetV1PlyPracticePracticeIdDocument200JSONResponse) VisitGetV1PlyPracticePracticeIdDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdDocument500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyPracticePracticeIdDocument500JSONResponse) VisitGetV1PlyPracticePracticeIdDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdDocumentArchiveRequestObject struct {
+	PracticeId string `json:"practiceId"`
+	Params     GetV1PlyPracticePracticeIdDocumentArchiveParams
+}
+
+type GetV1PlyPracticePracticeIdDocumentArchiveResponseObject interface {
+	VisitGetV1PlyPracticePracticeIdDocumentArchiveResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyPracticePracticeIdDocumentArchive200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type GetV1PlyPracticePracticeIdDocumentArchive200ApplicationzipResponse struct {
+	Body          io.Reader
+	Headers       GetV1PlyPracticePracticeIdDocumentArchive200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetV1PlyPracticePracticeIdDocumentArchive200ApplicationzipResponse) VisitGetV1PlyPracticePracticeIdDocumentArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/zip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1PlyPracticePracticeIdDocumentArchive500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyPracticePracticeIdDocumentArchive500JSONResponse) VisitGetV1PlyPracticePracticeIdDocumentArchiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdDocumentSearchRequestObject struct {
+	PracticeId string `json:"practiceId"`
+	Params     GetV1PlyPracticePracticeIdDocumentSearchParams
+}
+
+type GetV1PlyPracticePracticeIdDocumentSearchResponseObject interface {
+	VisitGetV1PlyPracticePracticeIdDocumentSearchResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyPracticePracticeIdDocumentSearch200JSONResponse struct {
+	Results *[]struct {
+		Document *struct {
+			// ContentType The media type detected from the file content
+			ContentType *string `json:"content_type,omitempty"`
+
+			// CurrentVersion The version whose file the document currently serves
+			CurrentVersion *int    `json:"currentVersion,omitempty"`
+			DocumentId     *string `json:"documentId,omitempty"`
+
+			// DocumentType One of "w9", "license", "coi", "cv", "board_certification", "payer_letter", "dea" or "other"
+			DocumentType    *string             `json:"documentType,omitempty"`
+			EnrollmentId    *string             `json:"enrollmentId,omitempty"`
+			ExpirationDate  *openapi_types.Date `json:"expirationDate,omitempty"`
+			ExtractedFields *[]struct {
+				Applied    *bool    `json:"applied,omitempty"`
+				Confidence *float64 `json:"confidence,omitempty"`
+
+				// Key The label the extractor found, as printed on the document
+				Key  *string `json:"key,omitempty"`
+				Page *int    `json:"page,omitempty"`
+
+				// Target The record field the value is suggested for, one of "provider.name", "provider.ssn", "practice.name", "practice.ein" or "practice.owner_name"; empty when there is no suggestion
+				Target *string `json:"target,omitempty"`
+				Value  *string `json:"value,omitempty"`
+			} `json:"extractedFields,omitempty"`
+
+			// ExtractionStatus One of "pending", "running", "completed" or "failed"; empty when extraction is disabled
+			ExtractionStatus *string `json:"extractionStatus,omitempty"`
+			FileName         *string `json:"file_name,omitempty"`
+			LocationId       *string `json:"locationId,omitempty"`
+			PracticeId       *string `json:"practiceId,omitempty"`
+
+			// PreviewAvailable Whether a preview of the current version can be downloaded
+			PreviewAvailable *bool   `json:"previewAvailable,omitempty"`
+			ProviderId       *string `json:"providerId,omitempty"`
+
+			// ScanSignature The malware found in an infected file
+			ScanSignature *string `json:"scanSignature,omitempty"`
+
+			// ScanStatus One of "clean", "infected", "error" or "too_large", for files larger than the scanner accepts, which are not scanned again; empty when not scanned. Only clean files can be downloaded while scanning is enabled.
+			ScanStatus *string `json:"scanStatus,omitempty"`
+
+			// Sha256 Hex-encoded SHA-256 of the file content
+			Sha256      *string `json:"sha256,omitempty"`
+			Size        *int64  `json:"size,omitempty"`
+			StoragePath *string `json:"storage_path,omitempty"`
+		} `json:"document,omitempty"`
+
+		// Matches How many times the search terms occur in the document's text
+		Matches *int `json:"matches,omitempty"`
+
+		// Snippet HTML escaped excerpt of the document's text with each search term wrapped in <mark> tags
+		Snippet *string `json:"snippet,omitempty"`
+	} `json:"results,omitempty"`
+}
+
+func (response GetV1PlyPracticePracticeIdDocumentSearch200JSONResponse) VisitGetV1PlyPracticePracticeIdDocumentSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdDocumentSearch400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyPracticePracticeIdDocumentSearch400JSONResponse) VisitGetV1PlyPracticePracticeIdDocumentSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdDocumentSearch500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyPracticePracticeIdDocumentSearch500JSONResponse) VisitGetV1PlyPracticePracticeIdDocumentSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdEnrollmentRequestObject struct {
+	PracticeId string `json:"practiceId"`
+}
+
+type GetV1PlyPracticePracticeIdEnrollmentResponseObject interface {
+	VisitGetV1PlyPracticePracticeIdEnrollmentResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyPracticePracticeIdEnrollment200JSONResponse struct {
+	Enrollments *[]struct {
+		ApprovalDate  *openapi_types.Date `json:"approvalDate,omitempty"`
+		EffectiveDate *openapi_types.Date `json:"effectiveDate,omitempty"`
+		EnrollmentId  *string             `json:"enrollmentId,omitempty"`
+
+		// GroupEnrollmentId For an individual enrollment, the group enrollment its benefits are reassigned to
+		GroupEnrollmentId *string `json:"groupEnrollmentId,omitempty"`
+
+		// Kind Either "group" or "individual"; an enrollment without one is individual. A group enrollment cannot change kind while individual enrollments reference it.
+		Kind                *string             `json:"kind,omitempty"`
+		LocationId          *string             `json:"locationId,omitempty"`
+		Payer               *string             `json:"payer,omitempty"`
+		PayerProviderNumber *string             `json:"payerProviderNumber,omitempty"`
+		PracticeId          *string             `json:"practiceId,omitempty"`
+		ProviderId          *string             `json:"providerId,omitempty"`
+		Ptan                *string             `json:"ptan,omitempty"`
+		RevalidationDueDate *openapi_types.Date `json:"revalidationDueDate,omitempty"`
+		State               *string             `json:"state,omitempty"`
+		Status              *string             `json:"status,omitempty"`
+		SubmittedDate       *openapi_types.Date `json:"submittedDate,omitempty"`
+		Type                *string             `json:"type,omitempty"`
+	} `json:"enrollments,omitempty"`
+}
+
+func (response GetV1PlyPracticePracticeIdEnrollment200JSONResponse) VisitGetV1PlyPracticePracticeIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdEnrollment500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyPracticePracticeIdEnrollment500JSONResponse) VisitGetV1PlyPracticePracticeIdEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdEnrollmentDuplicatesRequestObject struct {
+	PracticeId string `json:"practiceId"`
+}
+
+type GetV1PlyPracticePracticeIdEnrollmentDuplicatesResponseObject interface {
+	VisitGetV1PlyPracticePracticeIdEnrollmentDuplicatesResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyPracticePracticeIdEnrollmentDuplicates200JSONResponse struct {
+	Duplicates *[]struct {
+		Enrollments *[]struct {
+			ApprovalDate  *openapi_types.Date `json:"approvalDate,omitempty"`
+			EffectiveDate *openapi_types.Date `json:"effectiveDate,omitempty"`
+			EnrollmentId  *string             `json:"enrollmentId,omitempty"`
+
+			// GroupEnrollmentId For an individual enrollment, the group enrollment its benefits are reassigned to
+			GroupEnrollmentId *string `json:"groupEnrollmentId,omitempty"`
+
+			// Kind Either "group" or "individual"; an enrollment without one is individual. A group enrollment cannot change kind while individual enrollments reference it.
+			Kind                *string             `json:"kind,omitempty"`
+			LocationId          *string             `json:"locationId,omitempty"`
+			Payer               *string             `json:"payer,omitempty"`
+			PayerProviderNumber *string             `json:"payerProviderNumber,omitempty"`
+			PracticeId          *string             `json:"practiceId,omitempty"`
+			ProviderId          *string             `json:"providerId,omitempty"`
+			Ptan                *string             `json:"ptan,omitempty"`
+			RevalidationDueDate *openapi_types.Date `json:"revalidationDueDate,omitempty"`
+			State               *string             `json:"state,omitempty"`
+			Status              *string             `json:"status,omitempty"`
+			SubmittedDate       *openapi_types.Date `json:"submittedDate,omitempty"`
+			Type                *string             `json:"type,omitempty"`
+		} `json:"enrollments,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"duplicates,omitempty"`
+}
+
+func (response GetV1PlyPracticePracticeIdEnrollmentDuplicates200JSONResponse) VisitGetV1PlyPracticePracticeIdEnrollmentDuplicatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdEnrollmentDuplicates500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyPracticePracticeIdEnrollmentDuplicates500JSONResponse) VisitGetV1PlyPracticePracticeIdEnrollmentDuplicatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdLocationRequestObject struct {
+	PracticeId string `json:"practiceId"`
+}
+
+type GetV1PlyPracticePracticeIdLocationResponseObject interface {
+	VisitGetV1PlyPracticePracticeIdLocationResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyPracticePracticeIdLocation200JSONResponse struct {
+	Locations *[]struct {
+		Address    *string `json:"address,omitempty"`
+		LocationId *string `json:"locationId,omitempty"`
+		PracticeId *string `json:"practiceId,omitempty"`
+	} `json:"locations,omitempty"`
+}
+
+func (response GetV1PlyPracticePracticeIdLocation200JSONResponse) VisitGetV1PlyPracticePracticeIdLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdLocation500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyPracticePracticeIdLocation500JSONResponse) VisitGetV1PlyPracticePracticeIdLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdProviderRequestObject struct {
+	PracticeId string `json:"practiceId"`
+}
+
+type GetV1PlyPracticePracticeIdProviderResponseObject interface {
+	VisitGetV1PlyPracticePracticeIdProviderResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyPracticePracticeIdProvider200JSONResponse struct {
+	Providers *[]struct {
+		Name       *string `json:"name,omitempty"`
+		PracticeId *string `json:"practiceId,omitempty"`
+		ProviderId *string `json:"providerId,omitempty"`
+		Ssn        *string `json:"ssn,omitempty"`
+	} `json:"providers,omitempty"`
+}
+
+func (response GetV1PlyPracticePracticeIdProvider200JSONResponse) VisitGetV1PlyPracticePracticeIdProviderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdProvider500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyPracticePracticeIdProvider500JSONResponse) VisitGetV1PlyPracticePracticeIdProviderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdTaskRequestObject struct {
+	PracticeId string `json:"practiceId"`
+}
+
+type GetV1PlyPracticePracticeIdTaskResponseObject interface {
+	VisitGetV1PlyPracticePracticeIdTaskResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyPracticePracticeIdTask200JSONResponse struct {
+	Tasks *[]struct {
+		Message    *string `json:"message,omitempty"`
+		PracticeId *string `json:"practiceId,omitempty"`
+		Status     *string `json:"status,omitempty"`
+		TaskId     *string `json:"taskId,omitempty"`
+	} `json:"tasks,omitempty"`
+}
+
+func (response GetV1PlyPracticePracticeIdTask200JSONResponse) VisitGetV1PlyPracticePracticeIdTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdTask500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyPracticePracticeIdTask500JSONResponse) VisitGetV1PlyPracticePracticeIdTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPracticePracticeIdUploadRequestObject struct {
+	PracticeId string `json:"practiceId"`
+	Body       *multipart.Reader
+}
+
+type PostV1PlyPracticePracticeIdUploadResponseObject interface {
+	VisitPostV1PlyPracticePracticeIdUploadResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyPracticePracticeIdUpload200JSONResponse struct {
+	DocumentId *string `json:"documentId,omitempty"`
+}
+
+func (response PostV1PlyPracticePracticeIdUpload200JSONResponse) VisitPostV1PlyPracticePracticeIdUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPracticePracticeIdUpload400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPracticePracticeIdUpload400JSONResponse) VisitPostV1PlyPracticePracticeIdUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPracticePracticeIdUpload409JSONResponse struct {
+	Code int32 `json:"code"`
+
+	// ExistingId The identifier of the record that conflicts with the request
+	ExistingId *string `json:"existingId,omitempty"`
+	Message    string  `json:"message"`
+}
+
+func (response PostV1PlyPracticePracticeIdUpload409JSONResponse) VisitPostV1PlyPracticePracticeIdUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPracticePracticeIdUpload413JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPracticePracticeIdUpload413JSONResponse) VisitPostV1PlyPracticePracticeIdUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPracticePracticeIdUpload415JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPracticePracticeIdUpload415JSONResponse) VisitPostV1PlyPracticePracticeIdUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(415)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPracticePracticeIdUpload500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPracticePracticeIdUpload500JSONResponse) VisitPostV1PlyPracticePracticeIdUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPracticePracticeIdUpload507JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPracticePracticeIdUpload507JSONResponse) VisitPostV1PlyPracticePracticeIdUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(507)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPracticePracticeIdUploadResumableRequestObject struct {
+	PracticeId string `json:"practiceId"`
+	Body       *PostV1PlyPracticePracticeIdUploadResumableJSONRequestBody
+}
+
+type PostV1PlyPracticePracticeIdUploadResumableResponseObject interface {
+	VisitPostV1PlyPracticePracticeIdUploadResumableResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyPracticePracticeIdUploadResumable200JSONResponse struct {
+	// DocumentType One of "w9", "license", "coi", "cv", "board_certification", "payer_letter", "dea" or "other"
+	DocumentType   *string             `json:"documentType,omitempty"`
+	EnrollmentId   *string             `json:"enrollmentId,omitempty"`
+	ExpirationDate *openapi_types.Date `json:"expirationDate,omitempty"`
+
+	// ExpiresAt When the upload is discarded unless more data arrives
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	FileName   *string    `json:"fileName,omitempty"`
+	LocationId *string    `json:"locationId,omitempty"`
+
+	// Offset How many bytes have been received; the next chunk must start here
+	Offset     *int64  `json:"offset,omitempty"`
+	PracticeId *string `json:"practiceId,omitempty"`
+	ProviderId *string `json:"providerId,omitempty"`
+
+	// Size The total size of the file in bytes
+	Size     *int64  `json:"size,omitempty"`
+	UploadId *string `json:"uploadId,omitempty"`
+}
+
+func (response PostV1PlyPracticePracticeIdUploadResumable200JSONResponse) VisitPostV1PlyPracticePracticeIdUploadResumableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPracticePracticeIdUploadResumable400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPracticePracticeIdUploadResumable400JSONResponse) VisitPostV1PlyPracticePracticeIdUploadResumableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPracticePracticeIdUploadResumable413JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPracticePracticeIdUploadResumable413JSONResponse) VisitPostV1PlyPracticePracticeIdUploadResumableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPracticePracticeIdUploadResumable500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPracticePracticeIdUploadResumable500JSONResponse) VisitPostV1PlyPracticePracticeIdUploadResumableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyPracticePracticeIdUploadResumable507JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyPracticePracticeIdUploadResumable507JSONResponse) VisitPostV1PlyPracticePracticeIdUploadResumableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(507)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdUsageRequestObject struct {
+	PracticeId string `json:"practiceId"`
+}
+
+type GetV1PlyPracticePracticeIdUsageResponseObject interface {
+	VisitGetV1PlyPracticePracticeIdUsageResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyPracticePracticeIdUsage200JSONResponse struct {
+	Bytes      *int64  `json:"bytes,omitempty"`
+	Documents  *int    `json:"documents,omitempty"`
+	PracticeId *string `json:"practiceId,omitempty"`
+
+	// QuotaBytes Omitted when the practice has no quota
+	QuotaBytes *int64 `json:"quotaBytes,omitempty"`
+
+	// Types Usage by document type; documents without a type are counted as "unclassified"
+	Types *map[string]struct {
+		Bytes     *int64 `json:"bytes,omitempty"`
+		Documents *int   `json:"documents,omitempty"`
+	} `json:"types,omitempty"`
+}
+
+func (response GetV1PlyPracticePracticeIdUsage200JSONResponse) VisitGetV1PlyPracticePracticeIdUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyPracticePracticeIdUsage500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyPracticePracticeIdUsage500JSONResponse) VisitGetV1PlyPracticePracticeIdUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyProviderRequestObject struct {
+	Body *PostV1PlyProviderJSONRequestBody
+}
+
+type PostV1PlyProviderResponseObject interface {
+	VisitPostV1PlyProviderResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyProvider200JSONResponse struct {
+	ProviderId *string `json:"providerId,omitempty"`
+}
+
+func (response PostV1PlyProvider200JSONResponse) VisitPostV1PlyProviderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyProvider400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyProvider400JSONResponse) VisitPostV1PlyProviderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyProvider409JSONResponse struct {
+	Code int32 `json:"code"`
+
+	// ExistingId The identifier of the record that conflicts with the request
+	ExistingId *string `json:"existingId,omitempty"`
+	Message    string  `json:"message"`
+}
+
+func (response PostV1PlyProvider409JSONResponse) VisitPostV1PlyProviderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyProvider500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyProvider500JSONResponse) VisitPostV1PlyProviderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyProviderProviderIdRequestObject struct {
+	ProviderId string `json:"providerId"`
+}
+
+type DeleteV1PlyProviderProviderIdResponseObject interface {
+	VisitDeleteV1PlyProviderProviderIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteV1PlyProviderProviderId200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response DeleteV1PlyProviderProviderId200JSONResponse) VisitDeleteV1PlyProviderProviderIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyProviderProviderId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response DeleteV1PlyProviderProviderId500JSONResponse) VisitDeleteV1PlyProviderProviderIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyProviderProviderIdRequestObject struct {
+	ProviderId string `json:"providerId"`
+}
+
+type GetV1PlyProviderProviderIdResponseObject interface {
+	VisitGetV1PlyProviderProviderIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyProviderProviderId200JSONResponse struct {
+	Name       *string `json:"name,omitempty"`
+	PracticeId *string `json:"practiceId,omitempty"`
+	ProviderId *string `json:"providerId,omitempty"`
+	Ssn        *string `json:"ssn,omitempty"`
+}
+
+func (response GetV1PlyProviderProviderId200JSONResponse) VisitGetV1PlyProviderProviderIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyProviderProviderId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyProviderProviderId500JSONResponse) VisitGetV1PlyProviderProviderIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyProviderProviderIdRequestObject struct {
+	ProviderId string `json:"providerId"`
+	Body       *PostV1PlyProviderProviderIdJSONRequestBody
+}
+
+type PostV1PlyProviderProviderIdResponseObject interface {
+	VisitPostV1PlyProviderProviderIdResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyProviderProviderId200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response PostV1PlyProviderProviderId200JSONResponse) VisitPostV1PlyProviderProviderIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyProviderProviderId400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyProviderProviderId400JSONResponse) VisitPostV1PlyProviderProviderIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyProviderProviderId409JSONResponse struct {
+	Code int32 `json:"code"`
+
+	// ExistingId The identifier of the record that conflicts with the request
+	ExistingId *string `json:"existingId,omitempty"`
+	Message    string  `json:"message"`
+}
+
+func (response PostV1PlyProviderProviderId409JSONResponse) VisitPostV1PlyProviderProviderIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyProviderProviderId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyProviderProviderId500JSONResponse) VisitPostV1PlyProviderProviderIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyProviderProviderIdAffiliationRequestObject struct {
+	ProviderId string `json:"providerId"`
+}
+
+type GetV1PlyProviderProviderIdAffiliationResponseObject interface {
+	VisitGetV1PlyProviderProviderIdAffiliationResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyProviderProviderIdAffiliation200JSONResponse struct {
+	Affiliations *[]struct {
+		AffiliationId *string             `json:"affiliationId,omitempty"`
+		EndDate       *openapi_types.Date `json:"endDate,omitempty"`
+		PracticeId    *string             `json:"practiceId,omitempty"`
+		ProviderId    *string             `json:"providerId,omitempty"`
+		Role          *string             `json:"role,omitempty"`
+		StartDate     *openapi_types.Date `json:"startDate,omitempty"`
+	} `json:"affiliations,omitempty"`
+}
+
+func (response GetV1PlyProviderProviderIdAffiliation200JSONResponse) VisitGetV1PlyProviderProviderIdAffiliationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyProviderProviderIdAffiliation500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyProviderProviderIdAffiliation500JSONResponse) VisitGetV1PlyProviderProviderIdAffiliationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyProviderProviderIdAffiliationRequestObject struct {
+	ProviderId string `json:"providerId"`
+	Body       *PostV1PlyProviderProviderIdAffiliationJSONRequestBody
+}
+
+type PostV1PlyProviderProviderIdAffiliationResponseObject interface {
+	VisitPostV1PlyProviderProviderIdAffiliationResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyProviderProviderIdAffiliation200JSONResponse struct {
+	AffiliationId *string `json:"affiliationId,omitempty"`
+}
+
+func (response PostV1PlyProviderProviderIdAffiliation200JSONResponse) VisitPostV1PlyProviderProviderIdAffiliationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyProviderProviderIdAffiliation500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyProviderProviderIdAffiliation500JSONResponse) VisitPostV1PlyProviderProviderIdAffiliationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyReportRevalidationRequestObject struct {
+	Params GetV1PlyReportRevalidationParams
+}
+
+type GetV1PlyReportRevalidationResponseObject interface {
+	VisitGetV1PlyReportRevalidationResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyReportRevalidation200JSONResponse struct {
+	Enrollments *[]struct {
+		ApprovalDate  *openapi_types.Date `json:"approvalDate,omitempty"`
+		EffectiveDate *openapi_types.Date `json:"effectiveDate,omitempty"`
+		EnrollmentId  *string             `json:"enrollmentId,omitempty"`
+
+		// GroupEnrollmentId For an individual enrollment, the group enrollment its benefits are reassigned to
+		GroupEnrollmentId *string `json:"groupEnrollmentId,omitempty"`
+
+		// Kind Either "group" or "individual"; an enrollment without one is individual. A group enrollment cannot change kind while individual enrollments reference it.
+		Kind                *string             `json:"kind,omitempty"`
+		LocationId          *string             `json:"locationId,omitempty"`
+		Payer               *string             `json:"payer,omitempty"`
+		PayerProviderNumber *string             `json:"payerProviderNumber,omitempty"`
+		PracticeId          *string             `json:"practiceId,omitempty"`
+		ProviderId          *string             `json:"providerId,omitempty"`
+		Ptan                *string             `json:"ptan,omitempty"`
+		RevalidationDueDate *openapi_types.Date `json:"revalidationDueDate,omitempty"`
+		State               *string             `json:"state,omitempty"`
+		Status              *string             `json:"status,omitempty"`
+		SubmittedDate       *openapi_types.Date `json:"submittedDate,omitempty"`
+		Type                *string             `json:"type,omitempty"`
+	} `json:"enrollments,omitempty"`
+}
+
+func (response GetV1PlyReportRevalidation200JSONResponse) VisitGetV1PlyReportRevalidationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyReportRevalidation500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyReportRevalidation500JSONResponse) VisitGetV1PlyReportRevalidationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlySharedLinkIdRequestObject struct {
+	LinkId string `json:"linkId"`
+	Params GetV1PlySharedLinkIdParams
+}
+
+type GetV1PlySharedLinkIdResponseObject interface {
+	VisitGetV1PlySharedLinkIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlySharedLinkId200ResponseHeaders struct {
+	AcceptRanges        string
+	ContentDisposition  string
+	XContentTypeOptions string
+}
+
+type GetV1PlySharedLinkId200AsteriskResponse struct {
+	Body          io.Reader
+	Headers       GetV1PlySharedLinkId200ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetV1PlySharedLinkId200AsteriskResponse) VisitGetV1PlySharedLinkIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Accept-Ranges", fmt.Sprint(response.Headers.AcceptRanges))
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("X-Content-Type-Options", fmt.Sprint(response.Headers.XContentTypeOptions))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1PlySharedLinkId206ResponseHeaders struct {
+	AcceptRanges        string
+	ContentDisposition  string
+	ContentRange        string
+	XContentTypeOptions string
+}
+
+type GetV1PlySharedLinkId206AsteriskResponse struct {
+	Body          io.Reader
+	Headers       GetV1PlySharedLinkId206ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetV1PlySharedLinkId206AsteriskResponse) VisitGetV1PlySharedLinkIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Accept-Ranges", fmt.Sprint(response.Headers.AcceptRanges))
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("Content-Range", fmt.Sprint(response.Headers.ContentRange))
+	w.Header().Set("X-Content-Type-Options", fmt.Sprint(response.Headers.XContentTypeOptions))
+	w.WriteHeader(206)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetV1PlySharedLinkId403JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlySharedLinkId403JSONResponse) VisitGetV1PlySharedLinkIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlySharedLinkId410JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlySharedLinkId410JSONResponse) VisitGetV1PlySharedLinkIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlySharedLinkId416ResponseHeaders struct {
+	ContentRange string
+}
+
+type GetV1PlySharedLinkId416JSONResponse struct {
+	Body struct {
+		Code    int32  `json:"code"`
+		Message string `json:"message"`
+	}
+	Headers GetV1PlySharedLinkId416ResponseHeaders
+}
+
+func (response GetV1PlySharedLinkId416JSONResponse) VisitGetV1PlySharedLinkIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Range", fmt.Sprint(response.Headers.ContentRange))
+	w.WriteHeader(416)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetV1PlySharedLinkId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlySharedLinkId500JSONResponse) VisitGetV1PlySharedLinkIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlySharedLinkId503JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlySharedLinkId503JSONResponse) VisitGetV1PlySharedLinkIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyTaskTaskIdRequestObject struct {
+	TaskId string `json:"taskId"`
+	Body   *PostV1PlyTaskTaskIdJSONRequestBody
+}
+
+type PostV1PlyTaskTaskIdResponseObject interface {
+	VisitPostV1PlyTaskTaskIdResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyTaskTaskId200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response PostV1PlyTaskTaskId200JSONResponse) VisitPostV1PlyTaskTaskIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyTaskTaskId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyTaskTaskId500JSONResponse) VisitPostV1PlyTaskTaskIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyUploadUploadIdRequestObject struct {
+	UploadId string `json:"uploadId"`
+}
+
+type DeleteV1PlyUploadUploadIdResponseObject interface {
+	VisitDeleteV1PlyUploadUploadIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteV1PlyUploadUploadId200JSONResponse struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (response DeleteV1PlyUploadUploadId200JSONResponse) VisitDeleteV1PlyUploadUploadIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyUploadUploadId404JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response DeleteV1PlyUploadUploadId404JSONResponse) VisitDeleteV1PlyUploadUploadIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyUploadUploadId409JSONResponse struct {
+	Code int32 `json:"code"`
+
+	// ExistingId The identifier of the record that conflicts with the request
+	ExistingId *string `json:"existingId,omitempty"`
+	Message    string  `json:"message"`
+}
+
+func (response DeleteV1PlyUploadUploadId409JSONResponse) VisitDeleteV1PlyUploadUploadIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteV1PlyUploadUploadId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response DeleteV1PlyUploadUploadId500JSONResponse) VisitDeleteV1PlyUploadUploadIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyUploadUploadIdRequestObject struct {
+	UploadId string `json:"uploadId"`
+}
+
+type GetV1PlyUploadUploadIdResponseObject interface {
+	VisitGetV1PlyUploadUploadIdResponse(w http.ResponseWriter) error
+}
+
+type GetV1PlyUploadUploadId200JSONResponse struct {
+	// DocumentType One of "w9", "license", "coi", "cv", "board_certification", "payer_letter", "dea" or "other"
+	DocumentType   *string             `json:"documentType,omitempty"`
+	EnrollmentId   *string             `json:"enrollmentId,omitempty"`
+	ExpirationDate *openapi_types.Date `json:"expirationDate,omitempty"`
+
+	// ExpiresAt When the upload is discarded unless more data arrives
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	FileName   *string    `json:"fileName,omitempty"`
+	LocationId *string    `json:"locationId,omitempty"`
+
+	// Offset How many bytes have been received; the next chunk must start here
+	Offset     *int64  `json:"offset,omitempty"`
+	PracticeId *string `json:"practiceId,omitempty"`
+	ProviderId *string `json:"providerId,omitempty"`
+
+	// Size The total size of the file in bytes
+	Size     *int64  `json:"size,omitempty"`
+	UploadId *string `json:"uploadId,omitempty"`
+}
+
+func (response GetV1PlyUploadUploadId200JSONResponse) VisitGetV1PlyUploadUploadIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyUploadUploadId404JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyUploadUploadId404JSONResponse) VisitGetV1PlyUploadUploadIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetV1PlyUploadUploadId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response GetV1PlyUploadUploadId500JSONResponse) VisitGetV1PlyUploadUploadIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1PlyUploadUploadIdRequestObject struct {
+	UploadId string `json:"uploadId"`
+	Params   PatchV1PlyUploadUploadIdParams
+	Body     io.Reader
+}
+
+type PatchV1PlyUploadUploadIdResponseObject interface {
+	VisitPatchV1PlyUploadUploadIdResponse(w http.ResponseWriter) error
+}
+
+type PatchV1PlyUploadUploadId200JSONResponse struct {
+	// DocumentType One of "w9", "license", "coi", "cv", "board_certification", "payer_letter", "dea" or "other"
+	DocumentType   *string             `json:"documentType,omitempty"`
+	EnrollmentId   *string             `json:"enrollmentId,omitempty"`
+	ExpirationDate *openapi_types.Date `json:"expirationDate,omitempty"`
+
+	// ExpiresAt When the upload is discarded unless more data arrives
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	FileName   *string    `json:"fileName,omitempty"`
+	LocationId *string    `json:"locationId,omitempty"`
+
+	// Offset How many bytes have been received; the next chunk must start here
+	Offset     *int64  `json:"offset,omitempty"`
+	PracticeId *string `json:"practiceId,omitempty"`
+	ProviderId *string `json:"providerId,omitempty"`
+
+	// Size The total size of the file in bytes
+	Size     *int64  `json:"size,omitempty"`
+	UploadId *string `json:"uploadId,omitempty"`
+}
+
+func (response PatchV1PlyUploadUploadId200JSONResponse) VisitPatchV1PlyUploadUploadIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1PlyUploadUploadId404JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PatchV1PlyUploadUploadId404JSONResponse) VisitPatchV1PlyUploadUploadIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1PlyUploadUploadId409JSONResponse struct {
+	Code int32 `json:"code"`
+
+	// ExistingId The identifier of the record that conflicts with the request
+	ExistingId *string `json:"existingId,omitempty"`
+	Message    string  `json:"message"`
+}
+
+func (response PatchV1PlyUploadUploadId409JSONResponse) VisitPatchV1PlyUploadUploadIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1PlyUploadUploadId413JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PatchV1PlyUploadUploadId413JSONResponse) VisitPatchV1PlyUploadUploadIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchV1PlyUploadUploadId500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PatchV1PlyUploadUploadId500JSONResponse) VisitPatchV1PlyUploadUploadIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyUploadUploadIdFinalizeRequestObject struct {
+	UploadId string `json:"uploadId"`
+}
+
+type PostV1PlyUploadUploadIdFinalizeResponseObject interface {
+	VisitPostV1PlyUploadUploadIdFinalizeResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyUploadUploadIdFinalize200JSONResponse struct {
+	DocumentId *string `json:"documentId,omitempty"`
+}
+
+func (response PostV1PlyUploadUploadIdFinalize200JSONResponse) VisitPostV1PlyUploadUploadIdFinalizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyUploadUploadIdFinalize400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyUploadUploadIdFinalize400JSONResponse) VisitPostV1PlyUploadUploadIdFinalizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyUploadUploadIdFinalize404JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyUploadUploadIdFinalize404JSONResponse) VisitPostV1PlyUploadUploadIdFinalizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyUploadUploadIdFinalize409JSONResponse struct {
+	Code int32 `json:"code"`
+
+	// ExistingId The identifier of the record that conflicts with the request
+	ExistingId *string `json:"existingId,omitempty"`
+	Message    string  `json:"message"`
+}
+
+func (response PostV1PlyUploadUploadIdFinalize409JSONResponse) VisitPostV1PlyUploadUploadIdFinalizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyUploadUploadIdFinalize413JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyUploadUploadIdFinalize413JSONResponse) VisitPostV1PlyUploadUploadIdFinalizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyUploadUploadIdFinalize415JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyUploadUploadIdFinalize415JSONResponse) VisitPostV1PlyUploadUploadIdFinalizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(415)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyUploadUploadIdFinalize500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyUploadUploadIdFinalize500JSONResponse) VisitPostV1PlyUploadUploadIdFinalizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyUploadUploadIdFinalize507JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyUploadUploadIdFinalize507JSONResponse) VisitPostV1PlyUploadUploadIdFinalizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(507)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyUserInvitationRequestObject struct {
+	Body *PostV1PlyUserInvitationJSONRequestBody
+}
+
+type PostV1PlyUserInvitationResponseObject interface {
+	VisitPostV1PlyUserInvitationResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyUserInvitation200JSONResponse struct {
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+	Email       *string    `json:"email,omitempty"`
+	LastLoginAt *time.Time `json:"lastLoginAt,omitempty"`
+	MfaEnabled  *bool      `json:"mfaEnabled,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+
+	// Status One of "invited", "active" or "disabled"
+	Status *string `json:"status,omitempty"`
+	UserId *string `json:"userId,omitempty"`
+}
+
+func (response PostV1PlyUserInvitation200JSONResponse) VisitPostV1PlyUserInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyUserInvitation400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyUserInvitation400JSONResponse) VisitPostV1PlyUserInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyUserInvitation409JSONResponse struct {
+	Code int32 `json:"code"`
+
+	// ExistingId The identifier of the record that conflicts with the request
+	ExistingId *string `json:"existingId,omitempty"`
+	Message    string  `json:"message"`
+}
+
+func (response PostV1PlyUserInvitation409JSONResponse) VisitPostV1PlyUserInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyUserInvitation500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyUserInvitation500JSONResponse) VisitPostV1PlyUserInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyUserInvitation503JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyUserInvitation503JSONResponse) VisitPostV1PlyUserInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyUserInvitationAcceptRequestObject struct {
+	Body *PostV1PlyUserInvitationAcceptJSONRequestBody
+}
+
+type PostV1PlyUserInvitationAcceptResponseObject interface {
+	VisitPostV1PlyUserInvitationAcceptResponse(w http.ResponseWriter) error
+}
+
+type PostV1PlyUserInvitationAccept200JSONResponse struct {
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// MfaRequired Set when the user has multi-factor authentication, in which case there is no token until mfaToken and a code are sent to /v1/ply/login/mfa
+	MfaRequired *bool `json:"mfaRequired,omitempty"`
+
+	// MfaToken A short-lived token to complete the login with
+	MfaToken *string `json:"mfaToken,omitempty"`
+
+	// Token A bearer token for the user
+	Token *string `json:"token,omitempty"`
+	User  *struct {
+		CreatedAt   *time.Time `json:"createdAt,omitempty"`
+		Email       *string    `json:"email,omitempty"`
+		LastLoginAt *time.Time `json:"lastLoginAt,omitempty"`
+		MfaEnabled  *bool      `json:"mfaEnabled,omitempty"`
+		Name        *string    `json:"name,omitempty"`
+
+		// Status One of "invited", "active" or "disabled"
+		Status *string `json:"status,omitempty"`
+		UserId *string `json:"userId,omitempty"`
+	} `json:"user,omitempty"`
+}
+
+func (response PostV1PlyUserInvitationAccept200JSONResponse) VisitPostV1PlyUserInvitationAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyUserInvitationAccept400JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyUserInvitationAccept400JSONResponse) VisitPostV1PlyUserInvitationAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyUserInvitationAccept500JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyUserInvitationAccept500JSONResponse) VisitPostV1PlyUserInvitationAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostV1PlyUserInvitationAccept503JSONResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (response PostV1PlyUserInvitationAccept503JSONResponse) VisitPostV1PlyUserInvitationAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Verify stored documents against their checksums
+	// (POST /v1/ply/admin/document/verify)
+	PostV1PlyAdminDocumentVerify(ctx context.Context, request PostV1PlyAdminDocumentVerifyRequestObject) (PostV1PlyAdminDocumentVerifyResponseObject, error)
+	// List role grants
+	// (GET /v1/ply/admin/grant)
+	GetV1PlyAdminGrant(ctx context.Context, request GetV1PlyAdminGrantRequestObject) (GetV1PlyAdminGrantResponseObject, error)
+	// Grant a role
+	// (POST /v1/ply/admin/grant)
+	PostV1PlyAdminGrant(ctx context.Context, request PostV1PlyAdminGrantRequestObject) (PostV1PlyAdminGrantResponseObject, error)
+	// Revoke a role grant
+	// (DELETE /v1/ply/admin/grant/{grantId})
+	DeleteV1PlyAdminGrantGrantId(ctx context.Context, request DeleteV1PlyAdminGrantGrantIdRequestObject) (DeleteV1PlyAdminGrantGrantIdResponseObject, error)
+	// Get the MFA policy
+	// (GET /v1/ply/admin/mfa/policy)
+	GetV1PlyAdminMfaPolicy(ctx context.Context, request GetV1PlyAdminMfaPolicyRequestObject) (GetV1PlyAdminMfaPolicyResponseObject, error)
+	// Set the MFA policy
+	// (POST /v1/ply/admin/mfa/policy)
+	PostV1PlyAdminMfaPolicy(ctx context.Context, request PostV1PlyAdminMfaPolicyRequestObject) (PostV1PlyAdminMfaPolicyResponseObject, error)
+	// Reconcile stored files with document records
+	// (POST /v1/ply/admin/storage/reconcile)
+	PostV1PlyAdminStorageReconcile(ctx context.Context, request PostV1PlyAdminStorageReconcileRequestObject) (PostV1PlyAdminStorageReconcileResponseObject, error)
+	// List users
+	// (GET /v1/ply/admin/user)
+	GetV1PlyAdminUser(ctx context.Context, request GetV1PlyAdminUserRequestObject) (GetV1PlyAdminUserResponseObject, error)
+	// Disable a user
+	// (POST /v1/ply/admin/user/{userId}/disable)
+	PostV1PlyAdminUserUserIdDisable(ctx context.Context, request PostV1PlyAdminUserUserIdDisableRequestObject) (PostV1PlyAdminUserUserIdDisableResponseObject, error)
+	// Reset a user's multi-factor authentication
+	// (POST /v1/ply/admin/user/{userId}/mfa/reset)
+	PostV1PlyAdminUserUserIdMfaReset(ctx context.Context, request PostV1PlyAdminUserUserIdMfaResetRequestObject) (PostV1PlyAdminUserUserIdMfaResetResponseObject, error)
+	// Delete an affiliation
+	// (DELETE /v1/ply/affiliation/{affiliationId})
+	DeleteV1PlyAffiliationAffiliationId(ctx context.Context, request DeleteV1PlyAffiliationAffiliationIdRequestObject) (DeleteV1PlyAffiliationAffiliationIdResponseObject, error)
+	// Read an affiliation
+	// (GET /v1/ply/affiliation/{affiliationId})
+	GetV1PlyAffiliationAffiliationId(ctx context.Context, request GetV1PlyAffiliationAffiliationIdRequestObject) (GetV1PlyAffiliationAffiliationIdResponseObject, error)
+	// Update an affiliation
+	// (POST /v1/ply/affiliation/{affiliationId})
+	PostV1PlyAffiliationAffiliationId(ctx context.Context, request PostV1PlyAffiliationAffiliationIdRequestObject) (PostV1PlyAffiliationAffiliationIdResponseObject, error)
+	// Delete a document
+	// (DELETE /v1/ply/document/{documentId})
+	DeleteV1PlyDocumentDocumentId(ctx context.Context, request DeleteV1PlyDocumentDocumentIdRequestObject) (DeleteV1PlyDocumentDocumentIdResponseObject, error)
+	// Get a document by ID
 	// (GET /v1/ply/document/{documentId})
 	GetV1PlyDocumentDocumentId(ctx context.Context, request GetV1PlyDocumentDocumentIdRequestObject) (GetV1PlyDocumentDocumentIdResponseObject, error)
+	// Read the fields extracted from a document
+	// (GET /v1/ply/document/{documentId}/extraction)
+	GetV1PlyDocumentDocumentIdExtraction(ctx context.Context, request GetV1PlyDocumentDocumentIdExtractionRequestObject) (GetV1PlyDocumentDocumentIdExtractionResponseObject, error)
+	// Queue a document for field extraction again
+	// (POST /v1/ply/document/{documentId}/extraction)
+	PostV1PlyDocumentDocumentIdExtraction(ctx context.Context, request PostV1PlyDocumentDocumentIdExtractionRequestObject) (PostV1PlyDocumentDocumentIdExtractionResponseObject, error)
+	// Apply extracted fields to provider and practice records
+	// (POST /v1/ply/document/{documentId}/extraction/apply)
+	PostV1PlyDocumentDocumentIdExtractionApply(ctx context.Context, request PostV1PlyDocumentDocumentIdExtractionApplyRequestObject) (PostV1PlyDocumentDocumentIdExtractionApplyResponseObject, error)
+	// List a document's shared links
+	// (GET /v1/ply/document/{documentId}/link)
+	GetV1PlyDocumentDocumentIdLink(ctx context.Context, request GetV1PlyDocumentDocumentIdLinkRequestObject) (GetV1PlyDocumentDocumentIdLinkResponseObject, error)
+	// Create a signed link to a document
+	// (POST /v1/ply/document/{documentId}/link)
+	PostV1PlyDocumentDocumentIdLink(ctx context.Context, request PostV1PlyDocumentDocumentIdLinkRequestObject) (PostV1PlyDocumentDocumentIdLinkResponseObject, error)
+	// Revoke a shared link
+	// (DELETE /v1/ply/document/{documentId}/link/{linkId})
+	DeleteV1PlyDocumentDocumentIdLinkLinkId(ctx context.Context, request DeleteV1PlyDocumentDocumentIdLinkLinkIdRequestObject) (DeleteV1PlyDocumentDocumentIdLinkLinkIdResponseObject, error)
+	// Read a document's metadata
+	// (GET /v1/ply/document/{documentId}/metadata)
+	GetV1PlyDocumentDocumentIdMetadata(ctx context.Context, request GetV1PlyDocumentDocumentIdMetadataRequestObject) (GetV1PlyDocumentDocumentIdMetadataResponseObject, error)
+	// Update a document's metadata
+	// (POST /v1/ply/document/{documentId}/metadata)
+	PostV1PlyDocumentDocumentIdMetadata(ctx context.Context, request PostV1PlyDocumentDocumentIdMetadataRequestObject) (PostV1PlyDocumentDocumentIdMetadataResponseObject, error)
+	// Download a preview image of a document
+	// (GET /v1/ply/document/{documentId}/preview)
+	GetV1PlyDocumentDocumentIdPreview(ctx context.Context, request GetV1PlyDocumentDocumentIdPreviewRequestObject) (GetV1PlyDocumentDocumentIdPreviewResponseObject, error)
+	// List a document's versions
+	// (GET /v1/ply/document/{documentId}/version)
+	GetV1PlyDocumentDocumentIdVersion(ctx context.Context, request GetV1PlyDocumentDocumentIdVersionRequestObject) (GetV1PlyDocumentDocumentIdVersionResponseObject, error)
+	// Upload a new version of a document
+	// (POST /v1/ply/document/{documentId}/version)
+	PostV1PlyDocumentDocumentIdVersion(ctx context.Context, request PostV1PlyDocumentDocumentIdVersionRequestObject) (PostV1PlyDocumentDocumentIdVersionResponseObject, error)
+	// Get a version of a document
+	// (GET /v1/ply/document/{documentId}/version/{version})
+	GetV1PlyDocumentDocumentIdVersionVersion(ctx context.Context, request GetV1PlyDocumentDocumentIdVersionVersionRequestObject) (GetV1PlyDocumentDocumentIdVersionVersionResponseObject, error)
+	// Make a version of a document current
+	// (POST /v1/ply/document/{documentId}/version/{version}/current)
+	PostV1PlyDocumentDocumentIdVersionVersionCurrent(ctx context.Context, request PostV1PlyDocumentDocumentIdVersionVersionCurrentRequestObject) (PostV1PlyDocumentDocumentIdVersionVersionCurrentResponseObject, error)
 	// Create an enrollment
 	// (POST /v1/ply/enrollment)
 	PostV1PlyEnrollment(ctx context.Context, request PostV1PlyEnrollmentRequestObject) (PostV1PlyEnrollmentResponseObject, error)
@@ -2103,6 +9084,9 @@ type StrictServerInterface interface {
 	// List activities
 	// (GET /v1/ply/enrollment/{enrollmentId}/activity)
 	GetV1PlyEnrollmentEnrollmentIdActivity(ctx context.Context, request GetV1PlyEnrollmentEnrollmentIdActivityRequestObject) (GetV1PlyEnrollmentEnrollmentIdActivityResponseObject, error)
+	// List individual enrollments that depend on a group enrollment
+	// (GET /v1/ply/enrollment/{enrollmentId}/dependents)
+	GetV1PlyEnrollmentEnrollmentIdDependents(ctx context.Context, request GetV1PlyEnrollmentEnrollmentIdDependentsRequestObject) (GetV1PlyEnrollmentEnrollmentIdDependentsResponseObject, error)
 	// Create a location
 	// (POST /v1/ply/location)
 	PostV1PlyLocation(ctx context.Context, request PostV1PlyLocationRequestObject) (PostV1PlyLocationResponseObject, error)
@@ -2115,6 +9099,60 @@ type StrictServerInterface interface {
 	// Update a location
 	// (POST /v1/ply/location/{locationId})
 	PostV1PlyLocationLocationId(ctx context.Context, request PostV1PlyLocationLocationIdRequestObject) (PostV1PlyLocationLocationIdResponseObject, error)
+	// Log in with an email and password
+	// (POST /v1/ply/login)
+	PostV1PlyLogin(ctx context.Context, request PostV1PlyLoginRequestObject) (PostV1PlyLoginResponseObject, error)
+	// Complete a login with a second factor
+	// (POST /v1/ply/login/mfa)
+	PostV1PlyLoginMfa(ctx context.Context, request PostV1PlyLoginMfaRequestObject) (PostV1PlyLoginMfaResponseObject, error)
+	// Read the caller's identity
+	// (GET /v1/ply/me)
+	GetV1PlyMe(ctx context.Context, request GetV1PlyMeRequestObject) (GetV1PlyMeResponseObject, error)
+	// Start enrolling in multi-factor authentication
+	// (POST /v1/ply/me/mfa)
+	PostV1PlyMeMfa(ctx context.Context, request PostV1PlyMeMfaRequestObject) (PostV1PlyMeMfaResponseObject, error)
+	// Confirm multi-factor enrollment
+	// (POST /v1/ply/me/mfa/confirm)
+	PostV1PlyMeMfaConfirm(ctx context.Context, request PostV1PlyMeMfaConfirmRequestObject) (PostV1PlyMeMfaConfirmResponseObject, error)
+	// Disable multi-factor authentication
+	// (POST /v1/ply/me/mfa/disable)
+	PostV1PlyMeMfaDisable(ctx context.Context, request PostV1PlyMeMfaDisableRequestObject) (PostV1PlyMeMfaDisableResponseObject, error)
+	// Replace recovery codes
+	// (POST /v1/ply/me/mfa/recovery)
+	PostV1PlyMeMfaRecovery(ctx context.Context, request PostV1PlyMeMfaRecoveryRequestObject) (PostV1PlyMeMfaRecoveryResponseObject, error)
+	// Change the caller's password
+	// (POST /v1/ply/me/password)
+	PostV1PlyMePassword(ctx context.Context, request PostV1PlyMePasswordRequestObject) (PostV1PlyMePasswordResponseObject, error)
+	// Create an organization
+	// (POST /v1/ply/organization)
+	PostV1PlyOrganization(ctx context.Context, request PostV1PlyOrganizationRequestObject) (PostV1PlyOrganizationResponseObject, error)
+	// List organizations
+	// (GET /v1/ply/organization/list)
+	GetV1PlyOrganizationList(ctx context.Context, request GetV1PlyOrganizationListRequestObject) (GetV1PlyOrganizationListResponseObject, error)
+	// Read an organization
+	// (GET /v1/ply/organization/{organizationId})
+	GetV1PlyOrganizationOrganizationId(ctx context.Context, request GetV1PlyOrganizationOrganizationIdRequestObject) (GetV1PlyOrganizationOrganizationIdResponseObject, error)
+	// Update an organization
+	// (POST /v1/ply/organization/{organizationId})
+	PostV1PlyOrganizationOrganizationId(ctx context.Context, request PostV1PlyOrganizationOrganizationIdRequestObject) (PostV1PlyOrganizationOrganizationIdResponseObject, error)
+	// List enrollments across an organization
+	// (GET /v1/ply/organization/{organizationId}/enrollment)
+	GetV1PlyOrganizationOrganizationIdEnrollment(ctx context.Context, request GetV1PlyOrganizationOrganizationIdEnrollmentRequestObject) (GetV1PlyOrganizationOrganizationIdEnrollmentResponseObject, error)
+	// List practices in an organization
+	// (GET /v1/ply/organization/{organizationId}/practice)
+	GetV1PlyOrganizationOrganizationIdPractice(ctx context.Context, request GetV1PlyOrganizationOrganizationIdPracticeRequestObject) (GetV1PlyOrganizationOrganizationIdPracticeResponseObject, error)
+	// List providers across an organization
+	// (GET /v1/ply/organization/{organizationId}/provider)
+	GetV1PlyOrganizationOrganizationIdProvider(ctx context.Context, request GetV1PlyOrganizationOrganizationIdProviderRequestObject) (GetV1PlyOrganizationOrganizationIdProviderResponseObject, error)
+	// List open tasks across an organization
+	// (GET /v1/ply/organization/{organizationId}/task)
+	GetV1PlyOrganizationOrganizationIdTask(ctx context.Context, request GetV1PlyOrganizationOrganizationIdTaskRequestObject) (GetV1PlyOrganizationOrganizationIdTaskResponseObject, error)
+	// Request a password reset
+	// (POST /v1/ply/password/reset)
+	PostV1PlyPasswordReset(ctx context.Context, request PostV1PlyPasswordResetRequestObject) (PostV1PlyPasswordResetResponseObject, error)
+	// Reset a password
+	// (POST /v1/ply/password/reset/confirm)
+	PostV1PlyPasswordResetConfirm(ctx context.Context, request PostV1PlyPasswordResetConfirmRequestObject) (PostV1PlyPasswordResetConfirmResponseObject, error)
 	// Create a practice
 	// (POST /v1/ply/practice)
 	PostV1PlyPractice(ctx context.Context, request PostV1PlyPracticeRequestObject) (PostV1PlyPracticeResponseObject, error)
@@ -2130,9 +9168,18 @@ type StrictServerInterface interface {
 	// List documents
 	// (GET /v1/ply/practice/{practiceId}/document)
 	GetV1PlyPracticePracticeIdDocument(ctx context.Context, request GetV1PlyPracticePracticeIdDocumentRequestObject) (GetV1PlyPracticePracticeIdDocumentResponseObject, error)
+	// Download a practice's documents as a ZIP archive
+	// (GET /v1/ply/practice/{practiceId}/document/archive)
+	GetV1PlyPracticePracticeIdDocumentArchive(ctx context.Context, request GetV1PlyPracticePracticeIdDocumentArchiveRequestObject) (GetV1PlyPracticePracticeIdDocumentArchiveResponseObject, error)
+	// Search the text of a practice's documents
+	// (GET /v1/ply/practice/{practiceId}/document/search)
+	GetV1PlyPracticePracticeIdDocumentSearch(ctx context.Context, request GetV1PlyPracticePracticeIdDocumentSearchRequestObject) (GetV1PlyPracticePracticeIdDocumentSearchResponseObject, error)
 	// List enrollments
 	// (GET /v1/ply/practice/{practiceId}/enrollment)
 	GetV1PlyPracticePracticeIdEnrollment(ctx context.Context, request GetV1PlyPracticePracticeIdEnrollmentRequestObject) (GetV1PlyPracticePracticeIdEnrollmentResponseObject, error)
+	// Report likely duplicate enrollments
+	// (GET /v1/ply/practice/{practiceId}/enrollment/duplicates)
+	GetV1PlyPracticePracticeIdEnrollmentDuplicates(ctx context.Context, request GetV1PlyPracticePracticeIdEnrollmentDuplicatesRequestObject) (GetV1PlyPracticePracticeIdEnrollmentDuplicatesResponseObject, error)
 	// List locations
 	// (GET /v1/ply/practice/{practiceId}/location)
 	GetV1PlyPracticePracticeIdLocation(ctx context.Context, request GetV1PlyPracticePracticeIdLocationRequestObject) (GetV1PlyPracticePracticeIdLocationResponseObject, error)
@@ -2145,6 +9192,12 @@ type StrictServerInterface interface {
 	// Upload a document for a practice
 	// (POST /v1/ply/practice/{practiceId}/upload)
 	PostV1PlyPracticePracticeIdUpload(ctx context.Context, request PostV1PlyPracticePracticeIdUploadRequestObject) (PostV1PlyPracticePracticeIdUploadResponseObject, error)
+	// Start a resumable upload for a practice
+	// (POST /v1/ply/practice/{practiceId}/upload/resumable)
+	PostV1PlyPracticePracticeIdUploadResumable(ctx context.Context, request PostV1PlyPracticePracticeIdUploadResumableRequestObject) (PostV1PlyPracticePracticeIdUploadResumableResponseObject, error)
+	// Get a practice's document storage usage
+	// (GET /v1/ply/practice/{practiceId}/usage)
+	GetV1PlyPracticePracticeIdUsage(ctx context.Context, request GetV1PlyPracticePracticeIdUsageRequestObject) (GetV1PlyPracticePracticeIdUsageResponseObject, error)
 	// Create a provider
 	// (POST /v1/ply/provider)
 	PostV1PlyProvider(ctx context.Context, request PostV1PlyProviderRequestObject) (PostV1PlyProviderResponseObject, error)
@@ -2157,59 +9210,1666 @@ type StrictServerInterface interface {
 	// Update a provider
 	// (POST /v1/ply/provider/{providerId})
 	PostV1PlyProviderProviderId(ctx context.Context, request PostV1PlyProviderProviderIdRequestObject) (PostV1PlyProviderProviderIdResponseObject, error)
+	// List affiliations for a provider
+	// (GET /v1/ply/provider/{providerId}/affiliation)
+	GetV1PlyProviderProviderIdAffiliation(ctx context.Context, request GetV1PlyProviderProviderIdAffiliationRequestObject) (GetV1PlyProviderProviderIdAffiliationResponseObject, error)
+	// Affiliate a provider with a practice
+	// (POST /v1/ply/provider/{providerId}/affiliation)
+	PostV1PlyProviderProviderIdAffiliation(ctx context.Context, request PostV1PlyProviderProviderIdAffiliationRequestObject) (PostV1PlyProviderProviderIdAffiliationResponseObject, error)
+	// List enrollments due for revalidation
+	// (GET /v1/ply/report/revalidation)
+	GetV1PlyReportRevalidation(ctx context.Context, request GetV1PlyReportRevalidationRequestObject) (GetV1PlyReportRevalidationResponseObject, error)
+	// Download a document through a shared link
+	// (GET /v1/ply/shared/{linkId})
+	GetV1PlySharedLinkId(ctx context.Context, request GetV1PlySharedLinkIdRequestObject) (GetV1PlySharedLinkIdResponseObject, error)
 	// Update a task
 	// (POST /v1/ply/task/{taskId})
 	PostV1PlyTaskTaskId(ctx context.Context, request PostV1PlyTaskTaskIdRequestObject) (PostV1PlyTaskTaskIdResponseObject, error)
+	// Abandon a resumable upload
+	// (DELETE /v1/ply/upload/{uploadId})
+	DeleteV1PlyUploadUploadId(ctx context.Context, request DeleteV1PlyUploadUploadIdRequestObject) (DeleteV1PlyUploadUploadIdResponseObject, error)
+	// Read a resumable upload's progress
+	// (GET /v1/ply/upload/{uploadId})
+	GetV1PlyUploadUploadId(ctx context.Context, request GetV1PlyUploadUploadIdRequestObject) (GetV1PlyUploadUploadIdResponseObject, error)
+	// Send the next chunk of a resumable upload
+	// (PATCH /v1/ply/upload/{uploadId})
+	PatchV1PlyUploadUploadId(ctx context.Context, request PatchV1PlyUploadUploadIdRequestObject) (PatchV1PlyUploadUploadIdResponseObject, error)
+	// Finish a resumable upload
+	// (POST /v1/ply/upload/{uploadId}/finalize)
+	PostV1PlyUploadUploadIdFinalize(ctx context.Context, request PostV1PlyUploadUploadIdFinalizeRequestObject) (PostV1PlyUploadUploadIdFinalizeResponseObject, error)
+	// Invite a user
+	// (POST /v1/ply/user/invitation)
+	PostV1PlyUserInvitation(ctx context.Context, request PostV1PlyUserInvitationRequestObject) (PostV1PlyUserInvitationResponseObject, error)
+	// Accept an invitation
+	// (POST /v1/ply/user/invitation/accept)
+	PostV1PlyUserInvitationAccept(ctx context.Context, request PostV1PlyUserInvitationAcceptRequestObject) (PostV1PlyUserInvitationAcceptResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHttpHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHttpMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// PostV1PlyAdminDocumentVerify operation middleware
+func (sh *strictHandler) PostV1PlyAdminDocumentVerify(w http.ResponseWriter, r *http.Request, params PostV1PlyAdminDocumentVerifyParams) {
+	var request PostV1PlyAdminDocumentVerifyRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyAdminDocumentVerify(ctx, request.(PostV1PlyAdminDocumentVerifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyAdminDocumentVerify")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyAdminDocumentVerifyResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyAdminDocumentVerifyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyAdminGrant operation middleware
+func (sh *strictHandler) GetV1PlyAdminGrant(w http.ResponseWriter, r *http.Request, params GetV1PlyAdminGrantParams) {
+	var request GetV1PlyAdminGrantRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyAdminGrant(ctx, request.(GetV1PlyAdminGrantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyAdminGrant")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyAdminGrantResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyAdminGrantResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyAdminGrant operation middleware
+func (sh *strictHandler) PostV1PlyAdminGrant(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyAdminGrantRequestObject
+
+	var body PostV1PlyAdminGrantJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyAdminGrant(ctx, request.(PostV1PlyAdminGrantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyAdminGrant")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyAdminGrantResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyAdminGrantResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteV1PlyAdminGrantGrantId operation middleware
+func (sh *strictHandler) DeleteV1PlyAdminGrantGrantId(w http.ResponseWriter, r *http.Request, grantId string) {
+	var request DeleteV1PlyAdminGrantGrantIdRequestObject
+
+	request.GrantId = grantId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV1PlyAdminGrantGrantId(ctx, request.(DeleteV1PlyAdminGrantGrantIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV1PlyAdminGrantGrantId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteV1PlyAdminGrantGrantIdResponseObject); ok {
+		if err := validResponse.VisitDeleteV1PlyAdminGrantGrantIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyAdminMfaPolicy operation middleware
+func (sh *strictHandler) GetV1PlyAdminMfaPolicy(w http.ResponseWriter, r *http.Request) {
+	var request GetV1PlyAdminMfaPolicyRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyAdminMfaPolicy(ctx, request.(GetV1PlyAdminMfaPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyAdminMfaPolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyAdminMfaPolicyResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyAdminMfaPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyAdminMfaPolicy operation middleware
+func (sh *strictHandler) PostV1PlyAdminMfaPolicy(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyAdminMfaPolicyRequestObject
+
+	var body PostV1PlyAdminMfaPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyAdminMfaPolicy(ctx, request.(PostV1PlyAdminMfaPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyAdminMfaPolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyAdminMfaPolicyResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyAdminMfaPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyAdminStorageReconcile operation middleware
+func (sh *strictHandler) PostV1PlyAdminStorageReconcile(w http.ResponseWriter, r *http.Request, params PostV1PlyAdminStorageReconcileParams) {
+	var request PostV1PlyAdminStorageReconcileRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyAdminStorageReconcile(ctx, request.(PostV1PlyAdminStorageReconcileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyAdminStorageReconcile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyAdminStorageReconcileResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyAdminStorageReconcileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyAdminUser operation middleware
+func (sh *strictHandler) GetV1PlyAdminUser(w http.ResponseWriter, r *http.Request) {
+	var request GetV1PlyAdminUserRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyAdminUser(ctx, request.(GetV1PlyAdminUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyAdminUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyAdminUserResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyAdminUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyAdminUserUserIdDisable operation middleware
+func (sh *strictHandler) PostV1PlyAdminUserUserIdDisable(w http.ResponseWriter, r *http.Request, userId string) {
+	var request PostV1PlyAdminUserUserIdDisableRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyAdminUserUserIdDisable(ctx, request.(PostV1PlyAdminUserUserIdDisableRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyAdminUserUserIdDisable")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyAdminUserUserIdDisableResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyAdminUserUserIdDisableResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyAdminUserUserIdMfaReset operation middleware
+func (sh *strictHandler) PostV1PlyAdminUserUserIdMfaReset(w http.ResponseWriter, r *http.Request, userId string) {
+	var request PostV1PlyAdminUserUserIdMfaResetRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyAdminUserUserIdMfaReset(ctx, request.(PostV1PlyAdminUserUserIdMfaResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyAdminUserUserIdMfaReset")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyAdminUserUserIdMfaResetResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyAdminUserUserIdMfaResetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteV1PlyAffiliationAffiliationId operation middleware
+func (sh *strictHandler) DeleteV1PlyAffiliationAffiliationId(w http.ResponseWriter, r *http.Request, affiliationId string) {
+	var request DeleteV1PlyAffiliationAffiliationIdRequestObject
+
+	request.AffiliationId = affiliationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV1PlyAffiliationAffiliationId(ctx, request.(DeleteV1PlyAffiliationAffiliationIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV1PlyAffiliationAffiliationId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteV1PlyAffiliationAffiliationIdResponseObject); ok {
+		if err := validResponse.VisitDeleteV1PlyAffiliationAffiliationIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyAffiliationAffiliationId operation middleware
+func (sh *strictHandler) GetV1PlyAffiliationAffiliationId(w http.ResponseWriter, r *http.Request, affiliationId string) {
+	var request GetV1PlyAffiliationAffiliationIdRequestObject
+
+	request.AffiliationId = affiliationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyAffiliationAffiliationId(ctx, request.(GetV1PlyAffiliationAffiliationIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyAffiliationAffiliationId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyAffiliationAffiliationIdResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyAffiliationAffiliationIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyAffiliationAffiliationId operation middleware
+func (sh *strictHandler) PostV1PlyAffiliationAffiliationId(w http.ResponseWriter, r *http.Request, affiliationId string) {
+	var request PostV1PlyAffiliationAffiliationIdRequestObject
+
+	request.AffiliationId = affiliationId
+
+	var body PostV1PlyAffiliationAffiliationIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyAffiliationAffiliationId(ctx, request.(PostV1PlyAffiliationAffiliationIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyAffiliationAffiliationId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyAffiliationAffiliationIdResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyAffiliationAffiliationIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteV1PlyDocumentDocumentId operation middleware
+func (sh *strictHandler) DeleteV1PlyDocumentDocumentId(w http.ResponseWriter, r *http.Request, documentId string) {
+	var request DeleteV1PlyDocumentDocumentIdRequestObject
+
+	request.DocumentId = documentId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV1PlyDocumentDocumentId(ctx, request.(DeleteV1PlyDocumentDocumentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV1PlyDocumentDocumentId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteV1PlyDocumentDocumentIdResponseObject); ok {
+		if err := validResponse.VisitDeleteV1PlyDocumentDocumentIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyDocumentDocumentId operation middleware
+func (sh *strictHandler) GetV1PlyDocumentDocumentId(w http.ResponseWriter, r *http.Request, documentId string, params GetV1PlyDocumentDocumentIdParams) {
+	var request GetV1PlyDocumentDocumentIdRequestObject
+
+	request.DocumentId = documentId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyDocumentDocumentId(ctx, request.(GetV1PlyDocumentDocumentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyDocumentDocumentId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyDocumentDocumentIdResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyDocumentDocumentIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyDocumentDocumentIdExtraction operation middleware
+func (sh *strictHandler) GetV1PlyDocumentDocumentIdExtraction(w http.ResponseWriter, r *http.Request, documentId string) {
+	var request GetV1PlyDocumentDocumentIdExtractionRequestObject
+
+	request.DocumentId = documentId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyDocumentDocumentIdExtraction(ctx, request.(GetV1PlyDocumentDocumentIdExtractionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyDocumentDocumentIdExtraction")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyDocumentDocumentIdExtractionResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyDocumentDocumentIdExtractionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyDocumentDocumentIdExtraction operation middleware
+func (sh *strictHandler) PostV1PlyDocumentDocumentIdExtraction(w http.ResponseWriter, r *http.Request, documentId string) {
+	var request PostV1PlyDocumentDocumentIdExtractionRequestObject
+
+	request.DocumentId = documentId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyDocumentDocumentIdExtraction(ctx, request.(PostV1PlyDocumentDocumentIdExtractionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyDocumentDocumentIdExtraction")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyDocumentDocumentIdExtractionResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyDocumentDocumentIdExtractionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyDocumentDocumentIdExtractionApply operation middleware
+func (sh *strictHandler) PostV1PlyDocumentDocumentIdExtractionApply(w http.ResponseWriter, r *http.Request, documentId string) {
+	var request PostV1PlyDocumentDocumentIdExtractionApplyRequestObject
+
+	request.DocumentId = documentId
+
+	var body PostV1PlyDocumentDocumentIdExtractionApplyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyDocumentDocumentIdExtractionApply(ctx, request.(PostV1PlyDocumentDocumentIdExtractionApplyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyDocumentDocumentIdExtractionApply")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyDocumentDocumentIdExtractionApplyResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyDocumentDocumentIdExtractionApplyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyDocumentDocumentIdLink operation middleware
+func (sh *strictHandler) GetV1PlyDocumentDocumentIdLink(w http.ResponseWriter, r *http.Request, documentId string) {
+	var request GetV1PlyDocumentDocumentIdLinkRequestObject
+
+	request.DocumentId = documentId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyDocumentDocumentIdLink(ctx, request.(GetV1PlyDocumentDocumentIdLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyDocumentDocumentIdLink")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyDocumentDocumentIdLinkResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyDocumentDocumentIdLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyDocumentDocumentIdLink operation middleware
+func (sh *strictHandler) PostV1PlyDocumentDocumentIdLink(w http.ResponseWriter, r *http.Request, documentId string) {
+	var request PostV1PlyDocumentDocumentIdLinkRequestObject
+
+	request.DocumentId = documentId
+
+	var body PostV1PlyDocumentDocumentIdLinkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyDocumentDocumentIdLink(ctx, request.(PostV1PlyDocumentDocumentIdLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyDocumentDocumentIdLink")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyDocumentDocumentIdLinkResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyDocumentDocumentIdLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteV1PlyDocumentDocumentIdLinkLinkId operation middleware
+func (sh *strictHandler) DeleteV1PlyDocumentDocumentIdLinkLinkId(w http.ResponseWriter, r *http.Request, documentId string, linkId string) {
+	var request DeleteV1PlyDocumentDocumentIdLinkLinkIdRequestObject
+
+	request.DocumentId = documentId
+	request.LinkId = linkId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV1PlyDocumentDocumentIdLinkLinkId(ctx, request.(DeleteV1PlyDocumentDocumentIdLinkLinkIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV1PlyDocumentDocumentIdLinkLinkId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteV1PlyDocumentDocumentIdLinkLinkIdResponseObject); ok {
+		if err := validResponse.VisitDeleteV1PlyDocumentDocumentIdLinkLinkIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyDocumentDocumentIdMetadata operation middleware
+func (sh *strictHandler) GetV1PlyDocumentDocumentIdMetadata(w http.ResponseWriter, r *http.Request, documentId string) {
+	var request GetV1PlyDocumentDocumentIdMetadataRequestObject
+
+	request.DocumentId = documentId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyDocumentDocumentIdMetadata(ctx, request.(GetV1PlyDocumentDocumentIdMetadataRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyDocumentDocumentIdMetadata")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyDocumentDocumentIdMetadataResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyDocumentDocumentIdMetadataResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyDocumentDocumentIdMetadata operation middleware
+func (sh *strictHandler) PostV1PlyDocumentDocumentIdMetadata(w http.ResponseWriter, r *http.Request, documentId string) {
+	var request PostV1PlyDocumentDocumentIdMetadataRequestObject
+
+	request.DocumentId = documentId
+
+	var body PostV1PlyDocumentDocumentIdMetadataJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyDocumentDocumentIdMetadata(ctx, request.(PostV1PlyDocumentDocumentIdMetadataRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyDocumentDocumentIdMetadata")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyDocumentDocumentIdMetadataResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyDocumentDocumentIdMetadataResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyDocumentDocumentIdPreview operation middleware
+func (sh *strictHandler) GetV1PlyDocumentDocumentIdPreview(w http.ResponseWriter, r *http.Request, documentId string) {
+	var request GetV1PlyDocumentDocumentIdPreviewRequestObject
+
+	request.DocumentId = documentId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyDocumentDocumentIdPreview(ctx, request.(GetV1PlyDocumentDocumentIdPreviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyDocumentDocumentIdPreview")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyDocumentDocumentIdPreviewResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyDocumentDocumentIdPreviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyDocumentDocumentIdVersion operation middleware
+func (sh *strictHandler) GetV1PlyDocumentDocumentIdVersion(w http.ResponseWriter, r *http.Request, documentId string) {
+	var request GetV1PlyDocumentDocumentIdVersionRequestObject
+
+	request.DocumentId = documentId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyDocumentDocumentIdVersion(ctx, request.(GetV1PlyDocumentDocumentIdVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyDocumentDocumentIdVersion")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyDocumentDocumentIdVersionResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyDocumentDocumentIdVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyDocumentDocumentIdVersion operation middleware
+func (sh *strictHandler) PostV1PlyDocumentDocumentIdVersion(w http.ResponseWriter, r *http.Request, documentId string) {
+	var request PostV1PlyDocumentDocumentIdVersionRequestObject
+
+	request.DocumentId = documentId
+
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyDocumentDocumentIdVersion(ctx, request.(PostV1PlyDocumentDocumentIdVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyDocumentDocumentIdVersion")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyDocumentDocumentIdVersionResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyDocumentDocumentIdVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyDocumentDocumentIdVersionVersion operation middleware
+func (sh *strictHandler) GetV1PlyDocumentDocumentIdVersionVersion(w http.ResponseWriter, r *http.Request, documentId string, version int, params GetV1PlyDocumentDocumentIdVersionVersionParams) {
+	var request GetV1PlyDocumentDocumentIdVersionVersionRequestObject
+
+	request.DocumentId = documentId
+	request.Version = version
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyDocumentDocumentIdVersionVersion(ctx, request.(GetV1PlyDocumentDocumentIdVersionVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyDocumentDocumentIdVersionVersion")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyDocumentDocumentIdVersionVersionResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyDocumentDocumentIdVersionVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyDocumentDocumentIdVersionVersionCurrent operation middleware
+func (sh *strictHandler) PostV1PlyDocumentDocumentIdVersionVersionCurrent(w http.ResponseWriter, r *http.Request, documentId string, version int) {
+	var request PostV1PlyDocumentDocumentIdVersionVersionCurrentRequestObject
+
+	request.DocumentId = documentId
+	request.Version = version
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyDocumentDocumentIdVersionVersionCurrent(ctx, request.(PostV1PlyDocumentDocumentIdVersionVersionCurrentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyDocumentDocumentIdVersionVersionCurrent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyDocumentDocumentIdVersionVersionCurrentResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyDocumentDocumentIdVersionVersionCurrentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyEnrollment operation middleware
+func (sh *strictHandler) PostV1PlyEnrollment(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyEnrollmentRequestObject
+
+	var body PostV1PlyEnrollmentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyEnrollment(ctx, request.(PostV1PlyEnrollmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyEnrollment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyEnrollmentResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyEnrollmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteV1PlyEnrollmentEnrollmentId operation middleware
+func (sh *strictHandler) DeleteV1PlyEnrollmentEnrollmentId(w http.ResponseWriter, r *http.Request, enrollmentId string) {
+	var request DeleteV1PlyEnrollmentEnrollmentIdRequestObject
+
+	request.EnrollmentId = enrollmentId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV1PlyEnrollmentEnrollmentId(ctx, request.(DeleteV1PlyEnrollmentEnrollmentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV1PlyEnrollmentEnrollmentId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteV1PlyEnrollmentEnrollmentIdResponseObject); ok {
+		if err := validResponse.VisitDeleteV1PlyEnrollmentEnrollmentIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyEnrollmentEnrollmentId operation middleware
+func (sh *strictHandler) GetV1PlyEnrollmentEnrollmentId(w http.ResponseWriter, r *http.Request, enrollmentId string) {
+	var request GetV1PlyEnrollmentEnrollmentIdRequestObject
+
+	request.EnrollmentId = enrollmentId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyEnrollmentEnrollmentId(ctx, request.(GetV1PlyEnrollmentEnrollmentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyEnrollmentEnrollmentId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyEnrollmentEnrollmentIdResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyEnrollmentEnrollmentIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyEnrollmentEnrollmentId operation middleware
+func (sh *strictHandler) PostV1PlyEnrollmentEnrollmentId(w http.ResponseWriter, r *http.Request, enrollmentId string) {
+	var request PostV1PlyEnrollmentEnrollmentIdRequestObject
+
+	request.EnrollmentId = enrollmentId
+
+	var body PostV1PlyEnrollmentEnrollmentIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyEnrollmentEnrollmentId(ctx, request.(PostV1PlyEnrollmentEnrollmentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyEnrollmentEnrollmentId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyEnrollmentEnrollmentIdResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyEnrollmentEnrollmentIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyEnrollmentEnrollmentIdActivity operation middleware
+func (sh *strictHandler) GetV1PlyEnrollmentEnrollmentIdActivity(w http.ResponseWriter, r *http.Request, enrollmentId string) {
+	var request GetV1PlyEnrollmentEnrollmentIdActivityRequestObject
+
+	request.EnrollmentId = enrollmentId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyEnrollmentEnrollmentIdActivity(ctx, request.(GetV1PlyEnrollmentEnrollmentIdActivityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyEnrollmentEnrollmentIdActivity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyEnrollmentEnrollmentIdActivityResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyEnrollmentEnrollmentIdActivityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyEnrollmentEnrollmentIdDependents operation middleware
+func (sh *strictHandler) GetV1PlyEnrollmentEnrollmentIdDependents(w http.ResponseWriter, r *http.Request, enrollmentId string) {
+	var request GetV1PlyEnrollmentEnrollmentIdDependentsRequestObject
+
+	request.EnrollmentId = enrollmentId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyEnrollmentEnrollmentIdDependents(ctx, request.(GetV1PlyEnrollmentEnrollmentIdDependentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyEnrollmentEnrollmentIdDependents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyEnrollmentEnrollmentIdDependentsResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyEnrollmentEnrollmentIdDependentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyLocation operation middleware
+func (sh *strictHandler) PostV1PlyLocation(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyLocationRequestObject
+
+	var body PostV1PlyLocationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyLocation(ctx, request.(PostV1PlyLocationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyLocation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyLocationResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyLocationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteV1PlyLocationLocationId operation middleware
+func (sh *strictHandler) DeleteV1PlyLocationLocationId(w http.ResponseWriter, r *http.Request, locationId string) {
+	var request DeleteV1PlyLocationLocationIdRequestObject
+
+	request.LocationId = locationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteV1PlyLocationLocationId(ctx, request.(DeleteV1PlyLocationLocationIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteV1PlyLocationLocationId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteV1PlyLocationLocationIdResponseObject); ok {
+		if err := validResponse.VisitDeleteV1PlyLocationLocationIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyLocationLocationId operation middleware
+func (sh *strictHandler) GetV1PlyLocationLocationId(w http.ResponseWriter, r *http.Request, locationId string) {
+	var request GetV1PlyLocationLocationIdRequestObject
+
+	request.LocationId = locationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyLocationLocationId(ctx, request.(GetV1PlyLocationLocationIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyLocationLocationId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyLocationLocationIdResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyLocationLocationIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyLocationLocationId operation middleware
+func (sh *strictHandler) PostV1PlyLocationLocationId(w http.ResponseWriter, r *http.Request, locationId string) {
+	var request PostV1PlyLocationLocationIdRequestObject
+
+	request.LocationId = locationId
+
+	var body PostV1PlyLocationLocationIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyLocationLocationId(ctx, request.(PostV1PlyLocationLocationIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyLocationLocationId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyLocationLocationIdResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyLocationLocationIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyLogin operation middleware
+func (sh *strictHandler) PostV1PlyLogin(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyLoginRequestObject
+
+	var body PostV1PlyLoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyLogin(ctx, request.(PostV1PlyLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyLogin")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyLoginResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyLoginMfa operation middleware
+func (sh *strictHandler) PostV1PlyLoginMfa(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyLoginMfaRequestObject
+
+	var body PostV1PlyLoginMfaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyLoginMfa(ctx, request.(PostV1PlyLoginMfaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyLoginMfa")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyLoginMfaResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyLoginMfaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyMe operation middleware
+func (sh *strictHandler) GetV1PlyMe(w http.ResponseWriter, r *http.Request) {
+	var request GetV1PlyMeRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyMe(ctx, request.(GetV1PlyMeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyMe")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyMeResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyMeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyMeMfa operation middleware
+func (sh *strictHandler) PostV1PlyMeMfa(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyMeMfaRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyMeMfa(ctx, request.(PostV1PlyMeMfaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyMeMfa")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyMeMfaResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyMeMfaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyMeMfaConfirm operation middleware
+func (sh *strictHandler) PostV1PlyMeMfaConfirm(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyMeMfaConfirmRequestObject
+
+	var body PostV1PlyMeMfaConfirmJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyMeMfaConfirm(ctx, request.(PostV1PlyMeMfaConfirmRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyMeMfaConfirm")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyMeMfaConfirmResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyMeMfaConfirmResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyMeMfaDisable operation middleware
+func (sh *strictHandler) PostV1PlyMeMfaDisable(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyMeMfaDisableRequestObject
+
+	var body PostV1PlyMeMfaDisableJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyMeMfaDisable(ctx, request.(PostV1PlyMeMfaDisableRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyMeMfaDisable")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyMeMfaDisableResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyMeMfaDisableResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyMeMfaRecovery operation middleware
+func (sh *strictHandler) PostV1PlyMeMfaRecovery(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyMeMfaRecoveryRequestObject
+
+	var body PostV1PlyMeMfaRecoveryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyMeMfaRecovery(ctx, request.(PostV1PlyMeMfaRecoveryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyMeMfaRecovery")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyMeMfaRecoveryResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyMeMfaRecoveryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyMePassword operation middleware
+func (sh *strictHandler) PostV1PlyMePassword(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyMePasswordRequestObject
+
+	var body PostV1PlyMePasswordJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyMePassword(ctx, request.(PostV1PlyMePasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyMePassword")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyMePasswordResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyMePasswordResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyOrganization operation middleware
+func (sh *strictHandler) PostV1PlyOrganization(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyOrganizationRequestObject
+
+	var body PostV1PlyOrganizationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyOrganization(ctx, request.(PostV1PlyOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyOrganization")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyOrganizationResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyOrganizationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyOrganizationList operation middleware
+func (sh *strictHandler) GetV1PlyOrganizationList(w http.ResponseWriter, r *http.Request) {
+	var request GetV1PlyOrganizationListRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyOrganizationList(ctx, request.(GetV1PlyOrganizationListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyOrganizationList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyOrganizationListResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyOrganizationListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyOrganizationOrganizationId operation middleware
+func (sh *strictHandler) GetV1PlyOrganizationOrganizationId(w http.ResponseWriter, r *http.Request, organizationId string) {
+	var request GetV1PlyOrganizationOrganizationIdRequestObject
+
+	request.OrganizationId = organizationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyOrganizationOrganizationId(ctx, request.(GetV1PlyOrganizationOrganizationIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyOrganizationOrganizationId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyOrganizationOrganizationIdResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyOrganizationOrganizationIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyOrganizationOrganizationId operation middleware
+func (sh *strictHandler) PostV1PlyOrganizationOrganizationId(w http.ResponseWriter, r *http.Request, organizationId string) {
+	var request PostV1PlyOrganizationOrganizationIdRequestObject
+
+	request.OrganizationId = organizationId
+
+	var body PostV1PlyOrganizationOrganizationIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyOrganizationOrganizationId(ctx, request.(PostV1PlyOrganizationOrganizationIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyOrganizationOrganizationId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyOrganizationOrganizationIdResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyOrganizationOrganizationIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyOrganizationOrganizationIdEnrollment operation middleware
+func (sh *strictHandler) GetV1PlyOrganizationOrganizationIdEnrollment(w http.ResponseWriter, r *http.Request, organizationId string) {
+	var request GetV1PlyOrganizationOrganizationIdEnrollmentRequestObject
+
+	request.OrganizationId = organizationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyOrganizationOrganizationIdEnrollment(ctx, request.(GetV1PlyOrganizationOrganizationIdEnrollmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyOrganizationOrganizationIdEnrollment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyOrganizationOrganizationIdEnrollmentResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyOrganizationOrganizationIdEnrollmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyOrganizationOrganizationIdPractice operation middleware
+func (sh *strictHandler) GetV1PlyOrganizationOrganizationIdPractice(w http.ResponseWriter, r *http.Request, organizationId string) {
+	var request GetV1PlyOrganizationOrganizationIdPracticeRequestObject
+
+	request.OrganizationId = organizationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyOrganizationOrganizationIdPractice(ctx, request.(GetV1PlyOrganizationOrganizationIdPracticeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyOrganizationOrganizationIdPractice")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyOrganizationOrganizationIdPracticeResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyOrganizationOrganizationIdPracticeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyOrganizationOrganizationIdProvider operation middleware
+func (sh *strictHandler) GetV1PlyOrganizationOrganizationIdProvider(w http.ResponseWriter, r *http.Request, organizationId string) {
+	var request GetV1PlyOrganizationOrganizationIdProviderRequestObject
+
+	request.OrganizationId = organizationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyOrganizationOrganizationIdProvider(ctx, request.(GetV1PlyOrganizationOrganizationIdProviderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyOrganizationOrganizationIdProvider")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyOrganizationOrganizationIdProviderResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyOrganizationOrganizationIdProviderResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyOrganizationOrganizationIdTask operation middleware
+func (sh *strictHandler) GetV1PlyOrganizationOrganizationIdTask(w http.ResponseWriter, r *http.Request, organizationId string) {
+	var request GetV1PlyOrganizationOrganizationIdTaskRequestObject
+
+	request.OrganizationId = organizationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyOrganizationOrganizationIdTask(ctx, request.(GetV1PlyOrganizationOrganizationIdTaskRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyOrganizationOrganizationIdTask")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyOrganizationOrganizationIdTaskResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyOrganizationOrganizationIdTaskResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostV1PlyPasswordReset operation middleware
+func (sh *strictHandler) PostV1PlyPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyPasswordResetRequestObject
+
+	var body PostV1PlyPasswordResetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyPasswordReset(ctx, request.(PostV1PlyPasswordResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyPasswordReset")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyPasswordResetResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyPasswordResetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHttpHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHttpMiddlewareFunc
+// PostV1PlyPasswordResetConfirm operation middleware
+func (sh *strictHandler) PostV1PlyPasswordResetConfirm(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyPasswordResetConfirmRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var body PostV1PlyPasswordResetConfirmJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostV1PlyPasswordResetConfirm(ctx, request.(PostV1PlyPasswordResetConfirmRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostV1PlyPasswordResetConfirm")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostV1PlyPasswordResetConfirmResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyPasswordResetConfirmResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// DeleteV1PlyDocumentDocumentId operation middleware
-func (sh *strictHandler) DeleteV1PlyDocumentDocumentId(w http.ResponseWriter, r *http.Request, documentId string) {
-	var request DeleteV1PlyDocumentDocumentIdRequestObject
+// PostV1PlyPractice operation middleware
+func (sh *strictHandler) PostV1PlyPractice(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyPracticeRequestObject
 
-	request.DocumentId = documentId
+	var body PostV1PlyPracticeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteV1PlyDocumentDocumentId(ctx, request.(DeleteV1PlyDocumentDocumentIdRequestObject))
+		return sh.ssi.PostV1PlyPractice(ctx, request.(PostV1PlyPracticeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteV1PlyDocumentDocumentId")
+		handler = middleware(handler, "PostV1PlyPractice")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteV1PlyDocumentDocumentIdResponseObject); ok {
-		if err := validResponse.VisitDeleteV1PlyDocumentDocumentIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostV1PlyPracticeResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyPracticeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2217,25 +10877,49 @@ func (sh *strictHandler) DeleteV1PlyDocumentDocumentId(w http.ResponseWriter, r
 	}
 }
 
-// GetV1PlyDocumentDocumentId operation middleware
-func (sh *strictHandler) GetV1PlyDocumentDocumentId(w http.ResponseWriter, r *http.Request, documentId string) {
-	var request GetV1PlyDocumentDocumentIdRequestObject
+// GetV1PlyPracticeList operation middleware
+func (sh *strictHandler) GetV1PlyPracticeList(w http.ResponseWriter, r *http.Request) {
+	var request GetV1PlyPracticeListRequestObject
 
-	request.DocumentId = documentId
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyPracticeList(ctx, request.(GetV1PlyPracticeListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyPracticeList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyPracticeListResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyPracticeListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyPracticePracticeId operation middleware
+func (sh *strictHandler) GetV1PlyPracticePracticeId(w http.ResponseWriter, r *http.Request, practiceId string) {
+	var request GetV1PlyPracticePracticeIdRequestObject
+
+	request.PracticeId = practiceId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1PlyDocumentDocumentId(ctx, request.(GetV1PlyDocumentDocumentIdRequestObject))
+		return sh.ssi.GetV1PlyPracticePracticeId(ctx, request.(GetV1PlyPracticePracticeIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1PlyDocumentDocumentId")
+		handler = middleware(handler, "GetV1PlyPracticePracticeId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV1PlyDocumentDocumentIdResponseObject); ok {
-		if err := validResponse.VisitGetV1PlyDocumentDocumentIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV1PlyPracticePracticeIdResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyPracticePracticeIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2243,11 +10927,13 @@ func (sh *strictHandler) GetV1PlyDocumentDocumentId(w http.ResponseWriter, r *ht
 	}
 }
 
-// PostV1PlyEnrollment operation middleware
-func (sh *strictHandler) PostV1PlyEnrollment(w http.ResponseWriter, r *http.Request) {
-	var request PostV1PlyEnrollmentRequestObject
+// PostV1PlyPracticePracticeId operation middleware
+func (sh *strictHandler) PostV1PlyPracticePracticeId(w http.ResponseWriter, r *http.Request, practiceId string) {
+	var request PostV1PlyPracticePracticeIdRequestObject
 
-	var body PostV1PlyEnrollmentJSONRequestBody
+	request.PracticeId = practiceId
+
+	var body PostV1PlyPracticePracticeIdJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2255,18 +10941,18 @@ func (sh *strictHandler) PostV1PlyEnrollment(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostV1PlyEnrollment(ctx, request.(PostV1PlyEnrollmentRequestObject))
+		return sh.ssi.PostV1PlyPracticePracticeId(ctx, request.(PostV1PlyPracticePracticeIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostV1PlyEnrollment")
+		handler = middleware(handler, "PostV1PlyPracticePracticeId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostV1PlyEnrollmentResponseObject); ok {
-		if err := validResponse.VisitPostV1PlyEnrollmentResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostV1PlyPracticePracticeIdResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyPracticePracticeIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2274,25 +10960,26 @@ func (sh *strictHandler) PostV1PlyEnrollment(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// DeleteV1PlyEnrollmentEnrollmentId operation middleware
-func (sh *strictHandler) DeleteV1PlyEnrollmentEnrollmentId(w http.ResponseWriter, r *http.Request, enrollmentId string) {
-	var request DeleteV1PlyEnrollmentEnrollmentIdRequestObject
+// GetV1PlyPracticePracticeIdDocument operation middleware
+func (sh *strictHandler) GetV1PlyPracticePracticeIdDocument(w http.ResponseWriter, r *http.Request, practiceId string, params GetV1PlyPracticePracticeIdDocumentParams) {
+	var request GetV1PlyPracticePracticeIdDocumentRequestObject
 
-	request.EnrollmentId = enrollmentId
+	request.PracticeId = practiceId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteV1PlyEnrollmentEnrollmentId(ctx, request.(DeleteV1PlyEnrollmentEnrollmentIdRequestObject))
+		return sh.ssi.GetV1PlyPracticePracticeIdDocument(ctx, request.(GetV1PlyPracticePracticeIdDocumentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteV1PlyEnrollmentEnrollmentId")
+		handler = middleware(handler, "GetV1PlyPracticePracticeIdDocument")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteV1PlyEnrollmentEnrollmentIdResponseObject); ok {
-		if err := validResponse.VisitDeleteV1PlyEnrollmentEnrollmentIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV1PlyPracticePracticeIdDocumentResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyPracticePracticeIdDocumentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2300,25 +10987,26 @@ func (sh *strictHandler) DeleteV1PlyEnrollmentEnrollmentId(w http.ResponseWriter
 	}
 }
 
-// GetV1PlyEnrollmentEnrollmentId operation middleware
-func (sh *strictHandler) GetV1PlyEnrollmentEnrollmentId(w http.ResponseWriter, r *http.Request, enrollmentId string) {
-	var request GetV1PlyEnrollmentEnrollmentIdRequestObject
+// GetV1PlyPracticePracticeIdDocumentArchive operation middleware
+func (sh *strictHandler) GetV1PlyPracticePracticeIdDocumentArchive(w http.ResponseWriter, r *http.Request, practiceId string, params GetV1PlyPracticePracticeIdDocumentArchiveParams) {
+	var request GetV1PlyPracticePracticeIdDocumentArchiveRequestObject
 
-	request.EnrollmentId = enrollmentId
+	request.PracticeId = practiceId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1PlyEnrollmentEnrollmentId(ctx, request.(GetV1PlyEnrollmentEnrollmentIdRequestObject))
+		return sh.ssi.GetV1PlyPracticePracticeIdDocumentArchive(ctx, request.(GetV1PlyPracticePracticeIdDocumentArchiveRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1PlyEnrollmentEnrollmentId")
+		handler = middleware(handler, "GetV1PlyPracticePracticeIdDocumentArchive")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV1PlyEnrollmentEnrollmentIdResponseObject); ok {
-		if err := validResponse.VisitGetV1PlyEnrollmentEnrollmentIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV1PlyPracticePracticeIdDocumentArchiveResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyPracticePracticeIdDocumentArchiveResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2326,32 +11014,52 @@ func (sh *strictHandler) GetV1PlyEnrollmentEnrollmentId(w http.ResponseWriter, r
 	}
 }
 
-// PostV1PlyEnrollmentEnrollmentId operation middleware
-func (sh *strictHandler) PostV1PlyEnrollmentEnrollmentId(w http.ResponseWriter, r *http.Request, enrollmentId string) {
-	var request PostV1PlyEnrollmentEnrollmentIdRequestObject
+// GetV1PlyPracticePracticeIdDocumentSearch operation middleware
+func (sh *strictHandler) GetV1PlyPracticePracticeIdDocumentSearch(w http.ResponseWriter, r *http.Request, practiceId string, params GetV1PlyPracticePracticeIdDocumentSearchParams) {
+	var request GetV1PlyPracticePracticeIdDocumentSearchRequestObject
 
-	request.EnrollmentId = enrollmentId
+	request.PracticeId = practiceId
+	request.Params = params
 
-	var body PostV1PlyEnrollmentEnrollmentIdJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyPracticePracticeIdDocumentSearch(ctx, request.(GetV1PlyPracticePracticeIdDocumentSearchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyPracticePracticeIdDocumentSearch")
 	}
-	request.Body = &body
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyPracticePracticeIdDocumentSearchResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyPracticePracticeIdDocumentSearchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyPracticePracticeIdEnrollment operation middleware
+func (sh *strictHandler) GetV1PlyPracticePracticeIdEnrollment(w http.ResponseWriter, r *http.Request, practiceId string) {
+	var request GetV1PlyPracticePracticeIdEnrollmentRequestObject
+
+	request.PracticeId = practiceId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostV1PlyEnrollmentEnrollmentId(ctx, request.(PostV1PlyEnrollmentEnrollmentIdRequestObject))
+		return sh.ssi.GetV1PlyPracticePracticeIdEnrollment(ctx, request.(GetV1PlyPracticePracticeIdEnrollmentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostV1PlyEnrollmentEnrollmentId")
+		handler = middleware(handler, "GetV1PlyPracticePracticeIdEnrollment")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostV1PlyEnrollmentEnrollmentIdResponseObject); ok {
-		if err := validResponse.VisitPostV1PlyEnrollmentEnrollmentIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV1PlyPracticePracticeIdEnrollmentResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyPracticePracticeIdEnrollmentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2359,25 +11067,25 @@ func (sh *strictHandler) PostV1PlyEnrollmentEnrollmentId(w http.ResponseWriter,
 	}
 }
 
-// GetV1PlyEnrollmentEnrollmentIdActivity operation middleware
-func (sh *strictHandler) GetV1PlyEnrollmentEnrollmentIdActivity(w http.ResponseWriter, r *http.Request, enrollmentId string) {
-	var request GetV1PlyEnrollmentEnrollmentIdActivityRequestObject
+// GetV1PlyPracticePracticeIdEnrollmentDuplicates operation middleware
+func (sh *strictHandler) GetV1PlyPracticePracticeIdEnrollmentDuplicates(w http.ResponseWriter, r *http.Request, practiceId string) {
+	var request GetV1PlyPracticePracticeIdEnrollmentDuplicatesRequestObject
 
-	request.EnrollmentId = enrollmentId
+	request.PracticeId = practiceId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1PlyEnrollmentEnrollmentIdActivity(ctx, request.(GetV1PlyEnrollmentEnrollmentIdActivityRequestObject))
+		return sh.ssi.GetV1PlyPracticePracticeIdEnrollmentDuplicates(ctx, request.(GetV1PlyPracticePracticeIdEnrollmentDuplicatesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1PlyEnrollmentEnrollmentIdActivity")
+		handler = middleware(handler, "GetV1PlyPracticePracticeIdEnrollmentDuplicates")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV1PlyEnrollmentEnrollmentIdActivityResponseObject); ok {
-		if err := validResponse.VisitGetV1PlyEnrollmentEnrollmentIdActivityResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV1PlyPracticePracticeIdEnrollmentDuplicatesResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyPracticePracticeIdEnrollmentDuplicatesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2385,30 +11093,77 @@ func (sh *strictHandler) GetV1PlyEnrollmentEnrollmentIdActivity(w http.ResponseW
 	}
 }
 
-// PostV1PlyLocation operation middleware
-func (sh *strictHandler) PostV1PlyLocation(w http.ResponseWriter, r *http.Request) {
-	var request PostV1PlyLocationRequestObject
+// GetV1PlyPracticePracticeIdLocation operation middleware
+func (sh *strictHandler) GetV1PlyPracticePracticeIdLocation(w http.ResponseWriter, r *http.Request, practiceId string) {
+	var request GetV1PlyPracticePracticeIdLocationRequestObject
 
-	var body PostV1PlyLocationJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	request.PracticeId = practiceId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyPracticePracticeIdLocation(ctx, request.(GetV1PlyPracticePracticeIdLocationRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyPracticePracticeIdLocation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyPracticePracticeIdLocationResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyPracticePracticeIdLocationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyPracticePracticeIdProvider operation middleware
+func (sh *strictHandler) GetV1PlyPracticePracticeIdProvider(w http.ResponseWriter, r *http.Request, practiceId string) {
+	var request GetV1PlyPracticePracticeIdProviderRequestObject
+
+	request.PracticeId = practiceId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetV1PlyPracticePracticeIdProvider(ctx, request.(GetV1PlyPracticePracticeIdProviderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetV1PlyPracticePracticeIdProvider")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetV1PlyPracticePracticeIdProviderResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyPracticePracticeIdProviderResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetV1PlyPracticePracticeIdTask operation middleware
+func (sh *strictHandler) GetV1PlyPracticePracticeIdTask(w http.ResponseWriter, r *http.Request, practiceId string) {
+	var request GetV1PlyPracticePracticeIdTaskRequestObject
+
+	request.PracticeId = practiceId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostV1PlyLocation(ctx, request.(PostV1PlyLocationRequestObject))
+		return sh.ssi.GetV1PlyPracticePracticeIdTask(ctx, request.(GetV1PlyPracticePracticeIdTaskRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostV1PlyLocation")
+		handler = middleware(handler, "GetV1PlyPracticePracticeIdTask")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostV1PlyLocationResponseObject); ok {
-		if err := validResponse.VisitPostV1PlyLocationResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV1PlyPracticePracticeIdTaskResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyPracticePracticeIdTaskResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2416,25 +11171,32 @@ func (sh *strictHandler) PostV1PlyLocation(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// DeleteV1PlyLocationLocationId operation middleware
-func (sh *strictHandler) DeleteV1PlyLocationLocationId(w http.ResponseWriter, r *http.Request, locationId string) {
-	var request DeleteV1PlyLocationLocationIdRequestObject
+// PostV1PlyPracticePracticeIdUpload operation middleware
+func (sh *strictHandler) PostV1PlyPracticePracticeIdUpload(w http.ResponseWriter, r *http.Request, practiceId string) {
+	var request PostV1PlyPracticePracticeIdUploadRequestObject
 
-	request.LocationId = locationId
+	request.PracticeId = practiceId
+
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteV1PlyLocationLocationId(ctx, request.(DeleteV1PlyLocationLocationIdRequestObject))
+		return sh.ssi.PostV1PlyPracticePracticeIdUpload(ctx, request.(PostV1PlyPracticePracticeIdUploadRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteV1PlyLocationLocationId")
+		handler = middleware(handler, "PostV1PlyPracticePracticeIdUpload")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteV1PlyLocationLocationIdResponseObject); ok {
-		if err := validResponse.VisitDeleteV1PlyLocationLocationIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostV1PlyPracticePracticeIdUploadResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyPracticePracticeIdUploadResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2442,25 +11204,32 @@ func (sh *strictHandler) DeleteV1PlyLocationLocationId(w http.ResponseWriter, r
 	}
 }
 
-// GetV1PlyLocationLocationId operation middleware
-func (sh *strictHandler) GetV1PlyLocationLocationId(w http.ResponseWriter, r *http.Request, locationId string) {
-	var request GetV1PlyLocationLocationIdRequestObject
+// PostV1PlyPracticePracticeIdUploadResumable operation middleware
+func (sh *strictHandler) PostV1PlyPracticePracticeIdUploadResumable(w http.ResponseWriter, r *http.Request, practiceId string) {
+	var request PostV1PlyPracticePracticeIdUploadResumableRequestObject
 
-	request.LocationId = locationId
+	request.PracticeId = practiceId
+
+	var body PostV1PlyPracticePracticeIdUploadResumableJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1PlyLocationLocationId(ctx, request.(GetV1PlyLocationLocationIdRequestObject))
+		return sh.ssi.PostV1PlyPracticePracticeIdUploadResumable(ctx, request.(PostV1PlyPracticePracticeIdUploadResumableRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1PlyLocationLocationId")
+		handler = middleware(handler, "PostV1PlyPracticePracticeIdUploadResumable")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV1PlyLocationLocationIdResponseObject); ok {
-		if err := validResponse.VisitGetV1PlyLocationLocationIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostV1PlyPracticePracticeIdUploadResumableResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyPracticePracticeIdUploadResumableResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2468,32 +11237,25 @@ func (sh *strictHandler) GetV1PlyLocationLocationId(w http.ResponseWriter, r *ht
 	}
 }
 
-// PostV1PlyLocationLocationId operation middleware
-func (sh *strictHandler) PostV1PlyLocationLocationId(w http.ResponseWriter, r *http.Request, locationId string) {
-	var request PostV1PlyLocationLocationIdRequestObject
-
-	request.LocationId = locationId
+// GetV1PlyPracticePracticeIdUsage operation middleware
+func (sh *strictHandler) GetV1PlyPracticePracticeIdUsage(w http.ResponseWriter, r *http.Request, practiceId string) {
+	var request GetV1PlyPracticePracticeIdUsageRequestObject
 
-	var body PostV1PlyLocationLocationIdJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.PracticeId = practiceId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostV1PlyLocationLocationId(ctx, request.(PostV1PlyLocationLocationIdRequestObject))
+		return sh.ssi.GetV1PlyPracticePracticeIdUsage(ctx, request.(GetV1PlyPracticePracticeIdUsageRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostV1PlyLocationLocationId")
+		handler = middleware(handler, "GetV1PlyPracticePracticeIdUsage")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostV1PlyLocationLocationIdResponseObject); ok {
-		if err := validResponse.VisitPostV1PlyLocationLocationIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV1PlyPracticePracticeIdUsageResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyPracticePracticeIdUsageResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2501,11 +11263,11 @@ func (sh *strictHandler) PostV1PlyLocationLocationId(w http.ResponseWriter, r *h
 	}
 }
 
-// PostV1PlyPractice operation middleware
-func (sh *strictHandler) PostV1PlyPractice(w http.ResponseWriter, r *http.Request) {
-	var request PostV1PlyPracticeRequestObject
+// PostV1PlyProvider operation middleware
+func (sh *strictHandler) PostV1PlyProvider(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyProviderRequestObject
 
-	var body PostV1PlyPracticeJSONRequestBody
+	var body PostV1PlyProviderJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2513,18 +11275,18 @@ func (sh *strictHandler) PostV1PlyPractice(w http.ResponseWriter, r *http.Reques
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostV1PlyPractice(ctx, request.(PostV1PlyPracticeRequestObject))
+		return sh.ssi.PostV1PlyProvider(ctx, request.(PostV1PlyProviderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostV1PlyPractice")
+		handler = middleware(handler, "PostV1PlyProvider")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostV1PlyPracticeResponseObject); ok {
-		if err := validResponse.VisitPostV1PlyPracticeResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostV1PlyProviderResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyProviderResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2532,23 +11294,25 @@ func (sh *strictHandler) PostV1PlyPractice(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetV1PlyPracticeList operation middleware
-func (sh *strictHandler) GetV1PlyPracticeList(w http.ResponseWriter, r *http.Request) {
-	var request GetV1PlyPracticeListRequestObject
+// DeleteV1PlyProviderProviderId operation middleware
+func (sh *strictHandler) DeleteV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request, providerId string) {
+	var request DeleteV1PlyProviderProviderIdRequestObject
+
+	request.ProviderId = providerId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1PlyPracticeList(ctx, request.(GetV1PlyPracticeListRequestObject))
+		return sh.ssi.DeleteV1PlyProviderProviderId(ctx, request.(DeleteV1PlyProviderProviderIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1PlyPracticeList")
+		handler = middleware(handler, "DeleteV1PlyProviderProviderId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV1PlyPracticeListResponseObject); ok {
-		if err := validResponse.VisitGetV1PlyPracticeListResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteV1PlyProviderProviderIdResponseObject); ok {
+		if err := validResponse.VisitDeleteV1PlyProviderProviderIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2556,25 +11320,25 @@ func (sh *strictHandler) GetV1PlyPracticeList(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// GetV1PlyPracticePracticeId operation middleware
-func (sh *strictHandler) GetV1PlyPracticePracticeId(w http.ResponseWriter, r *http.Request, practiceId string) {
-	var request GetV1PlyPracticePracticeIdRequestObject
+// GetV1PlyProviderProviderId operation middleware
+func (sh *strictHandler) GetV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request, providerId string) {
+	var request GetV1PlyProviderProviderIdRequestObject
 
-	request.PracticeId = practiceId
+	request.ProviderId = providerId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1PlyPracticePracticeId(ctx, request.(GetV1PlyPracticePracticeIdRequestObject))
+		return sh.ssi.GetV1PlyProviderProviderId(ctx, request.(GetV1PlyProviderProviderIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1PlyPracticePracticeId")
+		handler = middleware(handler, "GetV1PlyProviderProviderId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV1PlyPracticePracticeIdResponseObject); ok {
-		if err := validResponse.VisitGetV1PlyPracticePracticeIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV1PlyProviderProviderIdResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyProviderProviderIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2582,13 +11346,13 @@ func (sh *strictHandler) GetV1PlyPracticePracticeId(w http.ResponseWriter, r *ht
 	}
 }
 
-// PostV1PlyPracticePracticeId operation middleware
-func (sh *strictHandler) PostV1PlyPracticePracticeId(w http.ResponseWriter, r *http.Request, practiceId string) {
-	var request PostV1PlyPracticePracticeIdRequestObject
+// PostV1PlyProviderProviderId operation middleware
+func (sh *strictHandler) PostV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request, providerId string) {
+	var request PostV1PlyProviderProviderIdRequestObject
 
-	request.PracticeId = practiceId
+	request.ProviderId = providerId
 
-	var body PostV1PlyPracticePracticeIdJSONRequestBody
+	var body PostV1PlyProviderProviderIdJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2596,18 +11360,18 @@ func (sh *strictHandler) PostV1PlyPracticePracticeId(w http.ResponseWriter, r *h
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostV1PlyPracticePracticeId(ctx, request.(PostV1PlyPracticePracticeIdRequestObject))
+		return sh.ssi.PostV1PlyProviderProviderId(ctx, request.(PostV1PlyProviderProviderIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostV1PlyPracticePracticeId")
+		handler = middleware(handler, "PostV1PlyProviderProviderId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostV1PlyPracticePracticeIdResponseObject); ok {
-		if err := validResponse.VisitPostV1PlyPracticePracticeIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostV1PlyProviderProviderIdResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyProviderProviderIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2615,25 +11379,25 @@ func (sh *strictHandler) PostV1PlyPracticePracticeId(w http.ResponseWriter, r *h
 	}
 }
 
-// GetV1PlyPracticePracticeIdDocument operation middleware
-func (sh *strictHandler) GetV1PlyPracticePracticeIdDocument(w http.ResponseWriter, r *http.Request, practiceId string) {
-	var request GetV1PlyPracticePracticeIdDocumentRequestObject
+// GetV1PlyProviderProviderIdAffiliation operation middleware
+func (sh *strictHandler) GetV1PlyProviderProviderIdAffiliation(w http.ResponseWriter, r *http.Request, providerId string) {
+	var request GetV1PlyProviderProviderIdAffiliationRequestObject
 
-	request.PracticeId = practiceId
+	request.ProviderId = providerId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1PlyPracticePracticeIdDocument(ctx, request.(GetV1PlyPracticePracticeIdDocumentRequestObject))
+		return sh.ssi.GetV1PlyProviderProviderIdAffiliation(ctx, request.(GetV1PlyProviderProviderIdAffiliationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1PlyPracticePracticeIdDocument")
+		handler = middleware(handler, "GetV1PlyProviderProviderIdAffiliation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV1PlyPracticePracticeIdDocumentResponseObject); ok {
-		if err := validResponse.VisitGetV1PlyPracticePracticeIdDocumentResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV1PlyProviderProviderIdAffiliationResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyProviderProviderIdAffiliationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2641,25 +11405,32 @@ func (sh *strictHandler) GetV1PlyPracticePracticeIdDocument(w http.ResponseWrite
 	}
 }
 
-// GetV1PlyPracticePracticeIdEnrollment operation middleware
-func (sh *strictHandler) GetV1PlyPracticePracticeIdEnrollment(w http.ResponseWriter, r *http.Request, practiceId string) {
-	var request GetV1PlyPracticePracticeIdEnrollmentRequestObject
+// PostV1PlyProviderProviderIdAffiliation operation middleware
+func (sh *strictHandler) PostV1PlyProviderProviderIdAffiliation(w http.ResponseWriter, r *http.Request, providerId string) {
+	var request PostV1PlyProviderProviderIdAffiliationRequestObject
 
-	request.PracticeId = practiceId
+	request.ProviderId = providerId
+
+	var body PostV1PlyProviderProviderIdAffiliationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1PlyPracticePracticeIdEnrollment(ctx, request.(GetV1PlyPracticePracticeIdEnrollmentRequestObject))
+		return sh.ssi.PostV1PlyProviderProviderIdAffiliation(ctx, request.(PostV1PlyProviderProviderIdAffiliationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1PlyPracticePracticeIdEnrollment")
+		handler = middleware(handler, "PostV1PlyProviderProviderIdAffiliation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV1PlyPracticePracticeIdEnrollmentResponseObject); ok {
-		if err := validResponse.VisitGetV1PlyPracticePracticeIdEnrollmentResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostV1PlyProviderProviderIdAffiliationResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyProviderProviderIdAffiliationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2667,25 +11438,25 @@ func (sh *strictHandler) GetV1PlyPracticePracticeIdEnrollment(w http.ResponseWri
 	}
 }
 
-// GetV1PlyPracticePracticeIdLocation operation middleware
-func (sh *strictHandler) GetV1PlyPracticePracticeIdLocation(w http.ResponseWriter, r *http.Request, practiceId string) {
-	var request GetV1PlyPracticePracticeIdLocationRequestObject
+// GetV1PlyReportRevalidation operation middleware
+func (sh *strictHandler) GetV1PlyReportRevalidation(w http.ResponseWriter, r *http.Request, params GetV1PlyReportRevalidationParams) {
+	var request GetV1PlyReportRevalidationRequestObject
 
-	request.PracticeId = practiceId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1PlyPracticePracticeIdLocation(ctx, request.(GetV1PlyPracticePracticeIdLocationRequestObject))
+		return sh.ssi.GetV1PlyReportRevalidation(ctx, request.(GetV1PlyReportRevalidationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1PlyPracticePracticeIdLocation")
+		handler = middleware(handler, "GetV1PlyReportRevalidation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV1PlyPracticePracticeIdLocationResponseObject); ok {
-		if err := validResponse.VisitGetV1PlyPracticePracticeIdLocationResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV1PlyReportRevalidationResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyReportRevalidationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2693,25 +11464,26 @@ func (sh *strictHandler) GetV1PlyPracticePracticeIdLocation(w http.ResponseWrite
 	}
 }
 
-// GetV1PlyPracticePracticeIdProvider operation middleware
-func (sh *strictHandler) GetV1PlyPracticePracticeIdProvider(w http.ResponseWriter, r *http.Request, practiceId string) {
-	var request GetV1PlyPracticePracticeIdProviderRequestObject
+// GetV1PlySharedLinkId operation middleware
+func (sh *strictHandler) GetV1PlySharedLinkId(w http.ResponseWriter, r *http.Request, linkId string, params GetV1PlySharedLinkIdParams) {
+	var request GetV1PlySharedLinkIdRequestObject
 
-	request.PracticeId = practiceId
+	request.LinkId = linkId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1PlyPracticePracticeIdProvider(ctx, request.(GetV1PlyPracticePracticeIdProviderRequestObject))
+		return sh.ssi.GetV1PlySharedLinkId(ctx, request.(GetV1PlySharedLinkIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1PlyPracticePracticeIdProvider")
+		handler = middleware(handler, "GetV1PlySharedLinkId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV1PlyPracticePracticeIdProviderResponseObject); ok {
-		if err := validResponse.VisitGetV1PlyPracticePracticeIdProviderResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV1PlySharedLinkIdResponseObject); ok {
+		if err := validResponse.VisitGetV1PlySharedLinkIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2719,25 +11491,32 @@ func (sh *strictHandler) GetV1PlyPracticePracticeIdProvider(w http.ResponseWrite
 	}
 }
 
-// GetV1PlyPracticePracticeIdTask operation middleware
-func (sh *strictHandler) GetV1PlyPracticePracticeIdTask(w http.ResponseWriter, r *http.Request, practiceId string) {
-	var request GetV1PlyPracticePracticeIdTaskRequestObject
+// PostV1PlyTaskTaskId operation middleware
+func (sh *strictHandler) PostV1PlyTaskTaskId(w http.ResponseWriter, r *http.Request, taskId string) {
+	var request PostV1PlyTaskTaskIdRequestObject
 
-	request.PracticeId = practiceId
+	request.TaskId = taskId
+
+	var body PostV1PlyTaskTaskIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1PlyPracticePracticeIdTask(ctx, request.(GetV1PlyPracticePracticeIdTaskRequestObject))
+		return sh.ssi.PostV1PlyTaskTaskId(ctx, request.(PostV1PlyTaskTaskIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1PlyPracticePracticeIdTask")
+		handler = middleware(handler, "PostV1PlyTaskTaskId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV1PlyPracticePracticeIdTaskResponseObject); ok {
-		if err := validResponse.VisitGetV1PlyPracticePracticeIdTaskResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostV1PlyTaskTaskIdResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyTaskTaskIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2745,32 +11524,25 @@ func (sh *strictHandler) GetV1PlyPracticePracticeIdTask(w http.ResponseWriter, r
 	}
 }
 
-// PostV1PlyPracticePracticeIdUpload operation middleware
-func (sh *strictHandler) PostV1PlyPracticePracticeIdUpload(w http.ResponseWriter, r *http.Request, practiceId string) {
-	var request PostV1PlyPracticePracticeIdUploadRequestObject
-
-	request.PracticeId = practiceId
+// DeleteV1PlyUploadUploadId operation middleware
+func (sh *strictHandler) DeleteV1PlyUploadUploadId(w http.ResponseWriter, r *http.Request, uploadId string) {
+	var request DeleteV1PlyUploadUploadIdRequestObject
 
-	if reader, err := r.MultipartReader(); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
-		return
-	} else {
-		request.Body = reader
-	}
+	request.UploadId = uploadId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostV1PlyPracticePracticeIdUpload(ctx, request.(PostV1PlyPracticePracticeIdUploadRequestObject))
+		return sh.ssi.DeleteV1PlyUploadUploadId(ctx, request.(DeleteV1PlyUploadUploadIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostV1PlyPracticePracticeIdUpload")
+		handler = middleware(handler, "DeleteV1PlyUploadUploadId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostV1PlyPracticePracticeIdUploadResponseObject); ok {
-		if err := validResponse.VisitPostV1PlyPracticePracticeIdUploadResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteV1PlyUploadUploadIdResponseObject); ok {
+		if err := validResponse.VisitDeleteV1PlyUploadUploadIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2778,30 +11550,25 @@ func (sh *strictHandler) PostV1PlyPracticePracticeIdUpload(w http.ResponseWriter
 	}
 }
 
-// PostV1PlyProvider operation middleware
-func (sh *strictHandler) PostV1PlyProvider(w http.ResponseWriter, r *http.Request) {
-	var request PostV1PlyProviderRequestObject
+// GetV1PlyUploadUploadId operation middleware
+func (sh *strictHandler) GetV1PlyUploadUploadId(w http.ResponseWriter, r *http.Request, uploadId string) {
+	var request GetV1PlyUploadUploadIdRequestObject
 
-	var body PostV1PlyProviderJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.UploadId = uploadId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostV1PlyProvider(ctx, request.(PostV1PlyProviderRequestObject))
+		return sh.ssi.GetV1PlyUploadUploadId(ctx, request.(GetV1PlyUploadUploadIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostV1PlyProvider")
+		handler = middleware(handler, "GetV1PlyUploadUploadId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostV1PlyProviderResponseObject); ok {
-		if err := validResponse.VisitPostV1PlyProviderResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetV1PlyUploadUploadIdResponseObject); ok {
+		if err := validResponse.VisitGetV1PlyUploadUploadIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2809,25 +11576,28 @@ func (sh *strictHandler) PostV1PlyProvider(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// DeleteV1PlyProviderProviderId operation middleware
-func (sh *strictHandler) DeleteV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request, providerId string) {
-	var request DeleteV1PlyProviderProviderIdRequestObject
+// PatchV1PlyUploadUploadId operation middleware
+func (sh *strictHandler) PatchV1PlyUploadUploadId(w http.ResponseWriter, r *http.Request, uploadId string, params PatchV1PlyUploadUploadIdParams) {
+	var request PatchV1PlyUploadUploadIdRequestObject
 
-	request.ProviderId = providerId
+	request.UploadId = uploadId
+	request.Params = params
+
+	request.Body = r.Body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteV1PlyProviderProviderId(ctx, request.(DeleteV1PlyProviderProviderIdRequestObject))
+		return sh.ssi.PatchV1PlyUploadUploadId(ctx, request.(PatchV1PlyUploadUploadIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteV1PlyProviderProviderId")
+		handler = middleware(handler, "PatchV1PlyUploadUploadId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteV1PlyProviderProviderIdResponseObject); ok {
-		if err := validResponse.VisitDeleteV1PlyProviderProviderIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(PatchV1PlyUploadUploadIdResponseObject); ok {
+		if err := validResponse.VisitPatchV1PlyUploadUploadIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2835,25 +11605,25 @@ func (sh *strictHandler) DeleteV1PlyProviderProviderId(w http.ResponseWriter, r
 	}
 }
 
-// GetV1PlyProviderProviderId operation middleware
-func (sh *strictHandler) GetV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request, providerId string) {
-	var request GetV1PlyProviderProviderIdRequestObject
+// PostV1PlyUploadUploadIdFinalize operation middleware
+func (sh *strictHandler) PostV1PlyUploadUploadIdFinalize(w http.ResponseWriter, r *http.Request, uploadId string) {
+	var request PostV1PlyUploadUploadIdFinalizeRequestObject
 
-	request.ProviderId = providerId
+	request.UploadId = uploadId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetV1PlyProviderProviderId(ctx, request.(GetV1PlyProviderProviderIdRequestObject))
+		return sh.ssi.PostV1PlyUploadUploadIdFinalize(ctx, request.(PostV1PlyUploadUploadIdFinalizeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetV1PlyProviderProviderId")
+		handler = middleware(handler, "PostV1PlyUploadUploadIdFinalize")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetV1PlyProviderProviderIdResponseObject); ok {
-		if err := validResponse.VisitGetV1PlyProviderProviderIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostV1PlyUploadUploadIdFinalizeResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyUploadUploadIdFinalizeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2861,13 +11631,11 @@ func (sh *strictHandler) GetV1PlyProviderProviderId(w http.ResponseWriter, r *ht
 	}
 }
 
-// PostV1PlyProviderProviderId operation middleware
-func (sh *strictHandler) PostV1PlyProviderProviderId(w http.ResponseWriter, r *http.Request, providerId string) {
-	var request PostV1PlyProviderProviderIdRequestObject
-
-	request.ProviderId = providerId
+// PostV1PlyUserInvitation operation middleware
+func (sh *strictHandler) PostV1PlyUserInvitation(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyUserInvitationRequestObject
 
-	var body PostV1PlyProviderProviderIdJSONRequestBody
+	var body PostV1PlyUserInvitationJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2875,18 +11643,18 @@ func (sh *strictHandler) PostV1PlyProviderProviderId(w http.ResponseWriter, r *h
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostV1PlyProviderProviderId(ctx, request.(PostV1PlyProviderProviderIdRequestObject))
+		return sh.ssi.PostV1PlyUserInvitation(ctx, request.(PostV1PlyUserInvitationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostV1PlyProviderProviderId")
+		handler = middleware(handler, "PostV1PlyUserInvitation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostV1PlyProviderProviderIdResponseObject); ok {
-		if err := validResponse.VisitPostV1PlyProviderProviderIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostV1PlyUserInvitationResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyUserInvitationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2894,13 +11662,11 @@ func (sh *strictHandler) PostV1PlyProviderProviderId(w http.ResponseWriter, r *h
 	}
 }
 
-// PostV1PlyTaskTaskId operation middleware
-func (sh *strictHandler) PostV1PlyTaskTaskId(w http.ResponseWriter, r *http.Request, taskId string) {
-	var request PostV1PlyTaskTaskIdRequestObject
-
-	request.TaskId = taskId
+// PostV1PlyUserInvitationAccept operation middleware
+func (sh *strictHandler) PostV1PlyUserInvitationAccept(w http.ResponseWriter, r *http.Request) {
+	var request PostV1PlyUserInvitationAcceptRequestObject
 
-	var body PostV1PlyTaskTaskIdJSONRequestBody
+	var body PostV1PlyUserInvitationAcceptJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2908,18 +11674,18 @@ func (sh *strictHandler) PostV1PlyTaskTaskId(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostV1PlyTaskTaskId(ctx, request.(PostV1PlyTaskTaskIdRequestObject))
+		return sh.ssi.PostV1PlyUserInvitationAccept(ctx, request.(PostV1PlyUserInvitationAcceptRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostV1PlyTaskTaskId")
+		handler = middleware(handler, "PostV1PlyUserInvitationAccept")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostV1PlyTaskTaskIdResponseObject); ok {
-		if err := validResponse.VisitPostV1PlyTaskTaskIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostV1PlyUserInvitationAcceptResponseObject); ok {
+		if err := validResponse.VisitPostV1PlyUserInvitationAcceptResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2930,32 +11696,173 @@ func (sh *strictHandler) PostV1PlyTaskTaskId(w http.ResponseWriter, r *http.Requ
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8xaX2/bNhD/KgS3p8KL0hZ78Vs3B12AYjCydi9FUTDS2WEniypJuTAMffeBkiiSFm1R",
-	"rpToLbFOx7vf7/6R4hHHbJezDDIp8PKIc8LJDiTw6r+ExcUOMnmfVP+BiDnNJWUZXuKPT4CKjH4vANEE",
-	"Mkk3FDhiGySfAOkX8QJTJZwT+YQXOCM7wEtb7QJz+F5QDgleSl7AAov4CXZErScPuZIWktNsi8tygSHj",
-	"LE2NRR7djsgw7SmLiXLurG5LYJjmnJNY0hjOarYEhmpme5oAv6C5FRimWRLx31mtzcMhGkslLHKWCahj",
-	"CzakSKX6M2aZVMGyPGKS5ymtUY6+CRVoR0vnrxw2eIl/iUzQRvVTEQlJZCHqhdxIXdUrIb08LlVUSuAZ",
-	"Sf8Bvgd+xznjo1kClTaPIffNoqheFd01ghq2ChcVCHsqD1U2cpYDlxScJzUrJ/h2k6MjsAMhyBbO0F3/",
-	"wh6/QSyVdJvCHTvcqtBZZkNT+FrHieepmwqdx0IyTrbwtQq3MEON311TezFxc75rLTkAv8YPNy89bhIJ",
-	"Z58Uwvuo/iEMEx3QLhwxSyoNG8Z3ROKlSoO3b3CrQGXFFnhvsJis/1zrNPJfPNZokD0hnSQchLiKmksE",
-	"+DDRL3iihGbeJc4GMfuRAb8yxv2m1dHSNe3aPOqLP5EFmmbi0TXsbJz6lKh20VVxPsQCysT5LGn7VoBh",
-	"RZ4ykjzA9wKEp3yoUuaffHQNREoESYZqTXhhcuuRZoQfTHK5FfLvhtiuasbplqouobjXA1W1zCPQbNus",
-	"BElX80lmVtZbq3VTs6w64YZVaFGpnMXr9ID+ApLKJ/RufY8XeA9c1Na9vrm9ua3iP4eM5BQv8dvqp0U1",
-	"HlSQRfvXUZ4eIg1QdDTtoqwdTqGufQrpNsPxqvr939fr9LBq3ljZc6I9mX72N2AjElk9qvxyMnm8ub09",
-	"18JbuUiPJ+UC/x4i7xsoqs5e7HYqDLSDiJjhuFzgLchuFDyA5BT2tix6PCAqBbpf3aAHkAXPRBUXJJYF",
-	"SevwaIaXG7w4gfY9yGfE1ZqhXkWv3LGpNzk805uTaVr5eLS8B3mC8/2qEtGRfDJfsLpQuAivmaghvjPC",
-	"dTKCkH+w5DDecGkWKN2EVwN42UvJ5ZUHTU+lt5647Bk8UMyBSEhGpO7PSiMiGXJQ8VEXHW1nQguRsf7O",
-	"3VYOSxoHx9mVIxu7tiD5C8hz4jFJtrjByYEkJ+6PBO6D0nwKbXDpGBvcmVSh547wT3lCOhEeUh0iew9+",
-	"RUK806+/bGJ4Tw+a/6iEnehjuYXBVHrCOTmElf4PVEg1vloLj8dtpdzWbPHqbDcv59wHLTpNmrSWTN6q",
-	"L26Wg9hqFEzYppEFR5et6Gh8CO3P2ugP9sHssJSzgJtdZzZ49fTl58FhgpTw9GPb6XG7sa05sC6MCegs",
-	"ysuL9WBv7jtHcpcZWWvRaaBsLZm8Ug88HOxmiUZiykptwdFlK0ppTdXFmqTNVJ0aT4Jg+CBj3JnnIGM8",
-	"8uJ9NDFTBuO+tj8pDitdVohO2gvcpPP0Apu3cXuBrTmw8owJ6CwK2Iv1gsvVxY72yP4KOTDsV+YOwsuF",
-	"v//LaXjlss+Kr69cZt2RC5eluJdK9xx1IJnOuepc6DQehRPqHgldT6m99sikOqp7abV32wNJtXbfc6FU",
-	"exNOqL2ruJ5Os+7IZFqKe6m0P0MPpHKtX50PldqbIWNi48RPUWnWHX1KbBX3Uqm/uA+k8aN6bT4UKi/C",
-	"6at8/inq6vVGpq1R2ktZc4PA2gq7Rn6qntvfKkmWICIEi6maq6hEP6h8QgSJHGK6obEZtBbhw+0nfZFh",
-	"ohF3V6SS5oTLaMP47reESBI+5br3NSbfq1+86RYSWO3H6/H36t1w2DB+fri2Lhj1bXfaSj7NPkXX2OkP",
-	"Wi5cgQo7aKkVTHvQ0sLRZUvVB+1D6JG4Nnpt3/sdmsrtq7M7ErdbdE9vew4cJkgJ7zGIcXrsYxCjObAu",
-	"jAnoLMrLCx6DeHJfTQvRsb7EWAaUazWxfdS38YfR0dyUnIqKehybPw3azvL/AAAA//+oI2nqDDMAAA==",
+	"H4sIAAAAAAAC/+x9a2/ctrboXyHmXqDAwdjjpNm92CnuB+88unOah4+d7B7cnSKgpTUzPNGQKknZmQb+",
+	"7xeLD4mSqBlpLNlO2y9tPJL4WC+uF9f6OkvEJhccuFazp19nOZV0Axqk+YsulyxjVDPBX6X4A+Ozp7Oc",
+	"6vVsPuN0A7OnjXfmMwm/FUxCOnuqZQHzmUrWsKH4sd7m+IHSkvHV7OZmPktFUmyAazt4CiqRLMeRZk9n",
+	"79dACs5+K4CwFLhmSwaSiCXRayD+w9k8tqRg2GHrAS5FllUrioxde2XY6CtJdwzsnw4bM2P8c+eQ7uHA",
+	"EUWyG+HBC8NGFnJFOft99+iNl4bNkEuaaJZA5+jBC0NHFlcsBblj5PKFYSNLylfQJv9TohhfZUAutxqI",
+	"eWlOlkIS+EI3eQbk4wyfqP97cvTo5PH3H2eeFdZAU5DVws7N+LvXoKnqpiL3cNiuijwTNB3E15RIUMWG",
+	"XmZA7Odx7i6HPmRF75ZLBTq+KgNpYV7wciZZF/wzYdz8sWQZzMn1miVrsimUJvBbQTPzyA7+nSJJISVw",
+	"7YbpwskH8/rRO/9S9z6WQm6onj2dMa5/eDKb+40xrmEF0u5M7aBL93AYrK5AKgOXGJjcQ8KLzaXH3G55",
+	"7IfrsYhqX8gaoHLBFZiz6JKm5/BbAcpgLxFc43x4SOV5xqxIWvyPsquuxv3fEpazp7P/tajOuYV9qhYg",
+	"pXBT1bf5D5oS6Sa7meNky4wl401cDhiZ+1n5DJ8saZGNN6/SVBcqNutzOxPxIMfZl0JesjQFPj3AX5ZT",
+	"4TkpOEw/5U84yw3SqyqWS5Yw4PpCC0lXdzD5q2BS4mc1i9EgOc0uQF6BfGE+v4PF2EmJnZXYaW/mMy70",
+	"S1HwdPolvBWa2KnwrKVbFI/vhXhN5V1g48xOSN4LQeyUN/OZAnnFEvjA6RVlGZ5K0y/kws5JwknxeBbi",
+	"DeVbJ/7U9OtAQGwo33ohqHAVBaeFXgvJfoc7oIgP4WxmdlXkuZAa0jeQMvrenBfTr6KclZhpiZkXX3Tf",
+	"mokTza6Y3uK/cylykJpB7cmrNDjj/EHbtjdaL2xAKSeQIlqb/UVc/g/Y4yIwxSJLadpykdWkz6mGmtqR",
+	"4g/z9rt1TTvyOFSXW4+lyCD6QGkqdc9FxCAQntT17ScihaZC9f3jiEI1n8EXpjTjqy7ttW2OSkiETIle",
+	"U038EhS5ZnrtHltVYj4QwZW29G+7/ur9XyObL5WwyOYNk3zSjmnae9oY4sbnJAUNCRL8UopNqfgSN0Zs",
+	"E07p/VcfrfF6LZQbMTTkveKcbQkKXlBRzNT9Ba11+Mfvo9t8x1G7Jx9n13//OJuTj7OMJcAV2D8Swdw/",
+	"ruz/LwWV6acEgbh0UsU+yOkW5KcMtAZpf0mBfpwRIcnHmdBr/HU2P4DZ4UvOpJmoNxvCF42MCOlLBllq",
+	"cM00bNRegVf7blaxEpWSboORmeAXVmnshmcOPGV8ZWEhC87LP3DmDDSkHjxLyjL860cCm1xvyfUaOKmm",
+	"IkyRlCk899LYdpFsPlmjIgK/uudiuMiCKwbXp+FpX9/wL2tA7BJK3LulleiMPk/jCeXkEmn7mqNeEe7l",
+	"UogMKO8hI1VC+QVbcaoL2cW0NLumEsgSNSe0UiknjC8d97IsSjJm3H0oTXCRFod+QPuXOSk9NrUQnzLU",
+	"lz7OrG8C51TE/CRRGlq7GWfkCLckgVwrb0LjyrnQ7nFK6IoyXiOM4OkxecezLTHrctO0oIzjZm46xldI",
+	"TMANLR1HAbGmj//2QxsI/4QvR8BR3qbk4p+nR4//9oNH9D5BqNjv0Mtqn8+UVfo/GUu53/Huxdsb0DSl",
+	"mraX/iyjSpXiqm6aE8rT4LBShGnilBt1TD7kKcUzj0jIM5qAIjTL3LaNvEbxgmCsHyx/Pom7V8jsYOpd",
+	"SL0AKpP1OShn78fhvE+ul++hakF1soYIl/9TXFvtXrMNKMuiZnaiQW4UEUlSSO/08iN+p4iGLzpOy5zl",
+	"ecyr9s/3b14TUAnNISXwJQGZ66YL3w1stSWgyTpcDLmWNMePGScfi5OT75MNlZ/Nv4BoulL91EI/179A",
+	"lqQVVdYLml2UYqFTv+imJiMpd4zQre7tPZ/UPpm9YUoFJ6+URa49kzixPRhaKgqoidXJPWDerQT8dWz+",
+	"oY9N62iH9FS3pPURirPY8IE3O+I9b5N/Yd0I8KI8Pn6SosjbfFCdLwM07/KbmNYtgaraSnfxaTBUW5bl",
+	"eBLRrL8lsUR6ZVfQ/4t9p+sKofai8VbL72u5L2VXLMVoSjXq3NCOGST4lTCtyCVwWOI/kBkQZoqtkBe0",
+	"iC30M+ORqV8wo8x/tMv0vFmtBI0UysOZ8YQShSaCAzJJ9eoxOW2vE9lJaJKsMf5GcA2O0aKbVUTCEiTw",
+	"BAjTyHjAiw0a/mZgE1fxn81+bW5yPvtytBJHg5UV1Ku6n5w5XeatCfQcZlPtNHFyTXn0gYQrmrHUamZF",
+	"f5JU2r254/RsPyouN0xr6O/88udeHx71LvyDvVFjeogaZn9MamQMQmQF9io6tliKFFoHkiguwyPThgUN",
+	"38E2fgBn9BJs3NQtSEh7GM8JVSSXuPuUCN5M9YiQaQ0wAdQ0HqYdcV7nrTM2jZniimaFYWlVrFagjBog",
+	"5JwIf657Oj5GtcPZJP4npbyV4nih9pL7CRj3Eqb8TVxzkJ/sy7XzG+WSWQ4XfkU2eto+2XDhfSmx9LJ0",
+	"mxedGtfIziU1gUupn25bQeE0z7NtEFGuQ6TacuPU8HtzJjHRgiQi3xLBtWjaNZ5GcKEe7bN5BcT6lJ3s",
+	"8hm2xpQvPWV+9qhs6k34gijQPxIXY1bErd88/S7kBTfkLvpr5q4kQkqrT1ve0oKgbNkSxpUGmnrNsNqQ",
+	"4HEXfyjhEEIxsVYnr8ZHDpOx7xi/YrrDGIQNZVmUGzpNj3Z+UxsF4TtEYLTVOsQ9eVhzXIrMCACTExZ3",
+	"hdZP3vZE/nlsPDNxbFAfl2kPZ0bQwo5Qk4x2mk/oD/XemfJHSJkW0jOukCnjVFeGEE03KBf34t3iwi1v",
+	"NyJPjbFE3SFVR2kn4nKq1LWQceGnxWfoiGmYR5VdW62C+BXv3pcdOpg/tjevw0VO6zSVoNTovvCb6CpW",
+	"bBCX7IBpB3Z3QmEDQyY3VNr/wEK6+gk/iZ1Ve6yHQkFJzy5lIO6M7PZYFHaXURJzDy3/2W0Zo2dDU+gw",
+	"d3BF+3Zs3okierOkz0QagbZXWdtpil9IylZME3yj4gac4jtFMIoPXKNBjbZens8RVk5+6DUwaY6lK5Bb",
+	"M4DayzVmIb/Gl/5ih0lsTmPFBOoSHySL7IUToXNcMPlw/soZZ+gRsR7SRIKek0IVNMMo5Vpcc1RXKfmv",
+	"c+J07zZuzVcd6YZUwfePifeZvH/3/qycBb1Cepvj5Izj4cnbgOyn8GyW9HWcd+8PoWZV7/fJ1UysVhYA",
+	"1jdMiZcQRIIuJId0L62U88x3ks2ZyFgSSd+AL6iWu6yciDb4GrQip2evUEVTZC0yVFpNIqtdgjs211IU",
+	"q/Ux8QN530ChgGyKTLOjpTWCAtgywedEGZVsazjexDmumQL0FBSq5p0LrDQ/9bnIYis2PzudA9cLUtlc",
+	"1kyEkFaQCJ4Su6y5pYGPs/CMD4/2DeV0hQ9MjKk814/JC0MFBgjodqxi/BJ1wIuLt8p88eLVWxNbKoV1",
+	"h8VdCeUCw1U7vYA4A/o+faZpP1Y5d5T7zBBuix5k83EjfctkbB8hWg3lo8IUwtVbd20eQuWsqJK+jsn7",
+	"Eu28FDaCJzAMTAqU93zuTAt1r0WhEmqsA3SqtjLcAwOewZ+tfVJ8Q2RZAjrbpa9xuD7rrXs0B6x//uuO",
+	"JZ6DyyNvHDLjqJKBpFOgx1Qna+vvNH+7VKuo6hadxpu77aEZv61Z1X6ldKWMo+16w32IDXErT6hSfZ3+",
+	"KIJ44jP5ziEXMuq+iEr/l/hzFfdyOQhRt2MuxWXm5EwvJdoN9pypREJOebKNBzhM8kF7ac/DNAWfSOPe",
+	"Jskaks8+1qW0UzPsJue9gjrl1RJ79aLbBfZnyh3LmQR1qqOZTjy41+LSshIqUVEteAZKkY2QQFKqKaFS",
+	"Mpux1y8eh3h7e2ASl+i4v1PmNJhLUWRNr4BcAhgSAnYF6Y9mPxwzDeylHqP1mHxTsgYJs3mf8OMtudzF",
+	"OWPiX9OM4PNazJRxu59+iwvvXPWSJDWW6DwL/nycERJozIXHVgyvS+BZUEPXJaD670PUY2cPTUw/Lbep",
+	"g4GbNnbIV36TtqYmYY+CHnfavEp76gB/DNfqu2n9qD2dSyZP0Jn3Rom0oXdP35Q4x9Z3pbGL82qTCl99",
+	"jIkqoujI8LAz/Rz1pf2CafN+LTipChzMZj1256V7ze3aTod25k/OMWZCDMZi0oIkNMtAqsotgZ68435e",
+	"k8BwanoEgkOzH10bs9IzVstkBF2ZhQaKa6p2OwUYd5lACVVQCxZaI6LgmmXEez6MPkWtKwftSQVcI3QW",
+	"V48WebZdGMfuYrOkUW9Ct5/mFI1SqY8yPFzdzAh0F54z+zFjG9M3BhfdNfAlUAnSm0RClrAZ39up1lRC",
+	"+prxz6OIsP0JgkOJp7rfP9TikHAlPg9bvr1x/kFBPAGgkFnM52Eyb3w6Gflw/vpHImxChfFYWEpgqHHR",
+	"LVJpJvgKJOagoRerA6eHJni1hZzHSWlUlOvpvGiym1K6jWaL3lc8xuaJ4KlyvFmuwH3RDsKaXItVIU3a",
+	"bM7kNp53G+KrwUVZJq6tKERRWqLH+SLLJUS4Prr7MmBf33JnWk18kJaFGL0wJ/iOgxK/RFHqT4EUfDZA",
+	"KUad6UquqSJL9iVOYy7Zt3/mYd/k36iOdtHUzKgy1jdgykuSmBN9hchnJhMssb6oHquKJg24C8U+ccBP",
+	"2j83roS2kPma8k/4tXP12iRj94tFAeqHnzZMmYTzuBoSJDE1z/6t85e7vBv2hdiMjgNuHXbKgdopa8GL",
+	"R6ZLqqAyYyBbgqKvYLDA/uD3V6dnq34PozAVT2nas/nfCqHpP/x0DXw6eRywiB3LaBtcEPNxP4rDX3z4",
+	"meH4NDurbXnXSYwfW0i1r9riz+RyW+EBX/6x/FOV+ZcusRy1GaMBomNIYSiWJ+7+Sz0fyOMqhj0sbtLG",
+	"2ihp+q1HVZWVHsKygtR0NBWbt/hWnAHHxN+D8qlYxqNz6VTcS1gKCaXsO75L38EeRcRefhVVkZty0EvG",
+	"qdx2DfvwXBIRn8EsWGvMZ+D19Vvr2t3ZHxlV2sS8B1ppL+yth7jy252+sS+X0aQF+YsgpiBAeXL6u67x",
+	"Q7Oq69NDXlwFV5u6ogLOjd51vAx0+EcvVXW6/CHt9vkre3N+TVM8i8wqVbExlqtx5HJxbR4K7k/vkJR3",
+	"ijN3lu9xcXYzLYfr0nBoXFzzOtWdce8xebNDwsUyOTOMJEpMRcV/4/DHe6OIZle/xg5PBUkhmd5eIAH4",
+	"hHH2M2xPC71ub+2Ul94iNOKd98bmndp/oodGBWkReKJ7P5o0iQqX25onyvlxYHvcVVnrv49Oz14d/QwB",
+	"GuwaEQ3WseBXa/966bH3n7+8nzV1klPyn7+8r6KygVXGlCpAmtQXGgZvg6QVhwW/WaYVZMtj4ojQlYcw",
+	"l1/MZQc3CpVlgod948nJo7lhBSRYw2TWkWndW9+pWmpYKsztL2qsP/f598e++psRaWbXFXTWWue28gnj",
+	"S2FEA9PIDbOzbEv+CTTTa8TjLFCvZ4+OT45PEKIiB05zNns6+978NDeVvwxpeAeTcU6WwmJh5JQ1/ISK",
+	"+CXP4WhN1Rq8feRurSEApBFrKtQIq2ISTBFnmyBOKjeDuwUbGFbV/bRj8jyiXJbyx8ieUOYgIEssoGSe",
+	"nQml//XoLNue4i6fhxJxO5vXqmn+25Vn+60Aua0ItlaRsLsm26+NamiPT05Gq34TOToipXBCMe9QgRTw",
+	"t5OTrvHLBS9iZa1ujFt4s0GR6UbfepxXGK5HfD1qlPm6TmErH4hw6e91RP0EAZ5szKKFnub5nW1Jxuzc",
+	"nr+MDDIXRaxYnEdxWj3tRui873xusGpuY7LvnPhn+8Z01FQ/PcfLtm2f4G06PK/E3Yj09xoBL+tDxwWU",
+	"oSCPH00/g7mmkwi0VcHkuxkDJRoYwuKdiXY+udIGF7IWu9onZTz1OqfJP0S6HU0UBLip6wVaFnAzoQxq",
+	"TNyFclsL7EkfnAf1IscjE7NEdFiJDLpk0OKri2TeWOLJwJqOdZw+N783sPpTWQO4IZpi665eWbgJZ12M",
+	"vXvbTm8cEUznJvbg4GQxF4HWZkkXeZl5u19svykTdSckxCobOEKIb16eErfiEWkKrMivDx4XPs9cTLMr",
+	"1fh6bTJOV7ZiRqXT1PJ5Yzrm96hqG/Gl1zjwZaGrBPhj8kFVkdS2GmzdcOiEK6wCxqF0NTIXyMPM8Y0k",
+	"SUbZxhxzCkOUcyJ0Pifr688oBVVyXGVTh4vUQvi0o3pKtrm0CXqfyKxTzvhis0E0dyc2e1Irwuh+BedF",
+	"hMhbAsG50hc+xxF2mQnWFqgZCcaLwEWpPs4r0122iktXF+7VvEw0jBoU1vyyU9gXTKJNypZL5ApvHrqL",
+	"DzI9JjbPkmMmR1XPI2CblaQJkBwkE2lZ0MPq1NaZbeMuah9du0DPeQmvPTrtua9TCMTGv8qUoSG7O7UA",
+	"si87Mt5ncZVGFVPBRnlKMliivSo4/FhhoW2LrcDUXTjuUHxtVDCm8wZhzZb7yRzBIe04b5NbyNxZnTtI",
+	"o2M57tDfuZwpLbpoknBMsaq9N75VVxJmnU/NmVO60RyAI/LA+4r3qwZ4Ps1GNWpw7v42jVnpQeaMOVnH",
+	"NmTs4uMAXXy1fuWbhfM+dwvZCy1yVWUmBb4tZAzgqXKSwaVLKXNvhcnWbcXPkO89oxEQH8zKnrt1DdWC",
+	"7b5urwQ/OXmy//2yNvZ4qHP7dul0e9GH6rMsb6FEEYjldQqjulnFsHSktC8geVFX3ds7Ju8LyXcmo2Gb",
+	"gy5aqG6vJWUZHevOIXSpa+TUnzTeYC6d7Znw56INs+sqS3MHTup0UxV9XnytVYDubZxWH502Ov0Mw0Bt",
+	"9gdjqTodgHISrA/H333m3C1QRtEJavtrn0PmRmYDBqPRLk0jEPYiq4vzp4Tx+CZgC7y9jMC7JndT5rVN",
+	"7oHEKAM1X6uEt76ywsc/nof9t4Yhq5r0wYkIEhZXjdbYOQctGVyF76JbhWlFXj3HyJ82B2pgfoWVEec+",
+	"qb7Khqt6P6GNxiI6lJdII4N+vvdts6o+Eus/Fv9R56K98fJId5pa6owffO6iv2ZiW/jlyHS7UvUJ2/Hu",
+	"Z3aEo+dM5UIxn3K665P/PvIfYerT0TuztD0T4XePT36YACBnVGpGs0ZO0b0Axn9y7q9sTwHFJyff72fl",
+	"ZdhE6MmjH6ZvD3JecqplUlN5lWqmlsxZMAEiBgDqZlzvcl0cvXq+X+Av6tXidipDbdHzovp4Avk/Dkqr",
+	"JUbwWm2A2PQua+Q0ar+NrSKV1ddUOBX63+pnzx7F6a7xcdfn8X8VUNSOWFtCGbI07CRhLM1BhL4w18e6",
+	"jelnImegiG39ACE5mBp3KloCEO9YQFqrBMi0KmO/x+Rf9lsqgbiap9YDbCucuFwuoaqy/VTC3F9zsGP6",
+	"vFev0vmoTqsaoZ1zXv7LUxsHSIkt4uKrblYvm/tjHIu1EMptaLucmWZoNmxNFjlTZbjGxZT+vsOu30Wk",
+	"pjLj7Sl1fB2/o3jkBOr+AYGaA47Ik7/v/yIJ2hGOxMAGeC1pipReERZPKyqNOYjjzJy5i3xR7fwVT7Ii",
+	"BcuTzuFvL2ClyJbXYAKO5tLcED37tb1FdX/HXN1vbTbXv2hHdf/xIO/1hfneQnRsJzYNhZdqTNQhok0a",
+	"ubKlUnOG2pT5ZE5cgXAlCNO+BH4pq0ysKUlAlamzp2ev5qQtzRuNfgbJt3HoZHyh1r7YeNNuvvr45NEE",
+	"E+6hqDsUhHfm1MVveywv0nyyziGW0gn1hI3wQoINNcVe4nLx1V4xPtzHg5h87TuNT+lysAv9ln34LitK",
+	"hTS+F0uboN3TQEOs7BT1UM2wgFjjHmn/AimhMLZfOpTv4SSDrazxgD2+kG+1DnsYOuvIXu04KvcymOvm",
+	"16k4nhK1wW5oZ29/cleG2QZNpZI2haxu7UilSU5XrnDK2fOXc7ICjiRkc/K0qfyafF5JU4XNhkJtRlLt",
+	"OoIrXVPvSUiWGTUNepY0U+DTVTiQLQzyDZ+5HU8rFgyUFjlf3d7daddr4d7wqtFkDcarKEU2iSPxvrIB",
+	"fMmGqt0k25SENeCUD27FDzw+fAOwh2PdtDvNti8yuv0edJFRRe8w9rGEjMkilq0qAmpORJaC0lY2TGok",
+	"lTvvNJAwYRDto133Gt1tQWODb+hnk8nnLZ9BFs9o5NN1HposiJxKjRy7OfJKUu9bT+2boROk7w7qtDKo",
+	"VVn0jpbBqLtOfZCT58mjHhKy2aLffPe3/d9Fu7jf2qD6P32+VcVyyRKGrTZd2dOmEuGkbYM1DpG1i6/u",
+	"HzedSkUYDA6DdzglnujRFYwcInbkMgaf7rferkrp+lds+a/Y8rcdWx6qEv4VjA6D0aMJ14XTSnZkMONS",
+	"lL03677y6o0vLFiROUZauamaZhtwwBeKxQyJFsQkr6LZRrQIa0Yd4giuS91nbgt3JHy/Vd/ZG2o8Z1HS",
+	"8cppjYQazVF3O3OCtjETRQ6rCSZXMfdUNeqjR74I2piGquQBvvj7CTF693jYv7WDPBZfQ4D1dYNXEKo1",
+	"uB3KxTVcjcCc9wPtMkOy3tx4p7fhLgE4CQtHnNX17Y/rpa6P3VuejQ3cByIap02kuB8uKr3YQ0XWwhQV",
+	"Y3r/Nfo4XZz6z++X+dqFYK+Y/6uXH68Ew60ceMHEY/vqgpF74TWFHHjqqzcegNnn1QAPCbdj980f5J/1",
+	"ICHhKkbGdEeLd5NyZBdABCe01TS+Rhe1xp+75f1r/+o0IrpcyeS6685ilL3Q7AYI9daRdUoSgKONrcXX",
+	"ag99lUm/6NfV7oeyazXpw7s3U8Frj054N3CYgCUiumC46XE1wXDknnJhTIA+CPFyX/fmunjfN1gVaoer",
+	"n3Z3tjgmp7abic0Ez4Rpd7Y01SWv1yYaZxIFtBC2zdW1FHxVtglU5k4+keL6mLyuVaCkfizXLcUUV1ck",
+	"Y8adUh/F39gmHjKEAxYtw+6TmJEAJvmbuu7WJumbcj/wDheY7T87Fe2sGL/rqjtVv8zI+eOKL1lL4lGf",
+	"kBje3xaS/Q73m1voqrvOnv7715pCEzaG9bg3+dmObNqsYNrJdLLDiy+Jae9pY1+9e/26mwi2T7JoNBme",
+	"O14JGeyY/GLIOzGtWC31a3FNkV2QKXxnZ88ZVLW4KhX7yPqNaZwzUU2p13844n7yuIdtrYV4Q7m/XqEe",
+	"IlM8822OaNDkqFlnrcYYtvjzzlAw1uioKvsSVuu1bW4FzX35DkeyvsjaNij6Fi07bJlHN4uzdAeL38Ck",
+	"RfYgRl7vy73fg/CMX8criyyzFPGgtw2c7pZ0pqTrslGDZSeeqkviNg+h1hTedv3SJiUHlkvTuY0n4Lt7",
+	"mfgV00g3ptSY3OysWPwGvOyarjbdi50+0+qp7cMJ6cF4vy+f3QWuuyqUiHjtW5rFEs/CoWrHcWm6Euwp",
+	"w9MkA+1K11vScWXUiCh0vQqBqyZTO0qVO2druqrxXHgFtb6WfST2zG1wslMSm7HfQ93Feqv4WPnFHQgD",
+	"12riUA/1/YlGh846OXZ4sByJ760z5tojotDzN6yiWt44Gh0599dUjWFVr0rp66mapEcU3a4RZcgtIgO1",
+	"j+qrGmYPg+qnDZjchcS+e9UxWpxtoHj39PtgiP99Rcg+iaUh/pUWObkWEkfcR+ZeCP75pPtbuG4A7k65",
+	"536Z4RzyjCbQAkCd/j3hjasg2wqTthCBrzVIgJf+AVR7arqLKUxgfQg7yfnML3caWvbQeLa22a0Pxqr3",
+	"+ybWLfNNKiVm5XWLLeqeCvss9IirvQtfn4YsaiuaPL7W7qN+QIwthMqEcTZOGrCJo3GRMbW/70u4ZgzR",
+	"ziYDa/+Qdn17twlq1+cfOZjdGLwLDV/rtHUzCCXvat8ODlLVp5428tdm2Ej0r4nakStpNocfIMamgPSD",
+	"kYr3V06zn6hq8kgjS/lAdqllL98v4zycPJ8Js3uCoQlNpFDqFhTgqxzdAv9nfoiHhX2/s/6491/cDvPV",
+	"vCPjvRzYVsA/HOO2yNWtMO6GeGgYt8sagnG3kVti3M87OsbdwLfnc99o/ECMv8fPHxa2cUf9MY1v3w7L",
+	"dr6xldscuB25D4q9Tbmv78KLjcmyKTtnlOUYaZpKwEmI4LYXdVm5yQzpQrR+Huuu85sizA6p6AbKdBzT",
+	"7NR8J80LqkjWrk/ADm+Ht/l9M4UpHR5mjglrJT603AC31XoOC4K5m5L2RwEvQFvkl2MGQQmftAXaubyu",
+	"qbI9orXwDjP4wpRGH3HoM+tLIdPG8GqE8s1XJpqKqGz/j6hXK9Qh95iCga44CSpLDW5qT1bQN/kwL5aH",
+	"xC1vOI6dXB5AsI3gfr4uv7Px/Vz3pdJPdycnMBZi8P5akdlNb7ifhS29h2ls1XTTOrHqfBpxYIV4Gzd9",
+	"PRy5p7AaE6APQuZ9SzX1dgukkEGqao7DOcWXR7gVgufxFvt+WabY0J6e7PEW/dYKfJUe8nUW3r0Y/DXU",
+	"7+/eVUt3D7LhRdTGqZ42upwPBu5NxgsqkzW76k7cvdAS6Aatuf/36swr5H6koFx0rZKOmttrfzbIvRSZ",
+	"8S9cbqu3EFpzn1G8oZwtQenjRF0RO/0lqvFAkzUBruW2O4m3m81O3c7+cNw2Eb/8zvJb11VCGnEU1Sy5",
+	"p+LFeXpXSrqZqOxkScnlQgn15O6ZYwA/KcCPepVEi85ti6Jq+KJNOSrKuLI9y0loERuKmLuuGaXnZSNQ",
+	"wiSWJRNQthzjMXmPo7FWx5Wz5y9tImyeUcbtnL5Hss+5p9L3kt7VMaCbCS8sOG7Jg3UY/mISvbQgFtZk",
+	"KeSPJKHWecRWXEhIO5oW/zZrai97+K+R7ooAlqCKTCvrz0Jszi04H+Evj05OfiROuTGvPD7pWErGNkzH",
+	"2LeqhjjueefWPfi0syg8N18fdvK9oToxHfdrR999Nmo3dIMsY4jeVFqKcWMPxh8Q3WxzyS2immNaUX+2",
+	"iOYgtC7SwsIOVKdU/wmVHVUnolZRBFMR37xjD/85yenW9YFRGg2RsJnwJVRdmPCNJAPKISVFPkQMVwT2",
+	"vNrFwyG1Omj7SSX/SbU1A/3D6M4hTizNbeFsS8rhJyJF26d+z2x7qTMsmzFQ5ARlNB4KFfjd9CeCsDzA",
+	"4cKmmndkURMMvBeVvSPlbVQeHCGfDpV/3Oh4D1T2Cn+30XhQ2Hs6FP4hQt5u0L0os6ZNd0iyLJhdOi5M",
+	"Ar5SImEotpkub0XnkLAlS4K2h7+gFZWCF/JMmDx9dy1uXhbDDk3Bfd0G/RXnKtQZVLRnO2OdLbqze5vO",
+	"23yryvXFAypZ34eaywrbd1xL9E9fyb7WIXWQJ9+SGM5abHbf3Qxa7tmPrEJflrW3OSvcpyMQxkmyLvhn",
+	"NSeqkFfsyjCqFDk6RBPBOZgGm8pe51kyTjO8LEIYr3U3O4Cbz8vNPMAgUgnpyftRDFhFjJntk9uy8oGc",
+	"+QCYy974p6QElSf74Tym6Ko7tvCPrbZ3MSWkPjXN+jobbVviXlqekrW4trWiqp+ZxsNzbm/XaWrr+HSE",
+	"Hhpj/1YITYeY1x+U7dX0MAPgyiLXLjLWhtI+JxZJY1fAj+CMqPqMNfKpzKA9QjjooBtpBr1iV8ADJey0",
+	"et261rHJM1OlovWZi2veT9XyAxlV60dXhsQ1v9Yi6Bxt5yZ6LUWxMhWl/Ep319EPDLppgvje1Jo+camM",
+	"bx2auORw9m2XZicByNu0vvjq/9W/jKYHzFkYQRwqfspPH1wZzdAbsMeMvgs4TMB20TykatNj5yFVI8el",
+	"6mko1VA0JpRzYVrtCw47u+H3EGRjYudByMM/dE32/sJqEZxnPTxeTWI4Db6+T65tVGOvVjWgHnv10S3T",
+	"P8PZxy7KHoxd6vBtydCbmUfF3/h8XcPJ1KpOMNnB2k4AzwlqDfjRQxYvK5DGTDlpYlQLCVc0Y2mTydvk",
+	"W491Wi07/JikBRAjY5Y0y5SZ23UJ5hh+t38/p1tFUro1pluSFSmq3Gja+SNHXIFMC+i2z2xs7Txcdos6",
+	"Y5kY1fy1dAwvzZ/+/WQe6VTZldkVpPHeVSbjA4nYGzwvTU2nAAUTXkyOzxdQsm1CX2v/P7hRZa1/mvdL",
+	"UKLYirv+9jayr/o0rjR33cw3TNmKha4ENtPKDEl1IWFO4EvOMMFLwpUv9k95aisAK8ZXmbkUZUZS87LI",
+	"VaGMwdt4Aacylm2RE4HFiEwlRZzQbBUjDZcA3FwTLEsQZ9fIjsq3lsO7eCKzwJnbHCtkT9NZ75i8Fa5W",
+	"UaMCIFOEA+zMHLswGHpt8DO8brv9rJ2n9YGzL8TcPNQe3gaioAjVc0KVQ19HapZ7d2euWJkcybj+4cms",
+	"v4QosTw0Fe2vhqJ/NRT9lhuKPupxCKwEhz95M9Fp7lYGKc+VI9z7J+0BZg+z2vmJYfTFV/yvOz33mAqY",
+	"yvCeqkNkuZ1kMpvA5ik8/N4bbp0VClyU8qv9f8tR2BDL5nfltJAE2BWkLhRpjnUJGVDlXjDRDnOlWl45",
+	"LQR/thMdk9N6sJOhloBnfhCubDvN2wd94Lu0YbUPbiODKcRD4B4avN6Xa+b0kvJU8EgoLnCQdquyYrm0",
+	"9+Xt50AU8NTXULUNgqXYdCtnUyPsruK5xstawe2++vs6p2wTlVjHUYqVBGUcPzkmy0dkLP48LlbmPd99",
+	"Z8hogGgWiQZ9pMzNsQM0qweVC/AMpaeLUd+N7Lj7xIHG5QieVr4Zc3jYCxJtEbTrmFr4c6I7onuqFGxM",
+	"3f/YedVIhrFWa90Ste2ZpMTPwgNLaZZlZMMUWsLGCLfGuTml9qbd2ZLp3Xl7vY69UiWqc+tLD5T7k6UP",
+	"NfHtobLVt54p95JxptZ7+VeBXDB+xXSrbnDzxgTlrkyPabLjKljXS0CZBlpzSxJO39iUlwptDScuOFhf",
+	"k3lZufLYW6uvUGMjE8pJtaZYQalYOam3AKldYCKETBmnWriOQOhCrjO+kLVKWLsYWYF8VQFoGjMlwMAd",
+	"H4UI/mguHCLWLOtbyMK4pfFcMo1BNLgaY7sYZWEp9YCiVg6mZoa5rf5SMkvV/sqySCZWyjIR60+i1s80",
+	"OaHaaShPHlK991eB1DDru4faR9M4ck4jknF20/ji68x2Bzgt9BoHQFWf5uxn2Ja//Hrz/wcA2DeBnhcX",
+	"AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file